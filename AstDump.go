@@ -1,6 +1,7 @@
 // AstDump.go
-// program that converts markdown files into html files
-// ./simpleMdCon /in=infile.md /out=outfile.html [/dbg]
+// program that dumps the ast of a markdown file into a file
+// ./AstDump /in=infile /out=outfile [/fmt=text|json|yaml|dot] [/ext=attr,imgattr,table,footnote|all] [/dbg]
+// reads md/<infile>.md and writes dump/<outfile>.<txt|json|yaml|dot>
 // uses goldmark: github.com/yuin/goldmark
 //
 // author: prr, azul software
@@ -14,32 +15,26 @@ import (
 	"fmt"
 	"log"
 	"os"
-//	"bytes"
+	"bytes"
 
-	"github.com/yuin/goldmark"
-//    "github.com/yuin/goldmark/parser"
-//    "github.com/yuin/goldmark/ast"
-//    "github.com/yuin/goldmark/renderer"
-//    "github.com/yuin/goldmark/renderer/html"
-    "github.com/yuin/goldmark/text"
-//    "github.com/yuin/goldmark/util"
+	"goDemo/goldmark/samples/astLib"
 
 	cliutil "github.com/prr123/utility/utilLib"
 )
 
 func main() {
 
-//	var buf bytes.Buffer
+	var buf bytes.Buffer
 	
 	numarg := len(os.Args)
-    flags:=[]string{"dbg", "in", "out"}
+    flags:=[]string{"dbg", "in", "out", "fmt", "ext"}
 
-    useStr := " /in=infile /out=outfile [/dbg]"
-    helpStr := "markdown to html conversion program"
+    useStr := " /in=infile /out=outfile [/fmt=text|json|yaml|dot] [/ext=attr,imgattr,table,footnote|all] [/dbg]"
+    helpStr := "program that dumps the ast of a markdown file"
 
     if numarg > len(flags) +1 {
         fmt.Println("too many arguments in cl!")
-        fmt.Println("usage: %s %s\n", os.Args[0], useStr)
+        fmt.Printf("usage: %s %s\n", os.Args[0], useStr)
         os.Exit(-1)
     }
 
@@ -74,33 +69,45 @@ func main() {
         outFil = outval.(string)
     }
 
+    format := "text"
+    fmtval, ok := flagMap["fmt"]
+    if ok {
+        if fmtval.(string) == "none" {log.Fatalf("error -- no format provided!\n")}
+        format = fmtval.(string)
+    }
+
+    extStr := ""
+    extval, ok := flagMap["ext"]
+    if ok {
+        if extval.(string) == "none" {log.Fatalf("error -- no extensions provided!\n")}
+        extStr = extval.(string)
+    }
+    exts, err := astLib.Extensions(extStr)
+    if err != nil {log.Fatalf("error -- ext: %v\n", err)}
+
 	inFilnam := "md/" + inFil + ".md"
-	outFilnam := "dump/" + outFil + ".txt"
+	outFilnam := "dump/" + outFil + astLib.FormatExt(format)
 
 	if dbg {
 		fmt.Printf("input:  %s\n", inFilnam)
 		fmt.Printf("output: %s\n", outFilnam)
+		fmt.Printf("format: %s ext: %s\n", format, extStr)
 	}
 
 	source, err := os.ReadFile(inFilnam)
 	if err != nil {log.Fatalf("error -- open file: %v\n",err)}
 
-	mkd := goldmark.New()
-
-	reader := text.NewReader(source)
-	mkdParser := mkd.Parser()
-//    doc := mkdParser.Parse(reader, opts...)
-    doc := mkdParser.Parse(reader)
-
-	doc.Dump(source, 2)
+	doc := astLib.Parse(source, exts)
+	tree := astLib.Build(doc, source)
 
-//	err = goldmark.Convert(source, &buf)
-//	if err != nil {log.Fatalf("error -- convert: %v\n",err)}
-//	log.Printf("*** success converting ***\n")
+	err = astLib.Write(&buf, tree, format)
+	if err != nil {log.Fatalf("error -- dump: %v\n",err)}
 
 	// save
-//	err = os.WriteFile(outFilnam, buf.Bytes(), 0666)
-//	if err != nil {log.Fatalf("error -- write file: %v\n")}
+	err = os.MkdirAll("dump", 0755)
+	if err != nil {log.Fatalf("error -- create dump dir: %v\n", err)}
+	err = os.WriteFile(outFilnam, buf.Bytes(), 0666)
+	if err != nil {log.Fatalf("error -- write file: %v\n", err)}
 
 	log.Println("*** success ***")
 }
@@ -8,6 +8,7 @@
 // copyright prr, azul software
 //
 // 4/12 fix inp errors
+// input directory and extensions from the md2js.yaml project config
 //
 package main
 
@@ -15,8 +16,10 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"bytes"
 
+	"goDemo/goldmark/samples/md2jsLib"
 	"github.com/yuin/goldmark"
 	util "github.com/prr123/utility/utilLib"
 )
@@ -33,7 +36,7 @@ func main() {
 
     if numarg > len(flags) +1 {
         fmt.Println("too many arguments in cl!")
-        fmt.Println("usage: %s %s\n", os.Args[0], useStr)
+        fmt.Printf("usage: %s %s\n", os.Args[0], useStr)
         os.Exit(-1)
     }
 
@@ -71,7 +74,10 @@ func main() {
 		}
     }
 
-	inFilnam := "md/" + inFil + ".md"
+	cfg, err := md2jsLib.LoadProjectConfig("")
+	if err != nil {log.Fatalf("error -- config: %v\n", err)}
+
+	inFilnam := filepath.Join(cfg.Path(cfg.InDir), inFil + ".md")
 	outFilnam := "html/" + outFil + ".html"
 
 	if dbg {
@@ -85,14 +91,14 @@ func main() {
 	// func Convert(source []byte, w io.Writer, opts ...parser.ParseOption) error
 //	err = goldmark.Convert(source, &buf, parser.WithContext(ctx))
 
-	md:= goldmark.New()
+	md:= goldmark.New(goldmark.WithExtensions(cfg.For(inFilnam).Goldmark()...))
 	err = md.Convert(source, &buf)
 	if err != nil {log.Fatalf("error -- convert: %v\n",err)}
 	log.Printf("*** success converting ***\n")
 
 	// save
 	err = os.WriteFile(outFilnam, buf.Bytes(), 0666)
-	if err != nil {log.Fatalf("error -- write file: %v\n")}
+	if err != nil {log.Fatalf("error -- write file: %v\n", err)}
 
 	log.Println("*** success ***")
 }
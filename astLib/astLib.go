@@ -0,0 +1,225 @@
+// astLib.go
+// library that parses markdown files and converts the goldmark ast into
+// a tree of plain nodes with source line ranges
+// the tree is written as indented text, json, yaml or graphviz dot
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package astLib
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	attributes "goDemo/goldmark/samples/extBlockAttr"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// A Node is a node of the ast with its properties as strings.
+type Node struct {
+	Kind string `json:"kind" yaml:"kind"`
+	// Lines is the 1-based range of source lines [start, end]; zero if the node has no source.
+	Lines [2]int `json:"lines" yaml:"lines,flow"`
+	// Props are the kind specific properties, such as the heading level or the link destination.
+	Props map[string]string `json:"props,omitempty" yaml:"props,omitempty"`
+	// Attrs are the attributes of the node.
+	Attrs    map[string]string `json:"attrs,omitempty" yaml:"attrs,omitempty"`
+	Children []*Node           `json:"children,omitempty" yaml:"children,omitempty"`
+}
+
+// ExtNames are the names of the extensions accepted by Extensions.
+var ExtNames = []string{"attr", "imgattr", "table", "footnote"}
+
+// Extensions returns the goldmark extensions of a comma separated list of names.
+// "all" selects all extensions, an empty list none.
+func Extensions(list string) (exts []goldmark.Extender, err error) {
+	for _, nam := range strings.Split(list, ",") {
+		switch strings.TrimSpace(strings.ToLower(nam)) {
+		case "":
+		case "all":
+			exts = []goldmark.Extender{attributes.Extension, extension.Table, extension.Footnote}
+		case "attr", "imgattr":
+			// image attributes are part of the attributes extension
+			if !hasExt(exts, attributes.Extension) {exts = append(exts, attributes.Extension)}
+		case "table":
+			exts = append(exts, extension.Table)
+		case "footnote":
+			exts = append(exts, extension.Footnote)
+		default:
+			return nil, fmt.Errorf("unknown extension: %s (extensions: %s, all)", nam, strings.Join(ExtNames, ", "))
+		}
+	}
+	return exts, nil
+}
+
+func hasExt(exts []goldmark.Extender, ext goldmark.Extender) bool {
+	for _, e := range exts {
+		if e == ext {return true}
+	}
+	return false
+}
+
+// Parse parses a markdown source with the extensions.
+func Parse(source []byte, exts []goldmark.Extender) ast.Node {
+	md := goldmark.New(
+		goldmark.WithExtensions(exts...),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+	return md.Parser().Parse(text.NewReader(source))
+}
+
+// Build converts a goldmark ast into a Node tree.
+func Build(doc ast.Node, source []byte) *Node {
+	b := builder{source: source}
+	// lineStarts holds the offset of the first byte of every line
+	b.lineStarts = append(b.lineStarts, 0)
+	for i, c := range source {
+		if c == '\n' {b.lineStarts = append(b.lineStarts, i+1)}
+	}
+	n, _, _ := b.build(doc)
+	return n
+}
+
+type builder struct {
+	source     []byte
+	lineStarts []int
+}
+
+// line returns the 1-based line of a source offset.
+func (b *builder) line(offset int) int {
+	return sort.Search(len(b.lineStarts), func(i int) bool {return b.lineStarts[i] > offset})
+}
+
+// build returns the node and the source offsets [start, stop) it covers; start is -1 without source.
+func (b *builder) build(an ast.Node) (n *Node, start, stop int) {
+
+	n = &Node{Kind: an.Kind().String(), Props: b.props(an)}
+	for _, a := range an.Attributes() {
+		if n.Attrs == nil {n.Attrs = make(map[string]string)}
+		n.Attrs[string(a.Name)] = attrString(a.Value)
+	}
+
+	start, stop = -1, -1
+	span := func(s, e int) {
+		if s < 0 {return}
+		if start < 0 || s < start {start = s}
+		if e > stop {stop = e}
+	}
+	if an.Type() == ast.TypeBlock {
+		lines := an.Lines()
+		if lines != nil && lines.Len() > 0 {span(lines.At(0).Start, lines.At(lines.Len()-1).Stop)}
+	}
+	if t, ok := an.(*ast.Text); ok {span(t.Segment.Start, t.Segment.Stop)}
+
+	for c := an.FirstChild(); c != nil; c = c.NextSibling() {
+		cn, cs, ce := b.build(c)
+		n.Children = append(n.Children, cn)
+		span(cs, ce)
+	}
+	if start >= 0 {
+		end := stop
+		if end > start {end--}
+		n.Lines = [2]int{b.line(start), b.line(end)}
+	}
+	return n, start, stop
+}
+
+// props returns the kind specific properties of a node.
+func (b *builder) props(an ast.Node) map[string]string {
+	p := make(map[string]string)
+	switch n := an.(type) {
+	case *ast.Heading:
+		p["level"] = strconv.Itoa(n.Level)
+	case *ast.List:
+		p["ordered"] = strconv.FormatBool(n.IsOrdered())
+		p["marker"] = string(n.Marker)
+		p["tight"] = strconv.FormatBool(n.IsTight)
+		if n.IsOrdered() {p["start"] = strconv.Itoa(n.Start)}
+	case *ast.FencedCodeBlock:
+		if lang := n.Language(b.source); lang != nil {p["language"] = string(lang)}
+	case *ast.Emphasis:
+		p["level"] = strconv.Itoa(n.Level)
+	case *ast.Link:
+		p["destination"] = string(n.Destination)
+		if len(n.Title) > 0 {p["title"] = string(n.Title)}
+	case *ast.Image:
+		p["destination"] = string(n.Destination)
+		if len(n.Title) > 0 {p["title"] = string(n.Title)}
+	case *ast.AutoLink:
+		p["url"] = string(n.URL(b.source))
+	case *ast.Text:
+		p["text"] = string(n.Segment.Value(b.source))
+		if n.SoftLineBreak() {p["softLineBreak"] = "true"}
+		if n.HardLineBreak() {p["hardLineBreak"] = "true"}
+	case *ast.String:
+		p["text"] = string(n.Value)
+	case *ast.CodeSpan:
+		p["text"] = string(n.Text(b.source))
+	case *ast.RawHTML:
+		var buf bytes.Buffer
+		for i := 0; i < n.Segments.Len(); i++ {
+			seg := n.Segments.At(i)
+			buf.Write(seg.Value(b.source))
+		}
+		p["html"] = buf.String()
+	case *ast.HTMLBlock:
+		p["type"] = strconv.Itoa(int(n.HTMLBlockType))
+	case *east.TableCell:
+		if n.Alignment != east.AlignNone {p["align"] = n.Alignment.String()}
+	case *east.Footnote:
+		p["index"] = strconv.Itoa(n.Index)
+		p["ref"] = string(n.Ref)
+	case *east.FootnoteLink:
+		p["index"] = strconv.Itoa(n.Index)
+	case *east.FootnoteBacklink:
+		p["index"] = strconv.Itoa(n.Index)
+	}
+	if len(p) == 0 {return nil}
+	return p
+}
+
+// attrString formats an attribute value.
+func attrString(v interface{}) string {
+	switch val := v.(type) {
+	case []byte:
+		return string(val)
+	case string:
+		return val
+	case []interface{}:
+		strs := make([]string, len(val))
+		for i, e := range val {strs[i] = attrString(e)}
+		return "[" + strings.Join(strs, ", ") + "]"
+	}
+	return fmt.Sprint(v)
+}
+
+// Walk calls fn for n and its descendants in document order.
+// The depth of n is 0.
+func (n *Node) Walk(fn func(n *Node, depth int)) {
+	n.walk(fn, 0)
+}
+
+func (n *Node) walk(fn func(n *Node, depth int), depth int) {
+	fn(n, depth)
+	for _, c := range n.Children {c.walk(fn, depth+1)}
+}
+
+// sortedKeys returns the keys of a map in order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {keys = append(keys, k)}
+	sort.Strings(keys)
+	return keys
+}
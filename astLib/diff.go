@@ -0,0 +1,311 @@
+// diff.go
+// structural diff of two ast trees
+// the children of matched nodes are aligned with a longest common subsequence of
+// identical subtrees; the remaining children of the same kind are paired in order
+// and compared recursively. Unpaired subtrees are removed or inserted, unless an
+// identical subtree, or one of the same kind with a similar text, is found on the
+// other side, which makes them moved.
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package astLib
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Op is the kind of a change.
+type Op string
+
+const (
+	OpInserted Op = "inserted"
+	OpRemoved  Op = "removed"
+	OpMoved    Op = "moved"
+	OpModified Op = "modified"
+)
+
+// A Change is a difference between the old and the new tree.
+type Change struct {
+	Op   Op     `json:"op"`
+	Kind string `json:"kind"`
+	// Path is the path of kinds from the document to the node in the old tree, or in the new tree for inserted nodes.
+	Path string `json:"path"`
+	// Old and New are the source line ranges; zero if the node is not in the tree.
+	Old [2]int `json:"old"`
+	New [2]int `json:"new"`
+	// Details lists the changed properties and attributes of a modified node
+	// and the new path of a moved node.
+	Details []string `json:"details,omitempty"`
+	// Text is a short text of the node.
+	Text string `json:"text,omitempty"`
+}
+
+// dnode is a node of a tree prepared for the diff.
+type dnode struct {
+	n        *Node
+	hash     [sha256.Size]byte
+	path     string
+	children []*dnode
+}
+
+func prepare(n *Node, parentPath string, idx int) *dnode {
+	d := &dnode{n: n}
+	d.path = n.Kind
+	if len(parentPath) > 0 {d.path = parentPath + "/" + n.Kind + "[" + strconv.Itoa(idx) + "]"}
+
+	h := sha256.New()
+	h.Write([]byte(n.Kind))
+	for _, k := range sortedKeys(n.Props) {fmt.Fprintf(h, "\x00p%s=%s", k, n.Props[k])}
+	for _, k := range sortedKeys(n.Attrs) {fmt.Fprintf(h, "\x00a%s=%s", k, n.Attrs[k])}
+	for i, c := range n.Children {
+		dc := prepare(c, d.path, i)
+		d.children = append(d.children, dc)
+		h.Write([]byte{1})
+		h.Write(dc.hash[:])
+	}
+	copy(d.hash[:], h.Sum(nil))
+	return d
+}
+
+// Diff returns the changes from the old to the new tree in the order of their source lines.
+func Diff(oldTree, newTree *Node) []Change {
+
+	var changes []Change
+	var removed, inserted []*dnode
+
+	var match func(o, n *dnode)
+	match = func(o, n *dnode) {
+		if det := propDiff(o.n, n.n); len(det) > 0 {
+			changes = append(changes, change(OpModified, o, n, det))
+		}
+		pairs := alignChildren(o.children, n.children)
+		oi, ni := 0, 0
+		for _, p := range append(pairs, [2]int{len(o.children), len(n.children)}) {
+			// pair the unmatched children of a gap by kind, in order
+			ogap, ngap := o.children[oi:p[0]], n.children[ni:p[1]]
+			used := make([]bool, len(ngap))
+			for _, oc := range ogap {
+				paired := false
+				for j, nc := range ngap {
+					if used[j] || nc.n.Kind != oc.n.Kind {continue}
+					used[j] = true
+					paired = true
+					match(oc, nc)
+					break
+				}
+				if !paired {removed = append(removed, oc)}
+			}
+			for j, nc := range ngap {
+				if !used[j] {inserted = append(inserted, nc)}
+			}
+			oi, ni = p[0]+1, p[1]+1
+		}
+	}
+	match(prepare(oldTree, "", 0), prepare(newTree, "", 0))
+
+	// an identical subtree that was removed in one place and inserted in another has moved
+	byHash := make(map[[sha256.Size]byte][]*dnode)
+	for _, in := range inserted {byHash[in.hash] = append(byHash[in.hash], in)}
+	used := make(map[*dnode]bool)
+	var restRm []*dnode
+	for _, rm := range removed {
+		if ins := byHash[rm.hash]; len(ins) > 0 {
+			byHash[rm.hash] = ins[1:]
+			used[ins[0]] = true
+			changes = append(changes, change(OpMoved, rm, ins[0], []string{"to " + ins[0].path}))
+			continue
+		}
+		restRm = append(restRm, rm)
+	}
+
+	var restIns []*dnode
+	for _, in := range inserted {
+		if !used[in] {restIns = append(restIns, in)}
+	}
+
+	// a subtree of the same kind with a similar text has moved and was modified
+	removed, inserted = nil, nil
+	for _, rm := range restRm {
+		var best *dnode
+		bestSim := 0.5
+		for _, cand := range restIns {
+			if used[cand] || cand.n.Kind != rm.n.Kind {continue}
+			if sim := similarity(rm.n.text(), cand.n.text()); sim > bestSim || (sim == bestSim && best == nil) {
+				best, bestSim = cand, sim
+			}
+		}
+		if best == nil {
+			changes = append(changes, change(OpRemoved, rm, nil, nil))
+			continue
+		}
+		used[best] = true
+		changes = append(changes, change(OpMoved, rm, best, []string{"to " + best.path}))
+		match(rm, best)
+	}
+	for _, in := range restIns {
+		if !used[in] {changes = append(changes, change(OpInserted, nil, in, nil))}
+	}
+	// the changes inside the moved subtrees are not searched for moves again
+	for _, rm := range removed {changes = append(changes, change(OpRemoved, rm, nil, nil))}
+	for _, in := range inserted {changes = append(changes, change(OpInserted, nil, in, nil))}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].line() != changes[j].line() {return changes[i].line() < changes[j].line()}
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+// similarity returns the share of common words of two texts, from 0 to 1.
+// Texts without words are not similar.
+func similarity(a, b string) float64 {
+	aw, bw := strings.Fields(a), strings.Fields(b)
+	if len(aw) == 0 || len(bw) == 0 {return 0}
+	count := make(map[string]int)
+	for _, w := range aw {count[w]++}
+	common := 0
+	for _, w := range bw {
+		if count[w] > 0 {
+			count[w]--
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(aw)+len(bw))
+}
+
+// alignChildren returns the index pairs of identical children in a longest common subsequence.
+func alignChildren(o, n []*dnode) (pairs [][2]int) {
+	// lcs[i][j] is the length of the lcs of o[i:] and n[j:]
+	lcs := make([][]int, len(o)+1)
+	for i := range lcs {lcs[i] = make([]int, len(n)+1)}
+	for i := len(o) - 1; i >= 0; i-- {
+		for j := len(n) - 1; j >= 0; j-- {
+			switch {
+			case o[i].hash == n[j].hash:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	for i, j := 0, 0; i < len(o) && j < len(n); {
+		switch {
+		case o[i].hash == n[j].hash:
+			pairs = append(pairs, [2]int{i, j})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return pairs
+}
+
+// propDiff lists the changed properties and attributes of two nodes of the same kind.
+func propDiff(o, n *Node) (det []string) {
+	diffMap := func(prefix string, om, nm map[string]string) {
+		keys := make(map[string]bool)
+		for k := range om {keys[k] = true}
+		for k := range nm {keys[k] = true}
+		names := make([]string, 0, len(keys))
+		for k := range keys {names = append(names, k)}
+		sort.Strings(names)
+		for _, k := range names {
+			ov, ook := om[k]
+			nv, nok := nm[k]
+			switch {
+			case ook && nok && ov != nv:
+				det = append(det, fmt.Sprintf("%s%s: %s -> %s", prefix, k, strconv.Quote(shorten(ov)), strconv.Quote(shorten(nv))))
+			case ook && !nok:
+				det = append(det, fmt.Sprintf("%s%s: %s removed", prefix, k, strconv.Quote(shorten(ov))))
+			case !ook && nok:
+				det = append(det, fmt.Sprintf("%s%s: %s added", prefix, k, strconv.Quote(shorten(nv))))
+			}
+		}
+	}
+	diffMap("", o.Props, n.Props)
+	diffMap("@", o.Attrs, n.Attrs)
+	return det
+}
+
+func change(op Op, o, n *dnode, det []string) Change {
+	c := Change{Op: op, Details: det}
+	ref := n
+	if o != nil {
+		ref = o
+		c.Old = o.n.Lines
+	}
+	if n != nil {c.New = n.n.Lines}
+	c.Kind = ref.n.Kind
+	c.Path = ref.path
+	c.Text = shorten(ref.n.text())
+	return c
+}
+
+// line returns the line used to order the changes.
+func (c Change) line() int {
+	if c.Old[0] > 0 {return c.Old[0]}
+	return c.New[0]
+}
+
+// text returns the texts of a node and its descendants separated by spaces.
+func (n *Node) text() string {
+	var parts []string
+	n.Walk(func(n *Node, _ int) {
+		if t := n.Props["text"]; len(t) > 0 {parts = append(parts, t)}
+	})
+	return strings.Join(parts, " ")
+}
+
+// WriteDiff writes the changes as text, one change per line, or as json.
+func WriteDiff(w io.Writer, changes []Change, format string) error {
+	switch format {
+	case "json":
+		if changes == nil {changes = []Change{}}
+		data, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {return err}
+		_, err = w.Write(append(data, '\n'))
+		return err
+	case "text":
+		for _, c := range changes {
+			_, err := fmt.Fprintln(w, c)
+			if err != nil {return err}
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown diff format: %s (formats: text, json)", format)
+}
+
+func (c Change) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-8s %s", c.Op, c.Kind)
+	switch {
+	case c.Old[0] > 0 && c.New[0] > 0:
+		fmt.Fprintf(&sb, " %s -> %s", lineRange(c.Old), lineRange(c.New))
+	case c.Old[0] > 0:
+		fmt.Fprintf(&sb, " %s", lineRange(c.Old))
+	case c.New[0] > 0:
+		fmt.Fprintf(&sb, " %s", lineRange(c.New))
+	}
+	if len(c.Text) > 0 {fmt.Fprintf(&sb, " %s", strconv.Quote(c.Text))}
+	if len(c.Details) > 0 {fmt.Fprintf(&sb, ": %s", strings.Join(c.Details, "; "))}
+	return sb.String()
+}
+
+func lineRange(l [2]int) string {
+	if l[0] == l[1] {return "line " + strconv.Itoa(l[0])}
+	return fmt.Sprintf("lines %d-%d", l[0], l[1])
+}
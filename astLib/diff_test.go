@@ -0,0 +1,70 @@
+// diff_test.go
+// tests of the structural diff: moved, modified, inserted and removed nodes
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package astLib
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		old, new string
+		want     []string
+	}{
+		{"same", "# a\n\ntext\n", "# a\n\ntext\n", nil},
+		{"modified", "# a\n\nsome *text*\n", "## a\n\nsome **text**\n", []string{
+			`modified Heading line 1 -> line 1 "a": level: "1" -> "2"`,
+			`modified Emphasis line 3 -> line 3 "text": level: "1" -> "2"`,
+		}},
+		{"inserted", "# a\n\none\n", "# a\n\none\n\ntwo\n", []string{
+			`inserted Paragraph line 5 "two"`,
+		}},
+		{"removed", "# a\n\none\n\ntwo\n", "# a\n\ntwo\n", []string{
+			`removed  Paragraph line 3 "one"`,
+		}},
+		// identical subtrees
+		{"moved", "# a\n\none\n\n# b\n\ntwo\n", "# b\n\ntwo\n\n# a\n\none\n", []string{
+			`moved    Heading line 1 -> line 5 "a": to Document/Heading[2]`,
+			`moved    Paragraph line 3 -> line 7 "one": to Document/Paragraph[3]`,
+		}},
+		// a similar text of the same kind
+		{"moved and modified", "the moved para text\n\n# x\n", "# x\n\nthe moved para text too\n", []string{
+			`moved    Paragraph line 1 -> line 3 "the moved para text": to Document/Paragraph[1]`,
+			`modified Text line 1 -> line 3 "the moved para text": text: "the moved para text" -> "the moved para text too"`,
+		}},
+		// a paragraph of the same kind in the gap is paired, not moved
+		{"paired", "one two\n\n# x\n", "three four\n\n# x\n", []string{
+			`modified Text line 1 -> line 1 "one two": text: "one two" -> "three four"`,
+		}},
+	}
+
+	for _, test := range tests {
+		o, n := []byte(test.old), []byte(test.new)
+		changes := Diff(Build(Parse(o, nil), o), Build(Parse(n, nil), n))
+		var got []string
+		for _, c := range changes {got = append(got, c.String())}
+		if strings.Join(got, "\n") != strings.Join(test.want, "\n") {
+			t.Errorf("%s:\n%s\nwant:\n%s", test.name, strings.Join(got, "\n"), strings.Join(test.want, "\n"))
+		}
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+
+	changes := []Change{{Op: OpInserted, Kind: "Paragraph", Path: "Document/Paragraph[1]", New: [2]int{3, 4}, Text: "two"}}
+	var buf strings.Builder
+	if err := WriteDiff(&buf, changes, "text"); err != nil {t.Fatal(err)}
+	if want := "inserted Paragraph lines 3-4 \"two\"\n"; buf.String() != want {t.Errorf("text: %q, want %q", buf.String(), want)}
+	buf.Reset()
+	if err := WriteDiff(&buf, nil, "json"); err != nil || buf.String() != "[]\n" {t.Errorf("json of no changes: %q %v", buf.String(), err)}
+	if err := WriteDiff(&buf, changes, "dot"); err == nil {t.Errorf("unknown format accepted")}
+}
@@ -0,0 +1,140 @@
+// format.go
+// output formats of the ast: indented text, json, yaml and graphviz dot
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package astLib
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Formats are the output formats accepted by Write.
+var Formats = []string{"text", "json", "yaml", "dot"}
+
+// FormatExt returns the file extension of a format.
+func FormatExt(format string) string {
+	if format == "text" {return ".txt"}
+	return "." + format
+}
+
+// Write writes the tree in a format.
+func Write(w io.Writer, n *Node, format string) error {
+	switch format {
+	case "text":
+		return WriteText(w, n)
+	case "json":
+		data, err := json.MarshalIndent(n, "", "  ")
+		if err != nil {return err}
+		_, err = w.Write(append(data, '\n'))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(n)
+		if err != nil {return err}
+		_, err = w.Write(data)
+		return err
+	case "dot":
+		return WriteDot(w, n)
+	}
+	return fmt.Errorf("unknown format: %s (formats: %s)", format, strings.Join(Formats, ", "))
+}
+
+// WriteText writes the tree as indented text, one node per line:
+// kind, line range, properties and attributes.
+func WriteText(w io.Writer, n *Node) (err error) {
+	n.Walk(func(n *Node, depth int) {
+		if err != nil {return}
+		_, err = fmt.Fprintf(w, "%s%s\n", strings.Repeat("  ", depth), n.label())
+	})
+	return err
+}
+
+// label returns the one line description of a node.
+func (n *Node) label() string {
+	var sb strings.Builder
+	sb.WriteString(n.Kind)
+	if n.Lines[0] > 0 {
+		if n.Lines[0] == n.Lines[1] {
+			fmt.Fprintf(&sb, " [%d]", n.Lines[0])
+		} else {
+			fmt.Fprintf(&sb, " [%d-%d]", n.Lines[0], n.Lines[1])
+		}
+	}
+	for _, k := range sortedKeys(n.Props) {
+		fmt.Fprintf(&sb, " %s=%s", k, strconv.Quote(n.Props[k]))
+	}
+	for _, k := range sortedKeys(n.Attrs) {
+		fmt.Fprintf(&sb, " @%s=%s", k, strconv.Quote(n.Attrs[k]))
+	}
+	return sb.String()
+}
+
+// WriteDot writes the tree as a graphviz digraph.
+func WriteDot(w io.Writer, n *Node) error {
+	var sb strings.Builder
+	sb.WriteString("digraph ast {\n\tnode [shape=box, fontname=\"monospace\"];\n")
+	id := 0
+	var visit func(n *Node) int
+	visit = func(n *Node) int {
+		nid := id
+		id++
+		fmt.Fprintf(&sb, "\tn%d [label=%s];\n", nid, dotQuote(n.dotLabel()))
+		for _, c := range n.Children {
+			cid := visit(c)
+			fmt.Fprintf(&sb, "\tn%d -> n%d;\n", nid, cid)
+		}
+		return nid
+	}
+	visit(n)
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// dotLabel returns a node label with one property per line; long values are shortened.
+func (n *Node) dotLabel() string {
+	lines := []string{n.Kind}
+	if n.Lines[0] > 0 {lines = append(lines, fmt.Sprintf("lines %d-%d", n.Lines[0], n.Lines[1]))}
+	for _, k := range sortedKeys(n.Props) {lines = append(lines, k+": "+shorten(n.Props[k]))}
+	for _, k := range sortedKeys(n.Attrs) {lines = append(lines, "@"+k+": "+shorten(n.Attrs[k]))}
+	return strings.Join(lines, "\n")
+}
+
+// dotQuote returns a label as dot string: quotes and backslashes are escaped, line breaks
+// are \n escapes, tabs become spaces and the other control characters are dropped.
+func dotQuote(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\t':
+			sb.WriteByte(' ')
+		case r < ' ' || r == 0x7f:
+			// dropped
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+func shorten(s string) string {
+	r := []rune(s)
+	if len(r) > 30 {return string(r[:29]) + "…"}
+	return s
+}
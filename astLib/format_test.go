@@ -0,0 +1,71 @@
+// format_test.go
+// tests of the output formats of the ast: text, json, yaml and dot
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package astLib
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+)
+
+func TestFormats(t *testing.T) {
+
+	source := []byte("# Hi *you*\n\nsome `co\"de`\n")
+	tree := Build(Parse(source, nil), source)
+
+	var buf bytes.Buffer
+	if err := Write(&buf, tree, "text"); err != nil {t.Fatal(err)}
+	want := `Document [1-3]
+  Heading [1] level="1" @id="hi-you"
+    Text [1] text="Hi "
+    Emphasis [1] level="1"
+      Text [1] text="you"
+  Paragraph [3]
+    Text [3] text="some "
+    CodeSpan [3] text="co\"de"
+      Text [3] text="co\"de"
+`
+	if buf.String() != want {t.Errorf("text:\n%s\nwant:\n%s", buf.String(), want)}
+
+	// json and yaml read back into the same tree
+	for _, format := range []string{"json", "yaml"} {
+		buf.Reset()
+		if err := Write(&buf, tree, format); err != nil {t.Fatal(err)}
+		var back Node
+		var err error
+		if format == "json" {err = json.Unmarshal(buf.Bytes(), &back)} else {err = yaml.Unmarshal(buf.Bytes(), &back)}
+		if err != nil {t.Fatalf("%s: %v\n%s", format, err, buf.String())}
+		if !reflect.DeepEqual(&back, tree) {t.Errorf("%s: tree changed:\n%s", format, buf.String())}
+	}
+
+	if err := Write(&buf, tree, "xml"); err == nil {t.Errorf("unknown format accepted")}
+}
+
+// dot labels escape quotes and backslashes the dot way, not as go strings
+func TestDot(t *testing.T) {
+
+	tree := &Node{Kind: "Paragraph", Lines: [2]int{1, 2}, Children: []*Node{
+		{Kind: "Text", Lines: [2]int{1, 1}, Props: map[string]string{"text": "a \"q\" \\ é\tx\x01"}},
+		{Kind: "CodeSpan", Attrs: map[string]string{"class": "c"}},
+	}}
+	var buf bytes.Buffer
+	if err := Write(&buf, tree, "dot"); err != nil {t.Fatal(err)}
+	want := "digraph ast {\n\tnode [shape=box, fontname=\"monospace\"];\n" +
+		"\tn0 [label=\"Paragraph\\nlines 1-2\"];\n" +
+		"\tn1 [label=\"Text\\nlines 1-1\\ntext: a \\\"q\\\" \\\\ é x\"];\n" +
+		"\tn0 -> n1;\n" +
+		"\tn2 [label=\"CodeSpan\\n@class: c\"];\n" +
+		"\tn0 -> n2;\n}\n"
+	if buf.String() != want {t.Errorf("dot:\n%s\nwant:\n%s", buf.String(), want)}
+	if strings.Contains(buf.String(), `\u`) || strings.Contains(buf.String(), `\t`) {t.Errorf("go escapes in dot:\n%s", buf.String())}
+}
@@ -0,0 +1,148 @@
+// azul.go
+// package azul holds the versions of the azul dom runtime that the md2js scripts call
+// the runtimes are embedded js files azul-<version>.js; the api of a version
+// are the keys of the azul object literal
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package azul
+
+import (
+	"embed"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//go:embed js/azul-*.js
+var files embed.FS
+
+// Latest is the runtime version the converter targets by default.
+const Latest = "1.0"
+
+// Versions returns the embedded runtime versions.
+func Versions() []string {
+	ents, _ := files.ReadDir("js")
+	var vers []string
+	for _, ent := range ents {
+		nam := ent.Name()
+		vers = append(vers, strings.TrimSuffix(strings.TrimPrefix(nam, "azul-"), ".js"))
+	}
+	sort.Strings(vers)
+	return vers
+}
+
+// Runtime returns the js source of a runtime version.
+func Runtime(version string) ([]byte, error) {
+	if len(version) == 0 {version = Latest}
+	data, err := files.ReadFile("js/azul-" + version + ".js")
+	if err != nil {return nil, fmt.Errorf("no azul runtime %s (versions: %s)", version, strings.Join(Versions(), ", "))}
+	return data, nil
+}
+
+// apiKey matches a key of the azul object literal, which is indented by one tab.
+var apiKey = regexp.MustCompile(`(?m)^\t([A-Za-z_$][\w$]*)\s*:`)
+
+// API returns the functions and properties of a runtime version.
+func API(version string) (map[string]bool, error) {
+	data, err := Runtime(version)
+	if err != nil {return nil, err}
+	api := make(map[string]bool)
+	for _, m := range apiKey.FindAllSubmatch(data, -1) {api[string(m[1])] = true}
+	return api, nil
+}
+
+// azulRef matches a reference to a member of the runtime.
+var azulRef = regexp.MustCompile(`\bazul\.([A-Za-z_$][\w$]*)`)
+
+// Check returns the sorted names of the runtime members that js uses
+// but that the runtime version does not define.
+// String literals and comments of js are not checked.
+func Check(version string, js []byte) (missing []string, err error) {
+	api, err := API(version)
+	if err != nil {return nil, err}
+	seen := make(map[string]bool)
+	for _, m := range azulRef.FindAllSubmatch(stripLiterals(js), -1) {
+		nam := string(m[1])
+		if api[nam] || seen[nam] {continue}
+		seen[nam] = true
+		missing = append(missing, nam)
+	}
+	sort.Strings(missing)
+	return missing, nil
+}
+
+// stripLiterals blanks the string literals, template literals and comments of js.
+// The substitutions ${...} of template literals are code and kept. Line breaks are kept.
+func stripLiterals(js []byte) []byte {
+	out := make([]byte, len(js))
+	copy(out, js)
+	blank := func(i int) {
+		if out[i] != '\n' {out[i] = ' '}
+	}
+	// template blanks the text of a template literal from i up to the closing backtick
+	// or the start of a substitution; it returns the index of the last character read
+	// and whether a substitution starts there
+	template := func(i int) (int, bool) {
+		for ; i < len(out); i++ {
+			switch {
+			case out[i] == '`':
+				return i, false
+			case out[i] == '$' && i+1 < len(out) && out[i+1] == '{':
+				return i + 1, true
+			case out[i] == '\\' && i+1 < len(out):
+				blank(i)
+				i++
+			}
+			blank(i)
+		}
+		return i, false
+	}
+	// depth holds the open braces of each substitution the scan is in
+	var depth []int
+	for i := 0; i < len(out); i++ {
+		switch c := out[i]; {
+		case c == '/' && i+1 < len(out) && out[i+1] == '/':
+			for ; i < len(out) && out[i] != '\n'; i++ {blank(i)}
+		case c == '/' && i+1 < len(out) && out[i+1] == '*':
+			blank(i)
+			blank(i + 1)
+			for i += 2; i < len(out); i++ {
+				if out[i] == '*' && i+1 < len(out) && out[i+1] == '/' {
+					blank(i)
+					blank(i + 1)
+					i++
+					break
+				}
+				blank(i)
+			}
+		case c == '\'' || c == '"':
+			for i++; i < len(out) && out[i] != c; i++ {
+				if out[i] == '\\' && i+1 < len(out) {
+					blank(i)
+					i++
+				}
+				blank(i)
+			}
+		case c == '`':
+			var sub bool
+			if i, sub = template(i + 1); sub {depth = append(depth, 0)}
+		case c == '{' && len(depth) > 0:
+			depth[len(depth)-1]++
+		case c == '}' && len(depth) > 0:
+			if depth[len(depth)-1] > 0 {
+				depth[len(depth)-1]--
+				continue
+			}
+			// the substitution ends, the template literal continues
+			depth = depth[:len(depth)-1]
+			var sub bool
+			if i, sub = template(i + 1); sub {depth = append(depth, 0)}
+		}
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+// azul_test.go
+// tests of the runtime api of a version, the check of the runtime members a script uses
+// and the blanking of string literals, template literals and comments
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package azul
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAPI(t *testing.T) {
+
+	api, err := API(Latest)
+	if err != nil {t.Fatal(err)}
+	for _, nam := range []string{"version", "docbody", "addElement"} {
+		if !api[nam] {t.Errorf("%s missing in the api of %s", nam, Latest)}
+	}
+	// keys of nested objects and function bodies are not members
+	for _, nam := range []string{"el", "key", "typ"} {
+		if api[nam] {t.Errorf("%s in the api of %s", nam, Latest)}
+	}
+	if _, err := API("0.1"); err == nil {t.Errorf("no error for an unknown version")}
+}
+
+func TestCheck(t *testing.T) {
+
+	tests := []struct {
+		js   string
+		want []string
+	}{
+		{"azul.addElement({typ: 'p'}); azul.docbody.append(p);", nil},
+		{"azul.addTable(); azul.addElement(); azul.addTable(); azul.setTitle();", []string{"addTable", "setTitle"}},
+		{"// azul.gone()\nlet s = 'azul.str' + \"azul.dq\"; /* azul.block */", nil},
+		{"let s = `azul.text ${azul.sub(1)} and ${ {a: azul.obj}.a } azul.tail`;", []string{"obj", "sub"}},
+	}
+	for _, test := range tests {
+		got, err := Check(Latest, []byte(test.js))
+		if err != nil {t.Fatal(err)}
+		if strings.Join(got, ",") != strings.Join(test.want, ",") {t.Errorf("%q: %v, want %v", test.js, got, test.want)}
+	}
+	if _, err := Check("0.1", nil); err == nil {t.Errorf("no error for an unknown version")}
+}
+
+func TestStripLiterals(t *testing.T) {
+
+	tests := []struct {
+		js   string
+		want string
+	}{
+		{`a('x\'y', "z") // c`, `a('    ', " ")     `},
+		{"a /* b\nc */ d", "a     \n     d"},
+		{"`t\n${x}u`", "` \n${x} `"},
+		{"`a${ `b${c}d` }e`", "` ${ ` ${c} ` } `"},
+		{"`${ {k: 'v'} }` + x", "`${ {k: ' '} }` + x"},
+		{"`\\${no}` y", "`      ` y"},
+		{"`${a /* } */}b`", "`${a        } `"},
+	}
+	for _, test := range tests {
+		got := string(stripLiterals([]byte(test.js)))
+		if got != test.want {t.Errorf("%q:\n got: %q\nwant: %q", test.js, got, test.want)}
+		if len(got) != len(test.js) {t.Errorf("%q: length %d, want %d", test.js, len(got), len(test.js))}
+	}
+}
@@ -0,0 +1,22 @@
+// azul dom runtime 1.0
+// the md2js scripts and the site scripts build the page with these functions
+//
+// author: prr, azul software
+// copyright prr, azul software
+//
+const azul = {
+	version: '1.0',
+	// docbody is the body element of the page
+	docbody: document.body,
+	// addElement creates an element from an object: typ is the tag name,
+	// style is assigned to the element style, all other keys are set as properties
+	addElement: function (obj) {
+		const el = document.createElement(obj.typ);
+		for (const [key, val] of Object.entries(obj)) {
+			if (key === 'typ') {continue;}
+			if (key === 'style') {Object.assign(el.style, val); continue;}
+			el[key] = val;
+		}
+		return el;
+	},
+};
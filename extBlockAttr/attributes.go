@@ -4,13 +4,29 @@
 // Package attributes is a extension for the goldmark
 // (http://github.com/yuin/goldmark).
 //
-// This extension adds support for block attributes in markdowns.
-//  paragraph text with attributes
+// This extension adds support for attributes {#id .class key=val} in markdowns,
+// one parser for block and inline nodes. Placement rules:
+//  - a line of attributes directly after a block (no blank line) attaches to the block:
+//    paragraph, list, table, quote, code; inside a tight list item to the item
+//  - attributes at the end of a heading or of the first paragraph of a list item, after
+//    a space, attach to the heading or the item
+//  - attributes directly after an inline element (no space) attach to it:
+//    link, image, emphasis, code span, autolink
+//  - [text]{.x} is a bracketed span: the text becomes a span with the attributes
+//  - attributes after the info string of fenced code attach to the code: ```go {.num}
+//  - attributes that attach to nothing stay text
+// Attributes of the markdown (e.g. a heading id) win over attributes of a later
+// rule, except for heading ids; classes are merged.
 
 package attributes
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
+
+	md2js "goDemo/goldmark/samples/rendererV3"
+
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/parser"
@@ -26,15 +42,7 @@ type block struct {
 
 // Dump implements Node.Dump.
 func (a *block) Dump(source []byte, level int) {
-	attrs := a.Attributes()
-	list := make(map[string]string, len(attrs))
-	for _, attr := range attrs {
-		name := util.BytesToReadOnlyString(attr.Name)
-		value := util.BytesToReadOnlyString(util.EscapeHTML(attr.Value.([]byte)))
-		list[name] = value
-	}
-
-	ast.DumpHelper(a, source, level, list, nil)
+	ast.DumpHelper(a, source, level, dumpAttrs(a), nil)
 }
 
 // KindAttributes is a NodeKind of the attributes block node.
@@ -45,23 +53,96 @@ func (a *block) Kind() ast.NodeKind {
 	return KindAttributes
 }
 
+// inline are parsed inline attributes; seg is the source of the attributes,
+// which stays text if they attach to nothing.
+type inline struct {
+	ast.BaseInline
+	seg text.Segment
+}
+
+// Dump implements Node.Dump.
+func (a *inline) Dump(source []byte, level int) {
+	ast.DumpHelper(a, source, level, dumpAttrs(a), nil)
+}
+
+// KindInlineAttributes is a NodeKind of the inline attributes node.
+var KindInlineAttributes = ast.NewNodeKind("InlineAttributes")
+
+// Kind implements Node.Kind.
+func (a *inline) Kind() ast.NodeKind {
+	return KindInlineAttributes
+}
+
+// A Span is a bracketed span [text]{.x}.
+type Span struct {
+	ast.BaseInline
+}
+
+// Dump implements Node.Dump.
+func (n *Span) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, dumpAttrs(n), nil)
+}
+
+// KindSpan is a NodeKind of the bracketed span node.
+var KindSpan = ast.NewNodeKind("Span")
+
+// Kind implements Node.Kind.
+func (n *Span) Kind() ast.NodeKind {
+	return KindSpan
+}
+
+// NewSpan returns a new Span node.
+func NewSpan() *Span {
+	return &Span{}
+}
+
+// dumpAttrs returns the attributes of a node as text for Dump.
+func dumpAttrs(n ast.Node) map[string]string {
+	attrs := n.Attributes()
+	list := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		list[string(attr.Name)] = valueText(attr.Value)
+	}
+	return list
+}
+
+// valueText returns the text of an attribute value; list items are separated by spaces.
+func valueText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(v)
+	case []interface{}:
+		list := make([]string, len(v))
+		for i, item := range v {list[i] = valueText(item)}
+		return strings.Join(list, " ")
+	}
+	return fmt.Sprint(value)
+}
+
 type attrParser struct{}
 
 // Trigger implement parser.BlockParser interface.
 func (a *attrParser) Trigger() []byte {
-//fmt.Println("dbg --- found attributes")
 	return []byte{'{'}
 }
 
 // Open implement parser.BlockParser interface.
 func (a *attrParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
-//fmt.Println("dbg --- found attributes")
-	// add attributes if defined
-	if attrs, ok := parser.ParseAttributes(reader); ok {
+	line, seg := reader.PeekLine()
+	l, pos := reader.Position()
+	// add attributes if defined and the line has nothing else
+	if attrs, ok := ParseAttributes(reader); ok {
+		if rest, _ := reader.PeekLine(); !util.IsBlank(rest) {
+			reader.SetPosition(l, pos)
+			return nil, parser.RequireParagraph
+		}
 		node := &block{BaseBlock: ast.BaseBlock{}}
 		for _, attr := range attrs {
 			node.SetAttribute(attr.Name, attr.Value)
 		}
+		node.Lines().Append(seg.WithStop(seg.Start + len(util.TrimRightSpace(line))))
 
 		return node, parser.NoChildren
 	}
@@ -89,38 +170,229 @@ func (a *attrParser) CanAcceptIndentedLine() bool {
 	return false
 }
 
+type inlineParser struct{}
+
+// Trigger implement parser.InlineParser interface.
+func (a *inlineParser) Trigger() []byte {
+	return []byte{'{'}
+}
+
+// Parse implement parser.InlineParser interface.
+func (a *inlineParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	_, start := block.Position()
+	attrs, ok := ParseAttributes(block)
+	if !ok {return nil}
+	_, stop := block.Position()
+	node := &inline{seg: text.NewSegment(start.Start, stop.Start)}
+	for _, attr := range attrs {
+		node.SetAttribute(attr.Name, attr.Value)
+	}
+	return node
+}
+
 type transformer struct{}
 
 // Transform implement parser.Transformer interface.
-func (a *transformer) Transform(node *ast.Document, reader text.Reader, pc parser.Context) {
-	// collect all attributes block
-	var attributes = make([]ast.Node, 0, 1000)
-	_ = ast.Walk(node, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
-		if entering && node.Kind() == KindAttributes {
+func (a *transformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	// collect all attributes nodes and fenced code blocks
+	var attributes = make([]ast.Node, 0, 100)
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {return ast.WalkContinue, nil}
+		switch node.Kind() {
+		case KindAttributes, KindInlineAttributes:
 			attributes = append(attributes, node)
 			return ast.WalkSkipChildren, nil
+		case ast.KindFencedCodeBlock:
+			infoAttributes(node.(*ast.FencedCodeBlock), source)
 		}
-
 		return ast.WalkContinue, nil
 	})
 
-	// set attributes to next block sibling
-//fmt.Printf("dbg -- attribute nodes: %d\n", len(attributes))
+	// in document order, so that inner spans are made first
 	for _, attr := range attributes {
-		prev := attr.PreviousSibling()
-		if prev != nil && prev.Type() == ast.TypeBlock &&
-			!attr.HasBlankPreviousLines() {
-			for _, attr := range attr.Attributes() {
-				if _, exist := prev.Attribute(attr.Name); !exist {
-					prev.SetAttribute(attr.Name, attr.Value)
-//fmt.Printf("dbg -- attr: %s - %s\n", attr.Name, attr.Value)
-				}
+		if attr.Kind() == KindAttributes {
+			attachBlock(attr, source)
+		} else {
+			attachInline(attr.(*inline), source, pc)
+		}
+	}
+}
+
+// attachBlock sets the attributes of a line to the previous block sibling.
+func attachBlock(attr ast.Node, source []byte) {
+	parent := attr.Parent()
+	prev := attr.PreviousSibling()
+	if prev != nil && prev.Type() == ast.TypeBlock && !attr.HasBlankPreviousLines() {
+		// a text block has no element of its own
+		if prev.Kind() == ast.KindTextBlock && parent.Kind() == ast.KindListItem {prev = parent}
+		setAttributes(prev, attr, false)
+		parent.RemoveChild(parent, attr)
+		return
+	}
+	// attaches to nothing: the line is a paragraph
+	para := ast.NewParagraph()
+	para.SetBlankPreviousLines(attr.HasBlankPreviousLines())
+	seg := attr.Lines().At(0)
+	para.Lines().Append(seg)
+	para.AppendChild(para, ast.NewTextSegment(seg))
+	parent.ReplaceChild(parent, attr, para)
+}
+
+// attachInline sets inline attributes to the inline element before them, a bracketed span,
+// the heading or the list item they end.
+func attachInline(attr *inline, source []byte, pc parser.Context) {
+	parent := attr.Parent()
+	prev := attr.PreviousSibling()
+	t, isText := prev.(*ast.Text)
+	switch {
+	case prev == nil:
+	case prev.Kind() != ast.KindText && prev.Kind() != ast.KindString && prev.Kind() != ast.KindRawHTML:
+		setAttributes(prev, attr, false)
+		parent.RemoveChild(parent, attr)
+		return
+	case !isText:
+	case endsWith(t, source, ']'):
+		if span := wrapSpan(t, source); span != nil {
+			setAttributes(span, attr, false)
+			parent.RemoveChild(parent, attr)
+			return
+		}
+	case attr.NextSibling() == nil && (endsWith(t, source, ' ') || endsWith(t, source, '\t')):
+		var target ast.Node
+		if parent.Kind() == ast.KindHeading {
+			target = parent
+		} else if item := parent.Parent(); item != nil && item.Kind() == ast.KindListItem && item.FirstChild() == parent {
+			target = item
+		}
+		if target == nil {break}
+		trimText(t, source)
+		if _, ok := attr.Attribute(attrNameID); !ok && target.Kind() == ast.KindHeading && pc.IDs() != nil {
+			// the auto heading id was made of the text with the attributes
+			if _, ok := target.AttributeString("id"); ok {
+				start := target.Lines().At(0).Start
+				target.SetAttribute(attrNameID, pc.IDs().Generate(util.TrimRightSpace(source[start:attr.seg.Start]), ast.KindHeading))
+			}
+		}
+		setAttributes(target, attr, target.Kind() == ast.KindHeading)
+		parent.RemoveChild(parent, attr)
+		return
+	}
+	// attaches to nothing: the attributes stay text
+	parent.ReplaceChild(parent, attr, ast.NewTextSegment(attr.seg))
+}
+
+// infoAttributes moves the attributes at the end of the info string of fenced code to the code.
+func infoAttributes(n *ast.FencedCodeBlock, source []byte) {
+	if n.Info == nil {return}
+	seg := n.Info.Segment
+	info := seg.Value(source)
+	i := bytes.IndexByte(info, '{')
+	if i < 0 {return}
+	reader := text.NewReader(info[i:])
+	attrs, ok := ParseAttributes(reader)
+	if !ok {return}
+	if rest, _ := reader.PeekLine(); !util.IsBlank(rest) {return}
+	for _, attr := range attrs {
+		if _, exist := n.Attribute(attr.Name); !exist {n.SetAttribute(attr.Name, attr.Value)}
+	}
+	lang := util.TrimRightSpace(info[:i])
+	if len(lang) == 0 {
+		n.Info = nil
+		return
+	}
+	n.Info = ast.NewTextSegment(seg.WithStop(seg.Start + len(lang)))
+}
+
+// setAttributes sets the attributes of attr to a node. Existing attributes are kept
+// unless override is set; classes are merged.
+func setAttributes(node, attr ast.Node, override bool) {
+	for _, a := range attr.Attributes() {
+		old, exist := node.Attribute(a.Name)
+		switch {
+		case bytes.Equal(a.Name, attrNameClass) && exist:
+			node.SetAttribute(a.Name, []byte(valueText(old)+" "+valueText(a.Value)))
+		case !exist || override:
+			node.SetAttribute(a.Name, a.Value)
+		}
+	}
+}
+
+// endsWith reports whether the text ends with an unescaped byte c.
+func endsWith(t *ast.Text, source []byte, c byte) bool {
+	seg := t.Segment
+	if seg.Len() == 0 || source[seg.Stop-1] != c {return false}
+	return seg.Len() < 2 || source[seg.Stop-2] != '\\'
+}
+
+// trimText removes the trailing spaces of a text; an empty text is removed.
+func trimText(t *ast.Text, source []byte) {
+	value := util.TrimRightSpace(t.Segment.Value(source))
+	t.Segment = t.Segment.WithStop(t.Segment.Start + len(value))
+	if t.Segment.Len() == 0 {t.Parent().RemoveChild(t.Parent(), t)}
+}
+
+// wrapSpan makes a span of the siblings between the text close, which ends with ']',
+// and the text with the matching '['. It returns nil if there is no '['.
+func wrapSpan(close *ast.Text, source []byte) *Span {
+	// find the opening bracket
+	depth := 0
+	var open *ast.Text
+	pos := -1
+	stop := close.Segment.Stop - 1
+	for n := ast.Node(close); n != nil && open == nil; n = n.PreviousSibling() {
+		t, ok := n.(*ast.Text)
+		if !ok {continue}
+		if n != ast.Node(close) {stop = t.Segment.Stop}
+		for i := stop - 1; i >= t.Segment.Start; i-- {
+			if i > t.Segment.Start && source[i-1] == '\\' {
+				i--
+				continue
+			}
+			if source[i] == ']' {depth++}
+			if source[i] != '[' {continue}
+			if depth > 0 {
+				depth--
+				continue
 			}
+			open, pos = t, i
+			break
 		}
+	}
+	if open == nil {return nil}
 
-		// remove attributes node
-		attr.Parent().RemoveChild(attr.Parent(), attr)
+	parent := close.Parent()
+	span := NewSpan()
+	parent.InsertAfter(parent, open, span)
+	inner := open.Segment.WithStart(pos + 1)
+	if open == close {inner = inner.WithStop(close.Segment.Stop - 1)}
+	if inner.Len() > 0 || open != close {
+		first := ast.NewTextSegment(inner)
+		first.SetSoftLineBreak(open.SoftLineBreak())
+		first.SetHardLineBreak(open.HardLineBreak())
+		span.AppendChild(span, first)
+		open.SetSoftLineBreak(false)
+		open.SetHardLineBreak(false)
+	}
+	open.Segment = open.Segment.WithStop(pos)
+	if open != close {
+		for n := span.NextSibling(); n != nil; {
+			next := n.NextSibling()
+			parent.RemoveChild(parent, n)
+			if n == ast.Node(close) {
+				close.Segment = close.Segment.WithStop(close.Segment.Stop - 1)
+				if close.Segment.Len() > 0 {span.AppendChild(span, close)}
+				break
+			}
+			span.AppendChild(span, n)
+			n = next
+		}
 	}
+	if first, ok := span.FirstChild().(*ast.Text); ok && first.Segment.Len() == 0 && !first.SoftLineBreak() {
+		span.RemoveChild(span, first)
+	}
+	if open.Segment.Len() == 0 {parent.RemoveChild(parent, open)}
+	return span
 }
 
 type attrRender struct{}
@@ -128,19 +400,67 @@ type attrRender struct{}
 // RegisterFuncs implement renderer.NodeRenderer interface.
 func (a *attrRender) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 	// not render
-	reg.Register(KindAttributes,
-		func(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
-			return ast.WalkSkipChildren, nil
-		})
+	skip := func(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+		return ast.WalkSkipChildren, nil
+	}
+	reg.Register(KindAttributes, skip)
+	reg.Register(KindInlineAttributes, skip)
+	reg.Register(KindSpan, renderSpan)
+	reg.Register(ast.KindDocument, renderDocument)
 }
 
-// extension defines a goldmark.Extender for markdown block attributes.
+// renderDocument sets the source text of typed values and lists as the attribute values
+// of the document nodes: the goldmark html renderer writes only text values.
+// The md2jsV3 renderer replaces the html renderer and keeps the typed values.
+func renderDocument(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {return ast.WalkContinue, nil}
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {return ast.WalkContinue, nil}
+		for _, attr := range n.Attributes() {
+			switch attr.Value.(type) {
+			case Value, []interface{}:
+				n.SetAttribute(attr.Name, []byte(valueText(attr.Value)))
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	return ast.WalkContinue, nil
+}
+
+// renderSpan renders a bracketed span as html.
+func renderSpan(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		_, _ = w.WriteString("</span>")
+		return ast.WalkContinue, nil
+	}
+	_, _ = w.WriteString("<span")
+	for _, attr := range node.Attributes() {
+		_, _ = w.WriteString(" ")
+		_, _ = w.Write(attr.Name)
+		_, _ = w.WriteString(`="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(valueText(attr.Value))))
+		_ = w.WriteByte('"')
+	}
+	_ = w.WriteByte('>')
+	return ast.WalkContinue, nil
+}
+
+// spanEmitter renders a bracketed span with md2jsV3.
+var spanEmitter = md2js.NewEmitter(func(b *md2js.Builder, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {return ast.WalkContinue, nil}
+	b.Element("span").Attributes(md2js.GlobalAttributeFilter)
+	b.Children()
+	return ast.WalkSkipChildren, nil
+}, KindSpan)
+
+// extension defines a goldmark.Extender for markdown attributes.
 type extension struct{}
 
 var (
-	defaultParser      = new(attrParser)
-	defaultTransformer = new(transformer)
-	defaultRenderer    = new(attrRender)
+	defaultParser       = new(attrParser)
+	defaultInlineParser = new(inlineParser)
+	defaultTransformer  = new(transformer)
+	defaultRenderer     = new(attrRender)
 )
 
 // Extend implement goldmark.Extender interface.
@@ -148,6 +468,8 @@ func (a *extension) Extend(m goldmark.Markdown) {
 	m.Parser().AddOptions(
 		parser.WithBlockParsers(
 			util.Prioritized(defaultParser, 100)),
+		parser.WithInlineParsers(
+			util.Prioritized(defaultInlineParser, 500)),
 		parser.WithASTTransformers(
 			util.Prioritized(defaultTransformer, 100),
 		),
@@ -159,8 +481,13 @@ func (a *extension) Extend(m goldmark.Markdown) {
 	)
 }
 
-// Extension is a goldmark.Extender with markdown block attributes support.
+// Emitters implements md2js.EmitterProvider: spans are rendered, attribute nodes are not.
+func (a *extension) Emitters() []md2js.Emitter {
+	return []md2js.Emitter{spanEmitter, md2js.SkipEmitter(KindAttributes, KindInlineAttributes)}
+}
+
+// Extension is a goldmark.Extender with markdown attributes support.
 var Extension goldmark.Extender = new(extension)
 
-// Enable is a goldmark.Option with block attributes support.
+// Enable is a goldmark.Option with attributes support.
 var Enable = goldmark.WithExtensions(Extension)
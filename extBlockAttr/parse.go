@@ -1,15 +1,21 @@
-// adopted from parser/attribute.go for img attributes
+// adopted from parser/attribute.go of goldmark
+// the one attribute parser of the attributes extension: blocks, headings, list items,
+// fenced code, inline elements and bracketed spans
 // changes: 
-// - parse attribute values: numbers are passed as strings not numbers
-// - added single quotes as acceptable input
+// - typed attribute values: numbers (float64), numbers with a unit (Length: 50%, 12rem)
+//   and booleans are a Value with their source text, so 1.10 stays 1.10;
+//   quoted strings and words are []byte, lists []interface{}
+// - single or double quoted strings
+// - unquoted values (key=val)
+// (moved from imgAttr/parsAttr.go)
 
-package imgAttrs
+package attributes
 
 import (
 //	"fmt"
 	"bytes"
 	"io"
-//	"strconv"
+	"strconv"
 
 	"github.com/yuin/goldmark/text"
 	"github.com/yuin/goldmark/util"
@@ -24,6 +30,34 @@ type Attribute struct {
 	Value interface{}
 }
 
+// A Length is a number with a unit, e.g. 50% or 12rem.
+type Length struct {
+	Value float64
+	Unit  string
+}
+
+// String returns the css text of the length.
+func (l Length) String() string {
+	return strconv.FormatFloat(l.Value, 'f', -1, 64) + l.Unit
+}
+
+// A Value is a typed attribute value with its source text: Typed is a number (float64),
+// a Length or a bool.
+type Value struct {
+	Text  []byte
+	Typed interface{}
+}
+
+// String returns the source text of the value.
+func (v Value) String() string {
+	return string(v.Text)
+}
+
+// TypedValue returns the typed value, see md2js.TypedAttrValue.
+func (v Value) TypedValue() interface{} {
+	return v.Typed
+}
+
 // An Attributes is a collection of attributes.
 type Attributes []Attribute
 
@@ -47,11 +81,10 @@ func (as Attributes) findUpdate(name []byte, cb func(v interface{}) interface{})
 	return false
 }
 
-// ParseAttributes parses attributes into a map.
+// ParseAttributes parses attributes {#id .class key=val} into a list.
 // ParseAttributes returns a parsed attributes and true if could parse
-// attributes, otherwise nil and false.
-func ParseImgAttrs(reader text.Reader) (Attributes, bool) {
-//fmt.Printf("dbg -- imgAttr/parsAttr: parseAttributes\n")
+// attributes, otherwise nil and false; the reader is not advanced then.
+func ParseAttributes(reader text.Reader) (Attributes, bool) {
 	savedLine, savedPosition := reader.Position()
 	reader.SkipSpaces()
 	if reader.Peek() != '{' {
@@ -164,7 +197,7 @@ func parseAttributeValue(reader text.Reader) (interface{}, bool) {
 		return Attribute{}, false
 	// nested attributes
 	case '{':
-		value, ok = ParseImgAttrs(reader)
+		value, ok = ParseAttributes(reader)
 	// array
 	case '[':
 		value, ok = parseAttributeArray(reader)
@@ -211,7 +244,8 @@ func parseAttributeArray(reader text.Reader) ([]interface{}, bool) {
 }
 
 func parseAttributeString(reader text.Reader) ([]byte, bool) {
-	reader.Advance(1) // skip "
+	quote := reader.Peek()
+	reader.Advance(1) // skip " or '
 	line, _ := reader.PeekLine()
 	i := 0
 	l := len(line)
@@ -221,7 +255,7 @@ func parseAttributeString(reader text.Reader) ([]byte, bool) {
 		if c == '\\' && i != l-1 {
 			n := line[i+1]
 			switch n {
-			case '"', '/', '\\':
+			case '"', '\'', '/', '\\':
 				buf.WriteByte(n)
 				i += 2
 			case 'b':
@@ -245,7 +279,7 @@ func parseAttributeString(reader text.Reader) ([]byte, bool) {
 			}
 			continue
 		}
-		if c == '"' {
+		if c == quote {
 			reader.Advance(i + 1)
 			return buf.Bytes(), true
 		}
@@ -267,63 +301,57 @@ func scanAttributeDecimal(reader text.Reader, w io.ByteWriter) {
 	}
 }
 
+// parseAttributeNumber parses a number (float64) or a number with a unit (Length)
+// into a Value with its source text.
 func parseAttributeNumber(reader text.Reader) (interface{}, bool) {
-
-	var retval interface{}
-//	intnum := true
-//	sign := 1
+	start, _ := reader.PeekLine()
+	sign := 1.0
 	c := reader.Peek()
-/*
 	if c == '-' {
 		sign = -1
 		reader.Advance(1)
 	} else if c == '+' {
 		reader.Advance(1)
 	}
-*/
 	var buf bytes.Buffer
 	if !util.IsNumeric(reader.Peek()) {
-		return 0, false
+		return nil, false
 	}
 	scanAttributeDecimal(reader, &buf)
-	if buf.Len() == 0 {
-		return 0, false
-	}
 	c = reader.Peek()
 	if c == '.' {
-//		intnum = false
 		buf.WriteByte(c)
 		reader.Advance(1)
 		scanAttributeDecimal(reader, &buf)
 	}
-	c = reader.Peek()
-	if c == 'e' || c == 'E' {
-//		intnum = false
-		buf.WriteByte(c)
-		reader.Advance(1)
-		c = reader.Peek()
-		if c == '-' || c == '+' {
-			buf.WriteByte(c)
-			reader.Advance(1)
+	// an exponent needs digits: 2em is a length
+	line, _ := reader.PeekLine()
+	if len(line) > 1 && (line[0] == 'e' || line[0] == 'E') {
+		i := 1
+		if line[i] == '-' || line[i] == '+' {i++}
+		if i < len(line) && util.IsNumeric(line[i]) {
+			buf.Write(line[:i])
+			reader.Advance(i)
+			scanAttributeDecimal(reader, &buf)
 		}
-		scanAttributeDecimal(reader, &buf)
 	}
-//fmt.Printf("dbg -- intnum [%t]: %s\n", intnum, buf.String())
-
-		retval = buf.String()
-/*
-	if intnum {
-		intval, err := strconv.Atoi(buf.String())
-		if err != nil {return 0, false}
-		retval = intval
-	} else {
-		f, err := strconv.ParseFloat(buf.String(), 64)
-		if err != nil {return 0, false}
-		retval = float64(sign) * f
+	f, err := strconv.ParseFloat(buf.String(), 64)
+	if err != nil {
+		return nil, false
 	}
-*/
+	f *= sign
 
-	return retval, true
+	// unit
+	line, _ = reader.PeekLine()
+	i := 0
+	for ; i < len(line); i++ {
+		c = line[i]
+		if !((c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '%') {break}
+	}
+	reader.Advance(i)
+	value := Value{Text: start[:len(start)-len(line)+i], Typed: f}
+	if i > 0 {value.Typed = Length{Value: f, Unit: string(line[:i])}}
+	return value, true
 }
 
 var bytesTrue = []byte("true")
@@ -348,11 +376,8 @@ func parseAttributeOthers(reader text.Reader) (interface{}, bool) {
 	}
 	value := line[:i]
 	reader.Advance(i)
-	if bytes.Equal(value, bytesTrue) {
-		return true, true
-	}
-	if bytes.Equal(value, bytesFalse) {
-		return false, true
+	if bytes.Equal(value, bytesTrue) || bytes.Equal(value, bytesFalse) {
+		return Value{Text: value, Typed: value[0] == 't'}, true
 	}
 	if bytes.Equal(value, bytesNull) {
 		return nil, true
@@ -0,0 +1,76 @@
+// parse_test.go
+// tests of the attribute parser: the types of the values, numbers, lengths and booleans
+// with their source text, quoted strings, lists and the text the html renderer writes
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package attributes
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestParseAttributes(t *testing.T) {
+
+	tests := []struct {
+		src  string
+		want Attributes
+	}{
+		{"{#top .a .b}", Attributes{{attrNameID, []byte("top")}, {attrNameClass, []byte("a b")}}},
+		{"{n=2 f=1.10 neg=-2.50 e=1e3}", Attributes{
+			{[]byte("n"), Value{[]byte("2"), 2.0}},
+			{[]byte("f"), Value{[]byte("1.10"), 1.1}},
+			{[]byte("neg"), Value{[]byte("-2.50"), -2.5}},
+			{[]byte("e"), Value{[]byte("1e3"), 1000.0}},
+		}},
+		{"{w=50% h=12rem b=-2px}", Attributes{
+			{[]byte("w"), Value{[]byte("50%"), Length{50, "%"}}},
+			{[]byte("h"), Value{[]byte("12rem"), Length{12, "rem"}}},
+			{[]byte("b"), Value{[]byte("-2px"), Length{-2, "px"}}},
+		}},
+		{"{a=true b=false c=\"true\" d='x y' e=middle f=null}", Attributes{
+			{[]byte("a"), Value{[]byte("true"), true}},
+			{[]byte("b"), Value{[]byte("false"), false}},
+			{[]byte("c"), []byte("true")},
+			{[]byte("d"), []byte("x y")},
+			{[]byte("e"), []byte("middle")},
+			{[]byte("f"), nil},
+		}},
+		{"{l=[\"a\", 2, 3em, true]}", Attributes{
+			{[]byte("l"), []interface{}{[]byte("a"), Value{[]byte("2"), 2.0}, Value{[]byte("3em"), Length{3, "em"}}, Value{[]byte("true"), true}}},
+		}},
+	}
+	for _, test := range tests {
+		got, ok := ParseAttributes(text.NewReader([]byte(test.src)))
+		if !ok {
+			t.Errorf("%q: not parsed", test.src)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {t.Errorf("%q:\n got: %#v\nwant: %#v", test.src, got, test.want)}
+	}
+
+	for _, src := range []string{"{n=-}", "{class=1}", "{x}", "{y=\"open}"} {
+		if _, ok := ParseAttributes(text.NewReader([]byte(src))); ok {t.Errorf("%q parsed", src)}
+	}
+}
+
+// the html renderer writes the source text of typed values and lists
+func TestRenderHTML(t *testing.T) {
+
+	md := goldmark.New(goldmark.WithExtensions(Extension))
+	src := "para\n{tabindex=2 data-v=1.10 data-l=[a, 2.0] hidden=true}\n\n![i](i.png){width=50% height=100}\n"
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(src), &buf); err != nil {t.Fatal(err)}
+	want := `<p tabindex="2" data-v="1.10" data-l="a 2.0" hidden="true">para</p>` + "\n" +
+		`<p><img src="i.png" alt="i" width="50%" height="100"></p>`
+	if got := strings.TrimSpace(buf.String()); got != want {t.Errorf("\n got: %s\nwant: %s", got, want)}
+}
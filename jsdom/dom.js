@@ -0,0 +1,207 @@
+// dom.js
+// minimal fake dom to run the md2js scripts outside a browser
+// it provides document.createElement, createTextNode, createDocumentFragment and body,
+// and elements with appendChild, setAttribute, style, classList, dataset,
+// textContent, innerHTML, addEventListener (listeners are kept, not run) and the reflected
+// properties of the common attributes
+// __serialize returns a node tree as plain objects for the go side
+//
+// author: prr, azul software
+// copyright prr, azul software
+//
+(function (global) {
+
+	// properties that reflect an attribute
+	const reflected = {
+		id: 'id', className: 'class', href: 'href', src: 'src', alt: 'alt', title: 'title',
+		start: 'start', type: 'type', lang: 'lang', dir: 'dir', htmlFor: 'for', rel: 'rel',
+		target: 'target', width: 'width', height: 'height', colSpan: 'colspan', rowSpan: 'rowspan',
+		name: 'name', value: 'value', loading: 'loading', decoding: 'decoding', srcset: 'srcset', sizes: 'sizes',
+		role: 'role', tabIndex: 'tabindex', align: 'align',
+	};
+	// boolean properties that add or remove an attribute
+	const booleans = {hidden: 'hidden', disabled: 'disabled', checked: 'checked', open: 'open', reversed: 'reversed'};
+
+	function Text(data) {
+		this.nodeType = 3;
+		this.data = String(data);
+		this.parentNode = null;
+	}
+	Object.defineProperty(Text.prototype, 'textContent', {
+		get: function () {return this.data;},
+		set: function (v) {this.data = String(v);},
+	});
+
+	function Raw(html) {
+		this.nodeType = -1;
+		this.html = String(html);
+		this.parentNode = null;
+	}
+
+	function Fragment() {
+		this.nodeType = 11;
+		this.childNodes = [];
+	}
+
+	function Element(tag) {
+		this.nodeType = 1;
+		this.localName = String(tag).toLowerCase();
+		this.tagName = this.localName.toUpperCase();
+		this.childNodes = [];
+		this.attributes = [];
+		this.style = new Style();
+		this.parentNode = null;
+		const el = this;
+		this.dataset = new Proxy({}, {
+			get: function (t, k) {return el.getAttribute('data-' + kebab(String(k)));},
+			set: function (t, k, v) {el.setAttribute('data-' + kebab(String(k)), v); return true;},
+		});
+		this.classList = {
+			add: function () {for (const c of arguments) {classes(el, function (cl) {if (!cl.includes(c)) {cl.push(c);}});}},
+			remove: function () {for (const c of arguments) {classes(el, function (cl) {const i = cl.indexOf(c); if (i >= 0) {cl.splice(i, 1);}});}},
+			contains: function (c) {return (el.getAttribute('class') || '').split(/\s+/).includes(c);},
+		};
+	}
+
+	// Style holds the style properties in camel case; the methods are not enumerable
+	function Style() {}
+	Object.defineProperty(Style.prototype, 'setProperty', {value: function (k, v) {this[camel(k)] = String(v);}});
+	Object.defineProperty(Style.prototype, 'getPropertyValue', {value: function (k) {return this[camel(k)] || '';}});
+	Object.defineProperty(Style.prototype, 'removeProperty', {value: function (k) {delete this[camel(k)];}});
+
+	function camel(s) {
+		if (s.startsWith('--')) {return s;}
+		return s.replace(/-([a-z])/g, function (m, c) {return c.toUpperCase();});
+	}
+
+	function kebab(s) {
+		return s.replace(/[A-Z]/g, function (c) {return '-' + c.toLowerCase();});
+	}
+
+	function classes(el, fn) {
+		const cur = el.getAttribute('class');
+		const cl = cur ? cur.split(/\s+/).filter(function (c) {return c.length > 0;}) : [];
+		fn(cl);
+		el.setAttribute('class', cl.join(' '));
+	}
+
+	function appendTo(parent, child) {
+		if (child === null || typeof child !== 'object' || !('nodeType' in child)) {
+			throw new TypeError('appendChild: parameter is not a node');
+		}
+		if (child.nodeType === 11) {
+			for (const c of child.childNodes) {appendTo(parent, c);}
+			child.childNodes = [];
+			return child;
+		}
+		if (child.parentNode) {
+			const sib = child.parentNode.childNodes;
+			sib.splice(sib.indexOf(child), 1);
+		}
+		child.parentNode = parent;
+		parent.childNodes.push(child);
+		return child;
+	}
+
+	Fragment.prototype.appendChild = function (c) {return appendTo(this, c);};
+	Element.prototype.appendChild = function (c) {return appendTo(this, c);};
+	Element.prototype.append = function () {
+		for (const c of arguments) {appendTo(this, typeof c === 'string' ? new Text(c) : c);}
+	};
+	Element.prototype.setAttribute = function (n, v) {
+		n = String(n).toLowerCase();
+		v = String(v);
+		for (const a of this.attributes) {
+			if (a.name === n) {a.value = v; return;}
+		}
+		this.attributes.push({name: n, value: v});
+	};
+	Element.prototype.getAttribute = function (n) {
+		n = String(n).toLowerCase();
+		for (const a of this.attributes) {
+			if (a.name === n) {return a.value;}
+		}
+		return null;
+	};
+	Element.prototype.addEventListener = function (typ, fn) {
+		(this.listeners = this.listeners || []).push({type: String(typ), fn: fn});
+	};
+	Element.prototype.hasAttribute = function (n) {return this.getAttribute(n) !== null;};
+	Element.prototype.removeAttribute = function (n) {
+		n = String(n).toLowerCase();
+		this.attributes = this.attributes.filter(function (a) {return a.name !== n;});
+	};
+	Object.defineProperty(Element.prototype, 'textContent', {
+		get: function () {
+			return this.childNodes.map(function (c) {return c.nodeType === -1 ? '' : c.textContent;}).join('');
+		},
+		set: function (v) {
+			this.childNodes = [];
+			appendTo(this, new Text(v));
+		},
+	});
+	Object.defineProperty(Element.prototype, 'innerHTML', {
+		get: function () {return '';},
+		set: function (v) {
+			this.childNodes = [];
+			appendTo(this, new Raw(v));
+		},
+	});
+	for (const prop in reflected) {
+		const attr = reflected[prop];
+		Object.defineProperty(Element.prototype, prop, {
+			get: function () {const v = this.getAttribute(attr); return v === null ? '' : v;},
+			set: function (v) {this.setAttribute(attr, v);},
+		});
+	}
+	for (const prop in booleans) {
+		const attr = booleans[prop];
+		Object.defineProperty(Element.prototype, prop, {
+			get: function () {return this.hasAttribute(attr);},
+			set: function (v) {if (v) {this.setAttribute(attr, '');} else {this.removeAttribute(attr);}},
+		});
+	}
+
+	const document = {
+		createElement: function (tag) {return new Element(tag);},
+		createTextNode: function (data) {return new Text(data);},
+		createDocumentFragment: function () {return new Fragment();},
+		getElementById: function (id) {return find(document.body, function (el) {return el.getAttribute('id') === String(id);});},
+	};
+	document.body = new Element('body');
+	document.documentElement = new Element('html');
+	document.documentElement.appendChild(document.body);
+
+	function find(el, fn) {
+		for (const c of el.childNodes) {
+			if (c.nodeType !== 1) {continue;}
+			if (fn(c)) {return c;}
+			const r = find(c, fn);
+			if (r) {return r;}
+		}
+		return null;
+	}
+
+	// mdStyle stands in for a missing theme: every style is empty
+	global.mdStyle = new Proxy({}, {get: function () {return {};}});
+	global.document = document;
+	global.window = global;
+
+	// __serialize returns the tree of a node: elements {tag, attrs, style, children},
+	// texts {text} and innerHTML strings {html}
+	global.__serialize = function (node) {
+		if (node.nodeType === 3) {return {text: node.data};}
+		if (node.nodeType === -1) {return {html: node.html};}
+		const style = {};
+		for (const k in node.style) {
+			const v = node.style[k];
+			if (v !== undefined && v !== null && v !== '') {style[kebab(k)] = String(v);}
+		}
+		return {
+			tag: node.localName,
+			attrs: node.attributes.map(function (a) {return [a.name, a.value];}),
+			style: style,
+			children: node.childNodes.map(global.__serialize),
+		};
+	};
+})(this);
@@ -0,0 +1,316 @@
+// jsdom.go
+// package jsdom runs md2js scripts in the embedded js engine goja with a minimal
+// fake dom and the azul runtime, and turns the resulting dom into a normalised tree
+// that can be compared with the html of the goldmark html renderer
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package jsdom
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+//go:embed dom.js
+var domJS string
+
+// A Node is a node of a normalised dom tree. Text nodes have an empty Tag.
+type Node struct {
+	Tag      string
+	Text     string
+	Attrs    []Attr
+	Children []*Node
+}
+
+// An Attr is an attribute of an element.
+type Attr struct {
+	Name, Value string
+}
+
+// RunOptions configures Run.
+type RunOptions struct {
+	// Runtime is the azul runtime script.
+	Runtime []byte
+	// Timeout stops a script that runs too long. It defaults to 5s.
+	Timeout time.Duration
+}
+
+// jsNode is the serialised form of a dom node returned by __serialize.
+type jsNode struct {
+	Tag      string            `json:"tag"`
+	Attrs    [][2]string       `json:"attrs"`
+	Style    map[string]string `json:"style"`
+	Children []jsNode          `json:"children"`
+	Text     *string           `json:"text"`
+	HTML     *string           `json:"html"`
+}
+
+// Run executes the runtime and a md2js script and calls site.render().
+// It returns the element that site.render returns (the mdDiv element).
+// Strings assigned to innerHTML are parsed as html.
+func Run(script []byte, ro RunOptions) (root *Node, err error) {
+
+	vm := goja.New()
+	timeout := ro.Timeout
+	if timeout <= 0 {timeout = 5 * time.Second}
+	timer := time.AfterFunc(timeout, func() {vm.Interrupt("timeout")})
+	defer timer.Stop()
+
+	if _, err := vm.RunScript("dom.js", domJS); err != nil {return nil, fmt.Errorf("dom: %v", err)}
+	if _, err := vm.RunScript("azul.js", string(ro.Runtime)); err != nil {return nil, fmt.Errorf("runtime: %v", err)}
+	// the script and the render call share one scope, as the script declares site with let
+	src := string(script) + "\n;JSON.stringify(__serialize(site.render()));\n"
+	val, err := vm.RunScript("script.js", src)
+	if err != nil {return nil, fmt.Errorf("script: %v", err)}
+
+	var jn jsNode
+	if err := json.Unmarshal([]byte(val.String()), &jn); err != nil {return nil, fmt.Errorf("serialize: %v", err)}
+	return jn.node()
+}
+
+// node converts a serialised node. The style becomes the style attribute.
+func (jn *jsNode) node() (*Node, error) {
+	if jn.Text != nil {return &Node{Text: *jn.Text}, nil}
+	n := &Node{Tag: jn.Tag}
+	for _, a := range jn.Attrs {n.Attrs = append(n.Attrs, Attr{Name: a[0], Value: a[1]})}
+	if len(jn.Style) > 0 {
+		keys := make([]string, 0, len(jn.Style))
+		for k := range jn.Style {keys = append(keys, k)}
+		sort.Strings(keys)
+		decl := make([]string, len(keys))
+		for i, k := range keys {decl[i] = k + ": " + jn.Style[k]}
+		n.Attrs = append(n.Attrs, Attr{Name: "style", Value: strings.Join(decl, "; ")})
+	}
+	for i := range jn.Children {
+		c := &jn.Children[i]
+		if c.HTML != nil {
+			frag, err := ParseHTML(*c.HTML, n.Tag)
+			if err != nil {return nil, err}
+			n.Children = append(n.Children, frag.Children...)
+			continue
+		}
+		cn, err := c.node()
+		if err != nil {return nil, err}
+		n.Children = append(n.Children, cn)
+	}
+	return n, nil
+}
+
+// ParseHTML parses an html fragment in the context of an element and
+// returns an element of that tag with the fragment as children.
+func ParseHTML(src, contextTag string) (*Node, error) {
+	if len(contextTag) == 0 {contextTag = "div"}
+	ctx := &html.Node{Type: html.ElementNode, Data: contextTag, DataAtom: atom.Lookup([]byte(contextTag))}
+	nodes, err := html.ParseFragment(strings.NewReader(src), ctx)
+	if err != nil {return nil, err}
+	root := &Node{Tag: contextTag}
+	for _, hn := range nodes {
+		if n := convertHTML(hn); n != nil {root.Children = append(root.Children, n)}
+	}
+	return root, nil
+}
+
+func convertHTML(hn *html.Node) *Node {
+	switch hn.Type {
+	case html.TextNode:
+		return &Node{Text: hn.Data}
+	case html.ElementNode:
+		n := &Node{Tag: hn.Data}
+		for _, a := range hn.Attr {n.Attrs = append(n.Attrs, Attr{Name: a.Key, Value: a.Val})}
+		for c := hn.FirstChild; c != nil; c = c.NextSibling {
+			if cn := convertHTML(c); cn != nil {n.Children = append(n.Children, cn)}
+		}
+		return n
+	}
+	// comments and doctypes are dropped
+	return nil
+}
+
+// NormOptions configures Normalize.
+type NormOptions struct {
+	// KeepStyle keeps the style attributes, which the goldmark html renderer does not write.
+	KeepStyle bool
+}
+
+// preTags keep their white space.
+var preTags = map[string]bool{"pre": true, "textarea": true}
+
+// inlineTags are the phrasing elements: the white space around them is part of the line.
+var inlineTags = map[string]bool{
+	"a": true, "abbr": true, "b": true, "bdi": true, "bdo": true, "br": true, "cite": true, "code": true,
+	"data": true, "del": true, "dfn": true, "em": true, "i": true, "img": true, "input": true, "ins": true,
+	"kbd": true, "label": true, "mark": true, "q": true, "s": true, "samp": true, "small": true, "span": true,
+	"strong": true, "sub": true, "sup": true, "time": true, "u": true, "var": true, "wbr": true,
+}
+
+// Normalize returns a copy of the tree for comparison: adjacent texts are merged,
+// runs of white space are collapsed to one space outside of pre, the white space at
+// the start and end of a block, around a br and after a space is dropped, empty texts
+// are dropped and the attributes are sorted. The space between inline elements is kept.
+func Normalize(n *Node, no NormOptions) *Node {
+	out := normalize(n, no, false)
+	if len(out.Tag) > 0 && !preTags[out.Tag] {trimSpace(out)}
+	return out
+}
+
+func normalize(n *Node, no NormOptions, pre bool) *Node {
+	if len(n.Tag) == 0 {return &Node{Text: n.Text}}
+	out := &Node{Tag: n.Tag}
+	for _, a := range n.Attrs {
+		if a.Name == "style" && !no.KeepStyle {continue}
+		out.Attrs = append(out.Attrs, a)
+	}
+	sort.Slice(out.Attrs, func(i, j int) bool {return out.Attrs[i].Name < out.Attrs[j].Name})
+
+	pre = pre || preTags[n.Tag]
+	var text strings.Builder
+	flush := func() {
+		if text.Len() == 0 {return}
+		t := text.String()
+		text.Reset()
+		if !pre {t = collapseSpace(t)}
+		out.Children = append(out.Children, &Node{Text: t})
+	}
+	for _, c := range n.Children {
+		if len(c.Tag) == 0 {
+			text.WriteString(c.Text)
+			continue
+		}
+		flush()
+		out.Children = append(out.Children, normalize(c, no, pre))
+	}
+	flush()
+	return out
+}
+
+// collapseSpace replaces every run of white space by one space.
+func collapseSpace(t string) string {
+	var sb strings.Builder
+	space := false
+	for _, r := range t {
+		switch r {
+		case ' ', '\t', '\n', '\r', '\f':
+			space = true
+			continue
+		}
+		if space {sb.WriteByte(' ')}
+		space = false
+		sb.WriteRune(r)
+	}
+	if space {sb.WriteByte(' ')}
+	return sb.String()
+}
+
+// trimSpace drops the spaces of the collapsed texts of a block the browser does not show:
+// at the start and end of a line, which a block or a br ends, and after a space.
+// The texts that become empty are removed.
+func trimSpace(block *Node) {
+	// last is the last text of the line, start is set until the line has content
+	var last *Node
+	start := true
+	lineEnd := func() {
+		if last != nil {last.Text = strings.TrimSuffix(last.Text, " ")}
+		last = nil
+		start = true
+	}
+	var walk func(n *Node)
+	walk = func(n *Node) {
+		for _, c := range n.Children {
+			switch {
+			case len(c.Tag) == 0:
+				if start || (last != nil && strings.HasSuffix(last.Text, " ")) {c.Text = strings.TrimPrefix(c.Text, " ")}
+				if len(c.Text) > 0 {
+					last = c
+					start = false
+				}
+			case c.Tag == "br":
+				lineEnd()
+			case c.Tag == "img" || c.Tag == "input" || c.Tag == "wbr":
+				// content without text: the space before it stays
+				last = nil
+				start = false
+			case inlineTags[c.Tag]:
+				walk(c)
+			case preTags[c.Tag]:
+				lineEnd()
+			default:
+				lineEnd()
+				walk(c)
+				lineEnd()
+			}
+		}
+	}
+	walk(block)
+	lineEnd()
+	dropEmpty(block)
+}
+
+// dropEmpty removes the empty texts of the tree.
+func dropEmpty(n *Node) {
+	if preTags[n.Tag] {return}
+	kept := n.Children[:0]
+	for _, c := range n.Children {
+		if len(c.Tag) == 0 && len(c.Text) == 0 {continue}
+		if len(c.Tag) > 0 {dropEmpty(c)}
+		kept = append(kept, c)
+	}
+	n.Children = kept
+}
+
+// Lines returns the tree as indented lines, one node per line.
+// It is the form used for the structural diff.
+func (n *Node) Lines() []string {
+	var lines []string
+	var walk func(n *Node, depth int)
+	walk = func(n *Node, depth int) {
+		ind := strings.Repeat("  ", depth)
+		if len(n.Tag) == 0 {
+			lines = append(lines, ind+strconv.Quote(n.Text))
+			return
+		}
+		var sb strings.Builder
+		sb.WriteString(ind + "<" + n.Tag)
+		for _, a := range n.Attrs {sb.WriteString(" " + a.Name + "=" + strconv.Quote(a.Value))}
+		sb.WriteString(">")
+		lines = append(lines, sb.String())
+		for _, c := range n.Children {walk(c, depth+1)}
+	}
+	walk(n, 0)
+	return lines
+}
+
+// HTML serialises the children of the node as html.
+func (n *Node) HTML() string {
+	var sb strings.Builder
+	for _, c := range n.Children {c.writeHTML(&sb)}
+	return sb.String()
+}
+
+// voidTags have no end tag.
+var voidTags = map[string]bool{"br": true, "hr": true, "img": true, "input": true, "meta": true, "link": true, "col": true, "wbr": true}
+
+func (n *Node) writeHTML(sb *strings.Builder) {
+	if len(n.Tag) == 0 {
+		sb.WriteString(html.EscapeString(n.Text))
+		return
+	}
+	sb.WriteString("<" + n.Tag)
+	for _, a := range n.Attrs {sb.WriteString(" " + a.Name + "=\"" + html.EscapeString(a.Value) + "\"")}
+	sb.WriteString(">")
+	if voidTags[n.Tag] {return}
+	for _, c := range n.Children {c.writeHTML(sb)}
+	sb.WriteString("</" + n.Tag + ">")
+}
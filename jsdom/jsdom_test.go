@@ -0,0 +1,110 @@
+// jsdom_test.go
+// tests of the fake dom, the normalisation and the verification against goldmark
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package jsdom
+
+import (
+	"strings"
+	"testing"
+
+	"goDemo/goldmark/samples/azul"
+)
+
+func runScript(t *testing.T, script string) *Node {
+	t.Helper()
+	runtime, err := azul.Runtime(azul.Latest)
+	if err != nil {t.Fatal(err)}
+	root, err := Run([]byte(script), RunOptions{Runtime: runtime})
+	if err != nil {t.Fatal(err)}
+	return root
+}
+
+func TestRunDom(t *testing.T) {
+
+	root := runScript(t, `
+let site = {render: function() {
+	let div = document.createElement('div');
+	let p = document.createElement('p');
+	p.className = 'a b';
+	p.dataset.lineNo = '3';
+	p.style.color = 'red';
+	p.textContent = 'x < y';
+	div.appendChild(p);
+	let s = document.createElement('span');
+	s.innerHTML = '<em>e</em> &amp; t';
+	div.appendChild(s);
+	return div;
+}};`)
+
+	got := root.HTML()
+	want := `<p class="a b" data-line-no="3" style="color: red">x &lt; y</p><span><em>e</em> &amp; t</span>`
+	if got != want {t.Errorf("html:\n got: %s\nwant: %s", got, want)}
+}
+
+func TestNormalize(t *testing.T) {
+
+	n, err := ParseHTML("<p style=\"color: red\" id=\"a\">one\n  two <em>three</em>  </p>\n<pre>a\n  b</pre>", "div")
+	if err != nil {t.Fatal(err)}
+	got := strings.Join(Normalize(n, NormOptions{}).Lines(), "\n")
+	want := strings.Join([]string{
+		`<div>`,
+		`  <p id="a">`,
+		`    "one two "`,
+		`    <em>`,
+		`      "three"`,
+		`  <pre>`,
+		`    "a\n  b"`,
+	}, "\n")
+	if got != want {t.Errorf("lines:\n got:\n%s\nwant:\n%s", got, want)}
+
+	// the space between inline elements is kept, the space at block boundaries and br is not
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"<p>a <em>b</em> c</p>", "<p>a <em>b</em> c</p>"},
+		{"<p>a<em>b</em>c</p>", "<p>a<em>b</em>c</p>"},
+		{"<p> a <em> b </em> c </p>", "<p>a <em>b </em>c</p>"},
+		{"<ul>\n<li>\n<p> x </p>\n</li>\n</ul>", "<ul><li><p>x</p></li></ul>"},
+		{"<p>a <br>\n b <img src=\"i\"> c</p>", "<p>a<br>b <img src=\"i\"> c</p>"},
+	}
+	for _, test := range tests {
+		n, err := ParseHTML(test.src, "div")
+		if err != nil {t.Fatal(err)}
+		if got := Normalize(n, NormOptions{}).HTML(); got != test.want {t.Errorf("%q:\n got: %s\nwant: %s", test.src, got, test.want)}
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+
+	a := []string{"a", "b", "c", "d", "e"}
+	if d := DiffLines(a, a, 1); d != nil {t.Errorf("equal inputs: %v", d)}
+
+	b := []string{"a", "b", "x", "d", "e"}
+	got := strings.Join(DiffLines(a, b, 1), "|")
+	want := " b|-c|+x| d"
+	if got != want {t.Errorf("diff: got %q, want %q", got, want)}
+}
+
+func TestVerify(t *testing.T) {
+
+	docs := map[string]string{
+		"headings":  "# One\n\n## Two\n\ntext\n",
+		"emphasis":  "a *b* and **c**\n",
+		"link":      "see [the docs](http://example.com/docs \"Docs\")\n",
+		"list":      "- one\n- two\n\n1. three\n2. four\n",
+		"codeblock": "    func main() {}\n",
+		"quote":     "> quoted\n",
+		"rule":      "a\n\n---\n\nb\n",
+	}
+	for nam, doc := range docs {
+		t.Run(nam, func(t *testing.T) {
+			AssertSameDOM(t, []byte(doc), VerifyOptions{})
+		})
+	}
+}
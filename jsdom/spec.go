@@ -0,0 +1,201 @@
+// spec.go
+// commonmark conformance of the md2jsV3 renderer: the examples of the spec are rendered
+// into scripts, run with the fake dom and compared with the html of the spec
+// the result is reported per spec section
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package jsdom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark"
+)
+
+// SpecFile is the commonmark spec fixture, relative to the repository root.
+const SpecFile = "jsdom/testdata/commonmark-spec-0.31.2.json"
+
+// A SpecExample is an example of the commonmark spec.
+type SpecExample struct {
+	Markdown  string `json:"markdown"`
+	HTML      string `json:"html"`
+	Example   int    `json:"example"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+	Section   string `json:"section"`
+}
+
+// LoadSpec reads the examples of a spec fixture.
+func LoadSpec(fil string) (examples []SpecExample, err error) {
+	data, err := os.ReadFile(fil)
+	if err != nil {return nil, err}
+	if err := json.Unmarshal(data, &examples); err != nil {return nil, fmt.Errorf("%s: %v", fil, err)}
+	return examples, nil
+}
+
+// SpecOptions configures RunSpec.
+type SpecOptions struct {
+	// Runtime is the azul runtime script.
+	Runtime []byte
+	// RendererOptions are passed to the md2jsV3 renderer. The spec expects raw html to be rendered (md2js.WithUnsafe).
+	RendererOptions []md2js.Option
+	Norm            NormOptions
+	// Sections restricts the run to these sections; empty runs all.
+	Sections []string
+}
+
+// An ExampleResult is the outcome of a spec example.
+type ExampleResult struct {
+	Example int    `json:"example"`
+	Section string `json:"section"`
+	Pass    bool   `json:"pass"`
+	// Error is the render or script error of a failed example.
+	Error string `json:"error,omitempty"`
+	// Diff lists the differing lines of a failed example: '-' for the spec, '+' for the script.
+	Diff []string `json:"diff,omitempty"`
+}
+
+// A SectionResult counts the examples of a spec section.
+type SectionResult struct {
+	Name   string `json:"name"`
+	Passed int    `json:"passed"`
+	Failed int    `json:"failed"`
+	// Failures are the numbers of the failed examples.
+	Failures []int `json:"failures,omitempty"`
+}
+
+// A SpecReport is the result of a conformance run. Sections are in spec order.
+type SpecReport struct {
+	Passed   int             `json:"passed"`
+	Failed   int             `json:"failed"`
+	Sections []SectionResult `json:"sections"`
+	Examples []ExampleResult `json:"examples"`
+}
+
+// RunSpec renders the examples with the md2jsV3 renderer and compares the dom of
+// the scripts with the html of the spec.
+func RunSpec(ctx context.Context, examples []SpecExample, so SpecOptions) (*SpecReport, error) {
+
+	sel := make(map[string]bool)
+	for _, s := range so.Sections {sel[strings.ToLower(s)] = true}
+
+	rep := &SpecReport{}
+	idx := make(map[string]int)
+	for _, ex := range examples {
+		if len(sel) > 0 && !sel[strings.ToLower(ex.Section)] {continue}
+		if err := ctx.Err(); err != nil {return nil, err}
+
+		res := runExample(ex, so)
+		rep.Examples = append(rep.Examples, res)
+
+		i, ok := idx[ex.Section]
+		if !ok {
+			i = len(rep.Sections)
+			idx[ex.Section] = i
+			rep.Sections = append(rep.Sections, SectionResult{Name: ex.Section})
+		}
+		sec := &rep.Sections[i]
+		if res.Pass {
+			sec.Passed++
+			rep.Passed++
+			continue
+		}
+		sec.Failed++
+		sec.Failures = append(sec.Failures, ex.Example)
+		rep.Failed++
+	}
+	return rep, nil
+}
+
+func runExample(ex SpecExample, so SpecOptions) (res ExampleResult) {
+
+	res = ExampleResult{Example: ex.Example, Section: ex.Section}
+
+	script, err := RenderScript([]byte(ex.Markdown), so.RendererOptions...)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	root, err := Run(script, RunOptions{Runtime: so.Runtime})
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	want, err := ParseHTML(ex.HTML, "div")
+	if err != nil {
+		res.Error = fmt.Sprintf("parse spec html: %v", err)
+		return res
+	}
+	got := &Node{Tag: "div", Children: root.Children}
+	res.Diff = DiffLines(Normalize(want, so.Norm).Lines(), Normalize(got, so.Norm).Lines(), 1)
+	res.Pass = len(res.Diff) == 0
+	return res
+}
+
+// RenderScript renders a markdown source into a script that defines site.render.
+// No theme is written; the fake dom provides empty styles.
+// As in the spec, headings get no automatic ids.
+// A panic of a node renderer is returned as an error.
+func RenderScript(src []byte, opts ...md2js.Option) (script []byte, err error) {
+
+	md := goldmark.New()
+	md.SetRenderer(md2js.GetRenderer("spec", false, opts...))
+
+	defer func() {
+		if r := recover(); r != nil {err = fmt.Errorf("renderer panic: %v", r)}
+	}()
+	var buf bytes.Buffer
+	buf.Write(md2js.JSRenderStartFunc())
+	if err := md.Convert(src, &buf); err != nil {return nil, fmt.Errorf("render: %v", err)}
+	return buf.Bytes(), nil
+}
+
+// WriteText writes the pass counts per section and the total.
+// With verbose, the errors and diffs of the failed examples follow.
+func (rep *SpecReport) WriteText(w io.Writer, verbose bool) error {
+
+	width := len("total")
+	for _, sec := range rep.Sections {
+		if len(sec.Name) > width {width = len(sec.Name)}
+	}
+	line := func(nam string, passed, failed int) {
+		total := passed + failed
+		pct := 0.0
+		if total > 0 {pct = 100 * float64(passed) / float64(total)}
+		fmt.Fprintf(w, "%-*s %4d/%-4d %5.1f%%\n", width, nam, passed, total, pct)
+	}
+	for _, sec := range rep.Sections {line(sec.Name, sec.Passed, sec.Failed)}
+	line("total", rep.Passed, rep.Failed)
+
+	if !verbose {return nil}
+	for _, ex := range rep.Examples {
+		if ex.Pass {continue}
+		fmt.Fprintf(w, "\nexample %d (%s):\n", ex.Example, ex.Section)
+		if len(ex.Error) > 0 {
+			fmt.Fprintf(w, "  error: %s\n", ex.Error)
+			continue
+		}
+		for _, l := range ex.Diff {fmt.Fprintf(w, "  %s\n", l)}
+	}
+	return nil
+}
+
+// WriteJSON writes the report as json.
+func (rep *SpecReport) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {return err}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
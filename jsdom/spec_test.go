@@ -0,0 +1,72 @@
+// spec_test.go
+// commonmark conformance test: the passed examples per spec section must not drop
+// below the baseline in testdata/commonmark-baseline.json
+//
+// record the baseline after the renderer improved:
+//   go test ./jsdom -run TestSpecConformance -update
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package jsdom
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"goDemo/goldmark/samples/azul"
+	md2js "goDemo/goldmark/samples/rendererV3"
+)
+
+var update = flag.Bool("update", false, "rewrite the conformance baseline")
+
+const baselineFile = "testdata/commonmark-baseline.json"
+
+func TestSpecConformance(t *testing.T) {
+
+	examples, err := LoadSpec(filepath.Join("..", SpecFile))
+	if err != nil {t.Fatal(err)}
+	runtime, err := azul.Runtime(azul.Latest)
+	if err != nil {t.Fatal(err)}
+
+	rep, err := RunSpec(context.Background(), examples, SpecOptions{
+		Runtime:         runtime,
+		RendererOptions: []md2js.Option{md2js.WithUnsafe()},
+	})
+	if err != nil {t.Fatal(err)}
+
+	var sb strings.Builder
+	rep.WriteText(&sb, false)
+	t.Logf("commonmark conformance:\n%s", sb.String())
+
+	passed := make(map[string]int)
+	for _, sec := range rep.Sections {passed[sec.Name] = sec.Passed}
+
+	if *update {
+		data, err := json.MarshalIndent(passed, "", "  ")
+		if err != nil {t.Fatal(err)}
+		if err := os.WriteFile(baselineFile, append(data, '\n'), 0666); err != nil {t.Fatal(err)}
+		return
+	}
+
+	data, err := os.ReadFile(baselineFile)
+	if err != nil {t.Fatalf("%v (run with -update to create the baseline)", err)}
+	var baseline map[string]int
+	if err := json.Unmarshal(data, &baseline); err != nil {t.Fatal(err)}
+	for _, sec := range rep.Sections {
+		base := baseline[sec.Name]
+		switch {
+		case sec.Passed < base:
+			t.Errorf("%s: %d examples pass, baseline %d (failed: %v)", sec.Name, sec.Passed, base, sec.Failures)
+		case sec.Passed > base:
+			t.Logf("%s: %d examples pass, baseline %d; update the baseline", sec.Name, sec.Passed, base)
+		}
+	}
+}
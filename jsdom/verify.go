@@ -0,0 +1,168 @@
+// verify.go
+// verification of a md2js conversion: the script is run with the fake dom and the
+// resulting tree is compared with the html of the goldmark html renderer
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package jsdom
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"goDemo/goldmark/samples/azul"
+	"goDemo/goldmark/samples/md2jsLib"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+)
+
+// VerifyOptions configures Verify.
+type VerifyOptions struct {
+	// Options are the md2jsLib conversion options. Style and Site are usually empty:
+	// a missing theme is replaced by empty styles and the document is rendered by site.render.
+	Options md2jsLib.Options
+	// HTMLOptions are the options of the goldmark html renderer that match Options.RendererOptions.
+	HTMLOptions []renderer.Option
+	Norm        NormOptions
+}
+
+// A Report is the result of a verification.
+type Report struct {
+	// JS is the normalised dom built by the script, Want the normalised goldmark html.
+	JS, Want *Node
+	// Diff lists the differing lines of the trees: '-' for goldmark, '+' for the script.
+	Diff []string
+	// Diagnostics of the conversion.
+	Diagnostics []md2jsLib.Diagnostic
+}
+
+// Equal reports whether the script builds the same tree as the goldmark html.
+func (rep *Report) Equal() bool {
+	return len(rep.Diff) == 0
+}
+
+// Verify converts a markdown source, runs the script and compares the dom with the goldmark html.
+func Verify(ctx context.Context, src []byte, vo VerifyOptions) (*Report, error) {
+
+	res, err := md2jsLib.Convert(ctx, src, vo.Options)
+	if err != nil {return nil, fmt.Errorf("convert: %v", err)}
+	runtime, err := azul.Runtime(vo.Options.Azul)
+	if err != nil {return nil, err}
+
+	root, err := Run(res.JS, RunOptions{Runtime: runtime})
+	if err != nil {return nil, err}
+	// the mdDiv container has no goldmark counterpart
+	root = &Node{Tag: "div", Children: root.Children}
+
+	// goldmark renders the main section that md2jsLib converts
+	parts, err := md2jsLib.SplitSource(src)
+	if err != nil {return nil, err}
+	md := goldmark.New(
+		goldmark.WithExtensions(vo.Options.Extensions...),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+		goldmark.WithRendererOptions(vo.HTMLOptions...),
+	)
+	var buf bytes.Buffer
+	if err := md.Convert(parts.Main, &buf); err != nil {return nil, fmt.Errorf("goldmark: %v", err)}
+	want, err := ParseHTML(buf.String(), "div")
+	if err != nil {return nil, fmt.Errorf("parse goldmark html: %v", err)}
+
+	rep := &Report{
+		JS:          Normalize(root, vo.Norm),
+		Want:        Normalize(want, vo.Norm),
+		Diagnostics: res.Diagnostics,
+	}
+	rep.Diff = DiffLines(rep.Want.Lines(), rep.JS.Lines(), 1)
+	return rep, nil
+}
+
+// TB is the part of testing.TB that AssertSameDOM uses, so the package does not
+// depend on the testing package.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
+// AssertSameDOM is a test helper that fails t if the script of src does not build
+// the same tree as the goldmark html.
+func AssertSameDOM(t TB, src []byte, vo VerifyOptions) {
+	t.Helper()
+	rep, err := Verify(context.Background(), src, vo)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if !rep.Equal() {
+		t.Errorf("dom differs from goldmark html (- goldmark, + md2js):\n%s", strings.Join(rep.Diff, "\n"))
+	}
+}
+
+// DiffLines returns the differing lines of a and b with ctx lines of context.
+// Removed lines start with '-', added lines with '+', context lines with ' ';
+// skipped lines are marked by '...'. Equal inputs return nil.
+func DiffLines(a, b []string, ctx int) []string {
+
+	// lcs[i][j] is the length of the lcs of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {lcs[i] = make([]int, len(b)+1)}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []string
+	changed := false
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, " "+a[i])
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] > lcs[i+1][j]):
+			ops = append(ops, "+"+b[j])
+			j++
+			changed = true
+		default:
+			ops = append(ops, "-"+a[i])
+			i++
+			changed = true
+		}
+	}
+	if !changed {return nil}
+
+	// keep the changes and their context
+	keep := make([]bool, len(ops))
+	for k, op := range ops {
+		if op[0] == ' ' {continue}
+		for c := k - ctx; c <= k+ctx; c++ {
+			if c >= 0 && c < len(ops) {keep[c] = true}
+		}
+	}
+	var out []string
+	skipped := false
+	for k, op := range ops {
+		if !keep[k] {
+			skipped = true
+			continue
+		}
+		if skipped && len(out) > 0 {out = append(out, "...")}
+		skipped = false
+		out = append(out, op)
+	}
+	return out
+}
@@ -0,0 +1,122 @@
+// astCmd.go
+// ast command: dumps the goldmark ast of markdown files as text, json, yaml or dot
+// with source line ranges
+// ast diff: reports the structural changes between two markdown files
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"goDemo/goldmark/samples/astLib"
+)
+
+func runAst(args []string) int {
+
+	if len(args) > 0 && args[0] == "diff" {return runAstDiff(args[1:])}
+
+	fs := newFlagSet("ast", "files... | diff old.md new.md")
+	out := fs.String("o", "", "output file or directory (default: stdout)")
+	format := fs.String("format", "text", "output format: "+strings.Join(astLib.Formats, ", "))
+	config := addConfigFlag(fs)
+	ext := addExtFlags(fs)
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+	if len(pos) == 0 || !validFormat(*format) {
+		fs.Usage()
+		return exitUsage
+	}
+
+	inputs, err := expandInputs(pos, ".md")
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	cfg, ok := loadConfig(*config)
+	if !ok {return exitFail}
+
+	multi := len(inputs) > 1
+	status := exitOK
+	for _, in := range inputs {
+		// without -o all dumps go to stdout
+		outFil := "-"
+		if len(*out) > 0 {outFil, err = outPath(*out, in, astLib.FormatExt(*format), multi)}
+		if err != nil {
+			errorf("%v", err)
+			return exitUsage
+		}
+		source, err := readInput(in.path)
+		if err != nil {
+			errorf("%v", err)
+			status = exitFail
+			continue
+		}
+		doc := astLib.Parse(source, ext.extensions(cfg.For(cfgPath(in.path)).Goldmark()))
+		tree := astLib.Build(doc, source)
+
+		var buf bytes.Buffer
+		if multi && outFil == "-" {fmt.Fprintf(&buf, "==== %s ====\n", in.path)}
+		err = astLib.Write(&buf, tree, *format)
+		if err == nil {err = writeOutput(outFil, buf.Bytes())}
+		if err != nil {
+			errorf("%s: %v", in.path, err)
+			status = exitFail
+		}
+	}
+	return status
+}
+
+func validFormat(format string) bool {
+	for _, f := range astLib.Formats {
+		if f == format {return true}
+	}
+	return false
+}
+
+func runAstDiff(args []string) int {
+
+	fs := newFlagSet("ast diff", "old.md new.md")
+	format := fs.String("format", "text", "output format: text, json")
+	config := addConfigFlag(fs)
+	ext := addExtFlags(fs)
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+	if len(pos) != 2 || (*format != "text" && *format != "json") {
+		fs.Usage()
+		return exitUsage
+	}
+
+	cfg, ok := loadConfig(*config)
+	if !ok {return exitFail}
+
+	var trees [2]*astLib.Node
+	for i, fil := range pos {
+		source, err := readInput(fil)
+		if err != nil {
+			errorf("%v", err)
+			return exitFail
+		}
+		doc := astLib.Parse(source, ext.extensions(cfg.For(cfgPath(fil)).Goldmark()))
+		trees[i] = astLib.Build(doc, source)
+	}
+
+	changes := astLib.Diff(trees[0], trees[1])
+	var buf bytes.Buffer
+	err = astLib.WriteDiff(&buf, changes, *format)
+	if err == nil {err = writeOutput("-", buf.Bytes())}
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+	return exitOK
+}
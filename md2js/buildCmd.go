@@ -0,0 +1,132 @@
+// buildCmd.go
+// build command: converts markdown files, directories and globs into js scripts
+// in an output directory, keeping the directory structure of directory arguments
+// without arguments the inDir of the project config is built into its outDir
+// the files are converted concurrently and listed in a json manifest
+// the azul runtime is written to azul.js in the output directory
+// with -page standalone html pages are written instead of js scripts
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"goDemo/goldmark/samples/azul"
+	"goDemo/goldmark/samples/md2jsLib"
+)
+
+// assetOptions returns the image pipeline options of the output rel, nil if there is no
+// asset directory and no inlining. The url of the asset directory is relative to the output,
+// the images must be inside root.
+func assetOptions(out, rel, root, dir string, inline int64) *md2jsLib.AssetOptions {
+	if len(dir) == 0 && inline <= 0 {return nil}
+	ao := &md2jsLib.AssetOptions{InlineMax: inline, Root: root}
+	if len(dir) > 0 {
+		ao.Dir = filepath.Join(out, dir)
+		up, err := filepath.Rel(filepath.Dir(filepath.Join(out, rel)), ao.Dir)
+		if err != nil {up = dir}
+		ao.URL = filepath.ToSlash(up) + "/"
+	}
+	return ao
+}
+
+func runBuild(args []string) int {
+
+	fs := newFlagSet("build", "[-o outdir] [files|dirs|globs...]")
+	out := fs.String("o", "", "output directory (default: config outDir)")
+	workers := fs.Int("j", runtime.NumCPU(), "number of concurrent conversions")
+	manifest := fs.String("manifest", "", "manifest file (default: <outdir>/manifest.json), '-' for none")
+	strict := fs.Bool("strict", false, "fail if the renderer reports warnings or errors")
+	assets := fs.String("assets", "", "copy the local images into this directory below the output directory (default: config assets.dir)")
+	inline := fs.Int64("inline", -1, "inline images up to this many bytes as data urls (default: config assets.inline)")
+	jsf := addJsFlags(fs)
+	pf := addPageFlags(fs)
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+	if *out == "-" || *workers < 1 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	cfg, ok := loadConfig(*jsf.config)
+	if !ok {return exitFail}
+	if len(pos) == 0 {pos = []string{cfg.Path(cfg.InDir)}}
+	if len(*out) == 0 {*out = cfg.Path(cfg.OutDir)}
+	if len(*manifest) == 0 {*manifest = filepath.Join(*out, "manifest.json")}
+
+	inputs, err := expandInputs(pos, ".md")
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	// scripts link the runtime azul.js in the output directory, pages inline it
+	var azulJS []byte
+	if !*pf.page {
+		azulJS, err = azul.Runtime(jsf.azulVersion(cfg))
+		if err != nil {
+			errorf("%v", err)
+			return exitFail
+		}
+	}
+	bins, err := batchInputs(inputs)
+	if err != nil {
+		errorf("%v", err)
+		return exitUsage
+	}
+	if len(*assets) == 0 {*assets = cfg.Assets.Dir}
+	if *inline < 0 {*inline = cfg.Assets.Inline}
+	rels := make(map[string]string, len(bins))
+	for _, bin := range bins {rels[bin.Path] = bin.Rel}
+	// the input root of a file is the directory argument it was found in
+	roots := make(map[string]string, len(inputs))
+	for _, in := range inputs {roots[in.path] = filepath.Clean(strings.TrimSuffix(in.path, in.rel))}
+	bo := md2jsLib.BatchOptions{
+		Inputs:  bins,
+		OutDir:  *out,
+		Workers: *workers,
+		Options: func(fil string) (md2jsLib.Options, error) {
+			opts, err := jsf.options(cfg, fil)
+			if err == nil {opts.Assets = assetOptions(*out, rels[fil], roots[fil], *assets, *inline)}
+			return opts, err
+		},
+		Page:    *pf.page,
+	}
+
+	man, err := md2jsLib.Batch(context.Background(), bo)
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+	failed := reportManifest(man)
+
+	if azulJS != nil {
+		err = writeOutput(filepath.Join(*out, "azul.js"), azulJS)
+		if err != nil {
+			errorf("%v", err)
+			return exitFail
+		}
+	}
+
+	if *manifest != "-" {
+		err = man.WriteFile(*manifest)
+		if err != nil {
+			errorf("%v", err)
+			return exitFail
+		}
+	}
+	fmt.Printf("built %d of %d files into %s\n", len(inputs)-failed, len(inputs), *out)
+	if failed > 0 {return exitFail}
+	if errs, warns, _ := man.DiagnosticCounts(); *strict && errs+warns > 0 {return exitFail}
+	return exitOK
+}
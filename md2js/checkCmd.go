@@ -0,0 +1,77 @@
+// checkCmd.go
+// check command: offline check of the links, images, anchors and footnote references of
+// markdown files; the external urls are listed for a separate review
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"goDemo/goldmark/samples/md2jsLib"
+)
+
+func runCheck(args []string) int {
+
+	fs := newFlagSet("check", "[files|dirs|globs...]")
+	config := addConfigFlag(fs)
+	root := fs.String("root", "", "directory of absolute paths (default: config inDir)")
+	external := fs.Bool("external", true, "list the external urls")
+	ext := addExtFlags(fs)
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+
+	cfg, ok := loadConfig(*config)
+	if !ok {return exitFail}
+	if len(pos) == 0 {pos = []string{cfg.Path(cfg.InDir)}}
+	if len(*root) == 0 {*root = cfg.Path(cfg.InDir)}
+	inputs, err := expandInputs(pos, ".md")
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	co := md2jsLib.CheckOptions{
+		Root: *root,
+		Options: func(fil string) (md2jsLib.Options, error) {
+			opts, err := cfg.Options(fil)
+			opts.Extensions = ext.extensions(opts.Extensions)
+			return opts, err
+		},
+	}
+	for _, in := range inputs {
+		if in.path == "-" {
+			errorf("check reads files, not stdin")
+			return exitUsage
+		}
+		co.Inputs = append(co.Inputs, in.path)
+	}
+
+	rep, err := md2jsLib.Check(context.Background(), co)
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+	var urls []string
+	for _, f := range rep.Files {
+		if f.Error != nil {errorf("%s: %v", f.Path, f.Error)}
+		for _, d := range f.Diagnostics {fmt.Println(diagPos(f.Path, d))}
+		for _, u := range f.External {urls = append(urls, u.URL+"  "+f.Path+":"+strconv.Itoa(u.Line))}
+	}
+	if *external && len(urls) > 0 {
+		fmt.Printf("external urls:\n")
+		for _, u := range urls {fmt.Printf("  %s\n", u)}
+	}
+
+	errs := rep.Errors()
+	fmt.Printf("checked %d files: %d broken links, %d external urls\n", len(rep.Files), errs, len(urls))
+	if errs > 0 {return exitFail}
+	return exitOK
+}
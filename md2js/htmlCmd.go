@@ -0,0 +1,82 @@
+// htmlCmd.go
+// html command: converts markdown files into html with the goldmark html renderer
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func runHtml(args []string) int {
+
+	fs := newFlagSet("html", "files...")
+	out := fs.String("o", "", "output file or directory, '-' for stdout")
+	unsafe := fs.Bool("unsafe", false, "render raw html and dangerous links")
+	hardWraps := fs.Bool("hardwraps", false, "render soft line breaks as <br>")
+	config := addConfigFlag(fs)
+	ext := addExtFlags(fs)
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+	if len(pos) == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	inputs, err := expandInputs(pos, ".md")
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	cfg, ok := loadConfig(*config)
+	if !ok {return exitFail}
+
+	multi := len(inputs) > 1
+	status := exitOK
+	for _, in := range inputs {
+		outFil, err := outPath(*out, in, ".html", multi)
+		if err != nil {
+			errorf("%v", err)
+			return exitUsage
+		}
+		source, err := readInput(in.path)
+		if err != nil {
+			errorf("%v", err)
+			status = exitFail
+			continue
+		}
+
+		dc := cfg.For(cfgPath(in.path))
+		var htmlOpts []renderer.Option
+		if *unsafe || isSet(dc.Renderer.Unsafe) {htmlOpts = append(htmlOpts, html.WithUnsafe())}
+		if *hardWraps || isSet(dc.Renderer.HardWraps) {htmlOpts = append(htmlOpts, html.WithHardWraps())}
+		md := goldmark.New(
+			goldmark.WithExtensions(ext.extensions(dc.Goldmark())...),
+			goldmark.WithRendererOptions(htmlOpts...),
+		)
+
+		var buf bytes.Buffer
+		err = md.Convert(source, &buf)
+		if err != nil {
+			errorf("%s: convert: %v", in.path, err)
+			status = exitFail
+			continue
+		}
+		err = writeOutput(outFil, buf.Bytes())
+		if err != nil {
+			errorf("%v", err)
+			status = exitFail
+		}
+	}
+	return status
+}
@@ -0,0 +1,261 @@
+// jsCmd.go
+// js command: converts markdown files into js scripts with md2jsLib
+// with -page standalone html pages are written instead
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"goDemo/goldmark/samples/azul"
+	"goDemo/goldmark/samples/md2jsLib"
+	md2js "goDemo/goldmark/samples/rendererV3"
+	attributes "goDemo/goldmark/samples/extBlockAttr"
+	imgAttrs "goDemo/goldmark/samples/imgAttr"
+
+	"github.com/yuin/goldmark"
+)
+
+// extFlags are the flags that enable goldmark extensions in addition to the config.
+type extFlags struct {
+	attr    *bool
+	imgAttr *bool
+}
+
+func addExtFlags(fs *flag.FlagSet) extFlags {
+	return extFlags{
+		attr:    fs.Bool("attr", false, "enable attributes {#id .class key=val}"),
+		imgAttr: fs.Bool("imgattr", false, "enable image attributes (same as -attr)"),
+	}
+}
+
+// extensions adds the extensions of the flags to exts.
+func (e extFlags) extensions(exts []goldmark.Extender) []goldmark.Extender {
+	if *e.attr {exts = appendExt(exts, attributes.Extension)}
+	if *e.imgAttr {exts = appendExt(exts, imgAttrs.ImgAttrExt)}
+	return exts
+}
+
+func appendExt(exts []goldmark.Extender, ext goldmark.Extender) []goldmark.Extender {
+	for _, e := range exts {
+		if e == ext {return exts}
+	}
+	return append(exts, ext)
+}
+
+// cfgPath returns the path used to look up the per directory settings of an input.
+func cfgPath(in string) string {
+	if in == "-" {return "."}
+	return in
+}
+
+// jsFlags are the flags shared by the js and build commands.
+// They take precedence over the project config.
+type jsFlags struct {
+	config    *string
+	style     *string
+	site      *string
+	meta      *string
+	name      *string
+	hardWraps *bool
+	unsafe    *bool
+	dbg       *bool
+	azul      *string
+	ext       extFlags
+}
+
+func addJsFlags(fs *flag.FlagSet) *jsFlags {
+	return &jsFlags{
+		config:    addConfigFlag(fs),
+		style:     fs.String("style", "", "style script written ahead of the document (default: config theme)"),
+		site:      fs.String("site", "", "site script written after the document (default: config site)"),
+		meta:      fs.String("meta", "", "meta yaml file (default: <input>.meta)"),
+		name:      fs.String("name", "", "document name (default: input base name)"),
+		hardWraps: fs.Bool("hardwraps", false, "render soft line breaks as line breaks"),
+		unsafe:    fs.Bool("unsafe", false, "render raw html and dangerous links"),
+		dbg:       fs.Bool("dbg", false, "add debug comments to the script"),
+		azul:      fs.String("azul", "", "azul runtime version the scripts target (default: config azul or latest)"),
+		ext:       addExtFlags(fs),
+	}
+}
+
+// options returns the conversion options of an input from the config and the flags.
+func (f *jsFlags) options(cfg *md2jsLib.Config, in string) (opts md2jsLib.Options, err error) {
+	opts, err = cfg.Options(cfgPath(in))
+	if err != nil {return opts, err}
+	if len(*f.style) > 0 {
+		opts.Style, err = os.ReadFile(*f.style)
+		if err != nil {return opts, fmt.Errorf("style: %v", err)}
+	}
+	if len(*f.site) > 0 {
+		opts.Site, err = os.ReadFile(*f.site)
+		if err != nil {return opts, fmt.Errorf("site: %v", err)}
+	}
+	opts.Meta, err = readOptional(*f.meta)
+	if err != nil {return opts, fmt.Errorf("meta: %v", err)}
+	opts.Name = *f.name
+	opts.Dbg = *f.dbg
+	if len(*f.azul) > 0 {opts.Azul = *f.azul}
+	opts.Extensions = f.ext.extensions(opts.Extensions)
+	if *f.hardWraps {opts.RendererOptions = append(opts.RendererOptions, md2js.WithHardWraps())}
+	if *f.unsafe {opts.RendererOptions = append(opts.RendererOptions, md2js.WithUnsafe())}
+	return opts, nil
+}
+
+// azulVersion returns the azul runtime version of the flag or the config.
+func (f *jsFlags) azulVersion(cfg *md2jsLib.Config) string {
+	if len(*f.azul) > 0 {return *f.azul}
+	if len(cfg.Azul) > 0 {return cfg.Azul}
+	return azul.Latest
+}
+
+// deps returns the theme, site and meta files an input depends on.
+// A style or site flag replaces the file of the config.
+func (f *jsFlags) deps(cfg *md2jsLib.Config, in string) []string {
+	var deps []string
+	dc := cfg.For(cfgPath(in))
+	if len(*f.style) > 0 {
+		deps = append(deps, *f.style)
+	} else if len(dc.Theme) > 0 {
+		deps = append(deps, cfg.Path(dc.Theme))
+	}
+	if len(*f.site) > 0 {
+		deps = append(deps, *f.site)
+	} else if len(dc.Site) > 0 {
+		deps = append(deps, cfg.Path(dc.Site))
+	}
+	if len(*f.meta) > 0 {deps = append(deps, *f.meta)}
+	return deps
+}
+
+// pageFlags select standalone html pages instead of js scripts.
+type pageFlags struct {
+	page *bool
+}
+
+func addPageFlags(fs *flag.FlagSet) pageFlags {
+	return pageFlags{
+		page: fs.Bool("page", false, "write standalone html pages with the runtime, theme and site inlined"),
+	}
+}
+
+// ext returns the extension of the output files.
+func (p pageFlags) ext() string {
+	if *p.page {return ".html"}
+	return ".js"
+}
+
+func runJs(args []string) int {
+
+	fs := newFlagSet("js", "files...")
+	out := fs.String("o", "", "output file or directory, '-' for stdout")
+	strict := fs.Bool("strict", false, "fail if the renderer reports warnings or errors")
+	jsf := addJsFlags(fs)
+	pf := addPageFlags(fs)
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+	if len(pos) == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	cfg, ok := loadConfig(*jsf.config)
+	if !ok {return exitFail}
+	inputs, err := expandInputs(pos, ".md")
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	multi := len(inputs) > 1
+	status := exitOK
+	var errs, warns, files int
+	for _, in := range inputs {
+		outFil, err := outPath(*out, in, pf.ext(), multi)
+		if err != nil {
+			errorf("%v", err)
+			return exitUsage
+		}
+		opts, err := jsf.options(cfg, in.path)
+		if err != nil {
+			errorf("%s: %v", in.path, err)
+			status = exitFail
+			continue
+		}
+		e, wn, err := convertJs(in.path, outFil, opts, *pf.page)
+		if err != nil {
+			errorf("%s: %v", in.path, err)
+			status = exitFail
+		}
+		errs += e
+		warns += wn
+		if e+wn > 0 {files++}
+	}
+	if diagSummary(errs, warns, files) && *strict {status = exitFail}
+	return status
+}
+
+// diagSummary prints the number of render errors and warnings to stderr
+// and reports whether there are any.
+func diagSummary(errs, warns, files int) bool {
+	if errs+warns == 0 {return false}
+	fmt.Fprintf(os.Stderr, "%d errors, %d warnings in %d files\n", errs, warns, files)
+	return true
+}
+
+// convertJs converts a markdown file or stdin and writes the script or a standalone html page.
+// Diagnostics are printed to stderr as file:line:col; the numbers of errors and warnings are returned.
+func convertJs(in, out string, opts md2jsLib.Options, page bool) (errs, warns int, err error) {
+
+	if in == "-" && len(opts.Name) == 0 {opts.Name = "stdin"}
+	if in != "-" && len(opts.Name) == 0 {
+		opts.Name = strings.TrimSuffix(filepath.Base(in), filepath.Ext(in))
+	}
+	conv := md2jsLib.NewConverter(opts)
+
+	var res *md2jsLib.Result
+	ctx := context.Background()
+	if in == "-" {
+		src, rerr := readInput(in)
+		if rerr != nil {return 0, 0, rerr}
+		res, err = conv.Convert(ctx, src, nil)
+	} else {
+		res, err = conv.ConvertFile(ctx, in)
+	}
+	if res == nil {return 0, 0, err}
+
+	for _, d := range res.Diagnostics {
+		fmt.Fprintf(os.Stderr, "%s\n", diagPos(in, d))
+	}
+	errs, warns = md2jsLib.CountDiagnostics(res.Diagnostics)
+	data := res.JS
+	if page {
+		po, perr := conv.PageOptions()
+		if perr != nil {return errs, warns, perr}
+		data = res.Page(po)
+	}
+	if werr := writeOutput(out, data); werr != nil {return errs, warns, werr}
+	return errs, warns, err
+}
+
+// diagPos formats a diagnostic with the position in the compiler style file:line:col.
+func diagPos(fil string, d md2jsLib.Diagnostic) string {
+	pos := fil
+	if d.Line > 0 {pos += ":" + strconv.Itoa(d.Line)}
+	if d.Line > 0 && d.Col > 0 {pos += ":" + strconv.Itoa(d.Col)}
+	msg := d.Msg
+	if len(d.Kind) > 0 {msg = d.Kind + ": " + msg}
+	return pos + ": " + d.Severity.String() + ": " + msg
+}
@@ -0,0 +1,240 @@
+// md2js.go
+// single command line program for the markdown conversions
+// ./md2js <command> [flags] [files...]
+// commands:
+//   js     convert markdown files into js scripts (md2jsV3 renderer)
+//   html   convert markdown files into html files (goldmark html renderer)
+//   ast    dump the ast of markdown files
+//   split  split markdown files into meta, summary and main sections
+//   build  convert markdown files, directories and globs into an output directory
+//   watch  build and rebuild the outputs of changed sources
+//   serve  preview server with live reload
+//   verify compare the dom built by the scripts with the goldmark html
+//   spec   commonmark conformance report of the md2jsV3 renderer
+//   check  check the links, anchors and footnote references of markdown files offline
+//
+// inputs are file paths, directories, glob patterns or '-' for stdin
+// exit codes: 0 success, 1 conversion or i/o failure (or diagnostics with -strict), 2 usage error
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"goDemo/goldmark/samples/md2jsLib"
+)
+
+const (
+	exitOK    = 0
+	exitFail  = 1
+	exitUsage = 2
+)
+
+type command struct {
+	run  func(args []string) int
+	help string
+}
+
+var commands = map[string]command{
+	"js":     {runJs, "convert markdown files into js scripts"},
+	"html":   {runHtml, "convert markdown files into html files"},
+	"ast":    {runAst, "dump the ast of markdown files"},
+	"split":  {runSplit, "split markdown files into meta, summary and main sections"},
+	"build":  {runBuild, "convert markdown files, directories and globs into an output directory"},
+	"watch":  {runWatch, "build and rebuild the outputs of changed sources"},
+	"serve":  {runServe, "preview server with live reload"},
+	"verify": {runVerify, "compare the dom built by the scripts with the goldmark html"},
+	"spec":   {runSpec, "commonmark conformance report of the md2jsV3 renderer"},
+	"check":  {runCheck, "check the links, anchors and footnote references of markdown files offline"},
+}
+
+func main() {
+
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(exitUsage)
+	}
+
+	switch os.Args[1] {
+	case "help", "-h", "-help", "--help":
+		usage(os.Stdout)
+		os.Exit(exitOK)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "error -- unknown command: %s\n", os.Args[1])
+		usage(os.Stderr)
+		os.Exit(exitUsage)
+	}
+	os.Exit(cmd.run(os.Args[2:]))
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "usage: md2js <command> [flags] [files...]\n\ncommands:\n")
+	names := make([]string, 0, len(commands))
+	for nam := range commands {names = append(names, nam)}
+	sort.Strings(names)
+	for _, nam := range names {
+		fmt.Fprintf(w, "  %-6s %s\n", nam, commands[nam].help)
+	}
+	fmt.Fprintf(w, "\nrun 'md2js <command> -h' for the flags of a command\n")
+}
+
+// newFlagSet returns a flag set for a command that reports errors instead of exiting.
+func newFlagSet(nam, argStr string) *flag.FlagSet {
+	fs := flag.NewFlagSet(nam, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: md2js %s [flags] %s\n", nam, argStr)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// addConfigFlag adds the -config flag. Without it, md2js.yaml is searched
+// from the working directory upwards.
+func addConfigFlag(fs *flag.FlagSet) *string {
+	return fs.String("config", "", "project config file (default: nearest "+md2jsLib.ConfigFile+")")
+}
+
+// loadConfig loads the project config and prints an error on failure.
+func loadConfig(fil string) (*md2jsLib.Config, bool) {
+	cfg, err := md2jsLib.LoadProjectConfig(fil)
+	if err != nil {
+		errorf("%v", err)
+		return nil, false
+	}
+	return cfg, true
+}
+
+// parseArgs parses flags that may be interspersed with the positional arguments.
+func parseArgs(fs *flag.FlagSet, args []string) (pos []string, err error) {
+	for {
+		err = fs.Parse(args)
+		if err != nil {return nil, err}
+		args = fs.Args()
+		if len(args) == 0 {return pos, nil}
+		pos = append(pos, args[0])
+		args = args[1:]
+	}
+}
+
+// An input is a markdown file to convert.
+type input struct {
+	// path is the file path or '-' for stdin.
+	path string
+	// rel is the path relative to the directory argument it was found in,
+	// or the base name for files and glob matches.
+	rel string
+}
+
+// expandInputs expands directories and glob patterns into markdown files.
+// A directory is searched recursively for files with the extension ext.
+// '-' stands for stdin.
+func expandInputs(args []string, ext string) (inputs []input, err error) {
+	for _, arg := range args {
+		if arg == "-" {
+			inputs = append(inputs, input{path: arg, rel: "stdin" + ext})
+			continue
+		}
+		matches := []string{arg}
+		if strings.ContainsAny(arg, "*?[") {
+			matches, err = filepath.Glob(arg)
+			if err != nil {return nil, fmt.Errorf("glob %s: %v", arg, err)}
+			if len(matches) == 0 {return nil, fmt.Errorf("no files match %s", arg)}
+		}
+		for _, m := range matches {
+			info, err := os.Stat(m)
+			if err != nil {return nil, err}
+			if !info.IsDir() {
+				inputs = append(inputs, input{path: m, rel: filepath.Base(m)})
+				continue
+			}
+			err = filepath.WalkDir(m, func(path string, d os.DirEntry, err error) error {
+				if err != nil {return err}
+				if d.IsDir() || filepath.Ext(path) != ext {return nil}
+				rel, err := filepath.Rel(m, path)
+				if err != nil {return err}
+				inputs = append(inputs, input{path: path, rel: rel})
+				return nil
+			})
+			if err != nil {return nil, err}
+		}
+	}
+	return inputs, nil
+}
+
+// outPath returns the output path for the input file in.
+// out is the -o flag value: empty, '-' for stdout, a directory or a file.
+// Without -o, a single input is written to stdout and several inputs next to their sources.
+// In an output directory the path relative to the directory argument is kept.
+func outPath(out string, src input, ext string, multi bool) (string, error) {
+
+	in := src.path
+	base := strings.TrimSuffix(src.rel, filepath.Ext(src.rel))
+	if len(base) == 0 {base = strings.TrimSuffix(filepath.Base(in), filepath.Ext(in))}
+	if in == "-" {base = "stdin"}
+
+	switch {
+	case out == "" && !multi:
+		return "-", nil
+	case out == "" && in == "-":
+		return "-", nil
+	case out == "":
+		return strings.TrimSuffix(in, filepath.Ext(in)) + ext, nil
+	case out == "-":
+		if multi {return "", fmt.Errorf("cannot write several outputs to stdout")}
+		return "-", nil
+	}
+
+	info, err := os.Stat(out)
+	isDir := err == nil && info.IsDir()
+	if isDir || multi || strings.HasSuffix(out, string(os.PathSeparator)) {
+		return filepath.Join(out, base+ext), nil
+	}
+	return out, nil
+}
+
+// readInput reads a file or stdin for '-'.
+func readInput(in string) ([]byte, error) {
+	if in == "-" {return io.ReadAll(os.Stdin)}
+	return os.ReadFile(in)
+}
+
+// writeOutput writes to a file, creating its directory, or to stdout for '-'.
+func writeOutput(out string, data []byte) error {
+	if out == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	if dir := filepath.Dir(out); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {return err}
+	}
+	return os.WriteFile(out, data, 0666)
+}
+
+// readOptional reads an optional file; an empty name returns nil.
+func readOptional(fil string) ([]byte, error) {
+	if len(fil) == 0 {return nil, nil}
+	return os.ReadFile(fil)
+}
+
+func isSet(b *bool) bool {
+	return b != nil && *b
+}
+
+// errorf prints an error message to stderr.
+func errorf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "error -- "+format+"\n", args...)
+}
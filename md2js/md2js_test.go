@@ -0,0 +1,48 @@
+// md2js_test.go
+// tests of the input expansion and the output paths of the commands
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// same-named files of sub directories keep their relative paths in the output directory
+func TestOutPath(t *testing.T) {
+
+	dir := t.TempDir()
+	for _, f := range []string{"a/index.md", "b/index.md", "top.md"} {
+		fil := filepath.Join(dir, "docs", filepath.FromSlash(f))
+		if err := os.MkdirAll(filepath.Dir(fil), 0755); err != nil {t.Fatal(err)}
+		if err := os.WriteFile(fil, []byte("# x\n"), 0644); err != nil {t.Fatal(err)}
+	}
+	inputs, err := expandInputs([]string{filepath.Join(dir, "docs")}, ".md")
+	if err != nil {t.Fatal(err)}
+
+	out := filepath.Join(dir, "out")
+	want := map[string]bool{
+		filepath.Join(out, "a", "index.js"): true,
+		filepath.Join(out, "b", "index.js"): true,
+		filepath.Join(out, "top.js"):        true,
+	}
+	for _, in := range inputs {
+		got, err := outPath(out, in, ".js", len(inputs) > 1)
+		if err != nil {t.Fatal(err)}
+		if !want[got] {t.Errorf("%s: unexpected output %s", in.path, got)}
+		delete(want, got)
+	}
+	for w := range want {t.Errorf("output %s missing", w)}
+
+	// a single file keeps its base name, stdin is stdin
+	got, err := outPath(out+string(os.PathSeparator), input{path: filepath.Join(dir, "docs", "top.md"), rel: "top.md"}, ".html", false)
+	if err != nil || got != filepath.Join(out, "top.html") {t.Errorf("single file: %s %v", got, err)}
+	got, err = outPath(out, input{path: "-", rel: "stdin.md"}, ".js", true)
+	if err != nil || got != filepath.Join(out, "stdin.js") {t.Errorf("stdin: %s %v", got, err)}
+}
@@ -0,0 +1,361 @@
+// serveCmd.go
+// serve command: local preview server
+// the markdown files are converted on request; open pages reload when
+// their markdown, meta, theme or site files change (server-sent events)
+//
+// routes:
+//   /            index of the markdown files
+//   /doc/<rel>   html shell of a document; site.open of the render calls opens /doc/<rel>
+//   /js/<rel>.js converted document script with theme and site
+//   /azul.js     dom runtime
+//   /events      reload events
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"goDemo/goldmark/samples/azul"
+	"goDemo/goldmark/samples/md2jsLib"
+)
+
+// reloadScript reloads the page when an event names its document.
+// The index page has an empty document name and reloads on every change.
+const reloadScript = `(function () {
+	const src = new EventSource('/events');
+	src.onmessage = function (ev) {
+		const docs = JSON.parse(ev.data);
+		if (md2jsDoc === '' || docs.includes(md2jsDoc)) {location.reload();}
+	};
+})();
+`
+
+// openScript opens a linked document of a render call (links.renderCall) at its /doc/ route
+// unless the site script defines site.open.
+const openScript = `if (typeof site.open !== 'function') {
+	site.open = function (doc, fragment) {
+		location.href = '/doc/' + doc.split('/').map(encodeURIComponent).join('/') + (fragment ? '#' + encodeURIComponent(fragment) : '');
+	};
+}
+`
+
+var docTmpl = template.Must(template.New("doc").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+</head>
+<body>
+<script>const md2jsDoc = {{.Rel}};</script>
+<script src="{{.Runtime}}"></script>
+<script src="/js/{{.Rel}}.js"></script>
+<script>{{.Open}}</script>
+{{if .Render}}<script>{{.RenderCall}}</script>
+{{end}}<script src="/reload.js"></script>
+</body>
+</html>
+`))
+
+var indexTmpl = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>md2js serve</title>
+</head>
+<body>
+<h1>{{.Root}}</h1>
+<ul>
+{{range .Docs}}<li><a href="/doc/{{.}}">{{.}}</a></li>
+{{end}}</ul>
+<script>const md2jsDoc = '';</script>
+<script src="/reload.js"></script>
+</body>
+</html>
+`))
+
+// server holds the state of the serve command.
+type server struct {
+	cfg     *md2jsLib.Config
+	jsf     *jsFlags
+	root    string
+	runtime []byte
+	inputs  func() ([]md2jsLib.BatchInput, error)
+
+	// mu guards the clients and cfg, which the watcher swaps when md2js.yaml changes
+	mu      sync.Mutex
+	clients map[chan []string]bool
+}
+
+// config returns the current project config.
+func (srv *server) config() *md2jsLib.Config {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	return srv.cfg
+}
+
+func runServe(args []string) int {
+
+	fs := newFlagSet("serve", "[dirs|files|globs...]")
+	addr := fs.String("addr", "localhost:8080", "listen address")
+	interval := fs.Duration("interval", 500*time.Millisecond, "poll interval for changes")
+	jsf := addJsFlags(fs)
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+	if *interval <= 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	cfg, ok := loadConfig(*jsf.config)
+	if !ok {return exitFail}
+	if len(pos) == 0 {pos = []string{cfg.Path(cfg.InDir)}}
+	azulJS, err := azul.Runtime(jsf.azulVersion(cfg))
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	srv := &server{
+		cfg:     cfg,
+		jsf:     jsf,
+		root:    strings.Join(pos, " "),
+		runtime: azulJS,
+		clients: make(map[chan []string]bool),
+	}
+	srv.inputs = func() ([]md2jsLib.BatchInput, error) {
+		ins, err := expandInputs(pos, ".md")
+		if err != nil {return nil, err}
+		return batchInputs(ins)
+	}
+	if _, err := srv.inputs(); err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	w := md2jsLib.NewWatcher(md2jsLib.WatchOptions{
+		Inputs: srv.inputs,
+		Deps:   func(fil string) []string {return jsf.deps(srv.config(), fil)},
+		Config: cfg.File(),
+		// the inputs and the runtime stay those of the start
+		Reload: func() error {
+			c, err := md2jsLib.LoadConfig(cfg.File())
+			if err != nil {return err}
+			srv.mu.Lock()
+			srv.cfg = c
+			srv.mu.Unlock()
+			return nil
+		},
+	})
+	// the first call records the current state
+	if _, err := w.Changed(); err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+	go srv.watch(ctx, w, *interval)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", srv.handleIndex)
+	mux.HandleFunc("/doc/", srv.handleDoc)
+	mux.HandleFunc("/js/", srv.handleJs)
+	mux.HandleFunc("/azul.js", srv.handleRuntime)
+	mux.HandleFunc("/reload.js", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+		fmt.Fprint(w, reloadScript)
+	})
+	mux.HandleFunc("/events", srv.handleEvents)
+
+	// the base context ends the event streams on shutdown
+	hs := &http.Server{
+		Addr:        *addr,
+		Handler:     mux,
+		BaseContext: func(net.Listener) context.Context {return ctx},
+	}
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+	fmt.Printf("serving %s on http://%s/\n", srv.root, ln.Addr())
+
+	go func() {
+		<-ctx.Done()
+		sctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_ = hs.Shutdown(sctx)
+	}()
+	err = hs.Serve(ln)
+	if err != nil && err != http.ErrServerClosed {
+		errorf("%v", err)
+		return exitFail
+	}
+	return exitOK
+}
+
+// watch polls the inputs and sends the changed documents to the event clients.
+func (srv *server) watch(ctx context.Context, w *md2jsLib.Watcher, interval time.Duration) {
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+		}
+		changed, err := w.Changed()
+		if err != nil {
+			errorf("%v", err)
+			continue
+		}
+		if len(changed) == 0 {continue}
+		docs := make([]string, len(changed))
+		for i, in := range changed {docs[i] = path.Clean(strings.ReplaceAll(in.Rel, "\\", "/"))}
+		fmt.Printf("%s changed: %s\n", time.Now().Format("15:04:05"), strings.Join(docs, " "))
+		srv.broadcast(docs)
+	}
+}
+
+func (srv *server) broadcast(docs []string) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	for ch := range srv.clients {
+		// a client that is not ready misses the event
+		select {
+		case ch <- docs:
+		default:
+		}
+	}
+}
+
+// lookup returns the input of a document. Only listed inputs are served.
+func (srv *server) lookup(rel string) (md2jsLib.BatchInput, bool) {
+	ins, err := srv.inputs()
+	if err != nil {return md2jsLib.BatchInput{}, false}
+	for _, in := range ins {
+		if strings.ReplaceAll(in.Rel, "\\", "/") == rel {return in, true}
+	}
+	return md2jsLib.BatchInput{}, false
+}
+
+func (srv *server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	ins, err := srv.inputs()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	docs := make([]string, len(ins))
+	for i, in := range ins {docs[i] = strings.ReplaceAll(in.Rel, "\\", "/")}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = indexTmpl.Execute(w, struct {
+		Root string
+		Docs []string
+	}{srv.root, docs})
+}
+
+func (srv *server) handleDoc(w http.ResponseWriter, r *http.Request) {
+	rel := strings.TrimPrefix(r.URL.Path, "/doc/")
+	in, ok := srv.lookup(rel)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	opts, err := srv.jsf.options(srv.config(), in.Path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = docTmpl.Execute(w, struct {
+		Title      string
+		Rel        string
+		Runtime    string
+		Render     bool
+		RenderCall template.JS
+		Open       template.JS
+	}{rel, rel, "/azul.js", len(opts.Site) == 0, md2jsLib.RenderCall, openScript})
+}
+
+func (srv *server) handleJs(w http.ResponseWriter, r *http.Request) {
+	rel := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/js/"), ".js")
+	in, ok := srv.lookup(rel)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	opts, err := srv.jsf.options(srv.config(), in.Path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	res, err := md2jsLib.ConvertFile(r.Context(), in.Path, opts)
+	if res == nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err != nil {errorf("%s: %v", in.Path, err)}
+	w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	for _, d := range res.Diagnostics {fmt.Fprintf(w, "// %s\n", d)}
+	_, _ = w.Write(res.JS)
+}
+
+func (srv *server) handleRuntime(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+	_, _ = w.Write(srv.runtime)
+}
+
+// handleEvents streams the names of changed documents as server-sent events.
+func (srv *server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	ch := make(chan []string, 1)
+	srv.mu.Lock()
+	srv.clients[ch] = true
+	srv.mu.Unlock()
+	defer func() {
+		srv.mu.Lock()
+		delete(srv.clients, ch)
+		srv.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	fmt.Fprint(w, ": md2js\n\n")
+	flusher.Flush()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case docs := <-ch:
+			data, _ := json.Marshal(docs)
+			fmt.Fprintf(w, "data: %s\n\n", data)
+			flusher.Flush()
+		}
+	}
+}
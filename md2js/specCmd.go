@@ -0,0 +1,77 @@
+// specCmd.go
+// spec command: commonmark conformance report of the md2jsV3 renderer
+// the examples of the spec fixture are rendered, run with the fake dom and compared
+// with the html of the spec; the result is listed per section
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"goDemo/goldmark/samples/azul"
+	"goDemo/goldmark/samples/jsdom"
+	md2js "goDemo/goldmark/samples/rendererV3"
+)
+
+func runSpec(args []string) int {
+
+	fs := newFlagSet("spec", "")
+	specFil := fs.String("spec", jsdom.SpecFile, "commonmark spec fixture (json)")
+	sections := fs.String("section", "", "comma separated list of the sections to run (default: all)")
+	azulVer := fs.String("azul", azul.Latest, "azul runtime version")
+	format := fs.String("format", "text", "report format: text, json")
+	verbose := fs.Bool("v", false, "list the errors and diffs of the failed examples")
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+	if len(pos) > 0 || (*format != "text" && *format != "json") {
+		fs.Usage()
+		return exitUsage
+	}
+
+	examples, err := jsdom.LoadSpec(*specFil)
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+	runtime, err := azul.Runtime(*azulVer)
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	so := jsdom.SpecOptions{
+		Runtime:         runtime,
+		RendererOptions: []md2js.Option{md2js.WithUnsafe()},
+	}
+	if len(*sections) > 0 {
+		for _, s := range strings.Split(*sections, ",") {so.Sections = append(so.Sections, strings.TrimSpace(s))}
+	}
+	rep, err := jsdom.RunSpec(context.Background(), examples, so)
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+	if len(rep.Examples) == 0 {
+		errorf("no examples in the sections: %s", *sections)
+		return exitFail
+	}
+
+	if *format == "json" {
+		err = rep.WriteJSON(os.Stdout)
+	} else {
+		err = rep.WriteText(os.Stdout, *verbose)
+	}
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+	return exitOK
+}
@@ -0,0 +1,94 @@
+// splitCmd.go
+// split command: splits markdown files into meta, summary and main sections
+// without -o the sections are printed, with -o they are written to
+// <name>.meta, <name>.summary.md and <name>.main.md in the output directory
+// without arguments the files of the config inDir are split
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"goDemo/goldmark/samples/md2jsLib"
+)
+
+func runSplit(args []string) int {
+
+	fs := newFlagSet("split", "[files...]")
+	out := fs.String("o", "", "output directory")
+	config := addConfigFlag(fs)
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+	if *out == "-" {
+		fs.Usage()
+		return exitUsage
+	}
+
+	cfg, ok := loadConfig(*config)
+	if !ok {return exitFail}
+	if len(pos) == 0 {pos = []string{cfg.Path(cfg.InDir)}}
+
+	inputs, err := expandInputs(pos, ".md")
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	status := exitOK
+	for _, in := range inputs {
+		src, err := readInput(in.path)
+		if err != nil {
+			errorf("%v", err)
+			status = exitFail
+			continue
+		}
+		parts, err := md2jsLib.SplitSource(src)
+		if err != nil {
+			errorf("%s: %v", in.path, err)
+			status = exitFail
+			continue
+		}
+
+		if len(*out) == 0 {
+			fmt.Printf("==== %s ====\n", in.path)
+			printPart("meta", parts.Meta)
+			printPart("summary", parts.Summary)
+			printPart("main", parts.Main)
+			continue
+		}
+
+		base := filepath.Join(*out, strings.TrimSuffix(in.rel, filepath.Ext(in.rel)))
+		for _, p := range []struct {
+			ext  string
+			data []byte
+		}{
+			{".meta", parts.Meta},
+			{".summary.md", parts.Summary},
+			{".main.md", parts.Main},
+		} {
+			if p.data == nil {continue}
+			err = writeOutput(base+p.ext, p.data)
+			if err != nil {
+				errorf("%v", err)
+				status = exitFail
+			}
+		}
+	}
+	return status
+}
+
+func printPart(nam string, data []byte) {
+	if data == nil {
+		fmt.Printf("**** %s none ****\n", nam)
+		return
+	}
+	fmt.Printf("**** %s:\n%s\n******\n", nam, data)
+}
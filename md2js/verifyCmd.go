@@ -0,0 +1,109 @@
+// verifyCmd.go
+// verify command: runs the scripts of markdown files with a fake dom and the azul
+// runtime and compares the resulting dom with the html of the goldmark html renderer
+// the theme and the site script of the config are not used
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"goDemo/goldmark/samples/jsdom"
+	"goDemo/goldmark/samples/md2jsLib"
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func runVerify(args []string) int {
+
+	fs := newFlagSet("verify", "files...")
+	config := addConfigFlag(fs)
+	unsafe := fs.Bool("unsafe", false, "render raw html and dangerous links")
+	hardWraps := fs.Bool("hardwraps", false, "render soft line breaks as line breaks")
+	azulVer := fs.String("azul", "", "azul runtime version (default: config azul or latest)")
+	keepStyle := fs.Bool("style", false, "compare the style attributes as well")
+	quiet := fs.Bool("q", false, "list the differing files without the diff")
+	ext := addExtFlags(fs)
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+	if len(pos) == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	cfg, ok := loadConfig(*config)
+	if !ok {return exitFail}
+	inputs, err := expandInputs(pos, ".md")
+	if err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	status := exitOK
+	for _, in := range inputs {
+		source, err := readInput(in.path)
+		if err != nil {
+			errorf("%v", err)
+			status = exitFail
+			continue
+		}
+		vo, err := verifyOptions(cfg, in.path)
+		if err != nil {
+			errorf("%s: %v", in.path, err)
+			status = exitFail
+			continue
+		}
+		vo.Options.Extensions = ext.extensions(vo.Options.Extensions)
+		if len(*azulVer) > 0 {vo.Options.Azul = *azulVer}
+		if *unsafe {
+			vo.Options.RendererOptions = append(vo.Options.RendererOptions, md2js.WithUnsafe())
+			vo.HTMLOptions = append(vo.HTMLOptions, html.WithUnsafe())
+		}
+		if *hardWraps {
+			vo.Options.RendererOptions = append(vo.Options.RendererOptions, md2js.WithHardWraps())
+			vo.HTMLOptions = append(vo.HTMLOptions, html.WithHardWraps())
+		}
+		vo.Norm.KeepStyle = *keepStyle
+
+		rep, err := jsdom.Verify(context.Background(), source, vo)
+		if err != nil {
+			errorf("%s: %v", in.path, err)
+			status = exitFail
+			continue
+		}
+		if rep.Equal() {
+			fmt.Printf("ok   %s\n", in.path)
+			continue
+		}
+		status = exitFail
+		fmt.Printf("diff %s\n", in.path)
+		if !*quiet {fmt.Printf("%s\n", strings.Join(rep.Diff, "\n"))}
+	}
+	return status
+}
+
+// verifyOptions returns the conversion options of the config without the theme and the
+// site script, and the goldmark html options that match the renderer settings.
+func verifyOptions(cfg *md2jsLib.Config, in string) (vo jsdom.VerifyOptions, err error) {
+	vo.Options, err = cfg.Options(cfgPath(in))
+	if err != nil {return vo, err}
+	vo.Options.Style = nil
+	vo.Options.Site = nil
+
+	dc := cfg.For(cfgPath(in))
+	var htmlOpts []renderer.Option
+	if isSet(dc.Renderer.Unsafe) {htmlOpts = append(htmlOpts, html.WithUnsafe())}
+	if isSet(dc.Renderer.HardWraps) {htmlOpts = append(htmlOpts, html.WithHardWraps())}
+	vo.HTMLOptions = htmlOpts
+	return vo, nil
+}
@@ -0,0 +1,110 @@
+// watchCmd.go
+// watch command: builds markdown files like the build command and rebuilds
+// the outputs whose markdown, meta, theme or site files change
+// stops with ctrl-c
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"goDemo/goldmark/samples/md2jsLib"
+)
+
+func runWatch(args []string) int {
+
+	fs := newFlagSet("watch", "[-o outdir] [files|dirs|globs...]")
+	out := fs.String("o", "", "output directory (default: config outDir)")
+	workers := fs.Int("j", runtime.NumCPU(), "number of concurrent conversions")
+	interval := fs.Duration("interval", 500*time.Millisecond, "poll interval")
+	jsf := addJsFlags(fs)
+
+	pos, err := parseArgs(fs, args)
+	if err != nil {return exitUsage}
+	if *out == "-" || *workers < 1 || *interval <= 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	cfg, ok := loadConfig(*jsf.config)
+	if !ok {return exitFail}
+	if len(pos) == 0 {pos = []string{cfg.Path(cfg.InDir)}}
+	if len(*out) == 0 {*out = cfg.Path(cfg.OutDir)}
+
+	// the inputs are expanded on every scan to pick up new files
+	inputs := func() ([]md2jsLib.BatchInput, error) {
+		ins, err := expandInputs(pos, ".md")
+		if err != nil {return nil, err}
+		return batchInputs(ins)
+	}
+	if _, err := inputs(); err != nil {
+		errorf("%v", err)
+		return exitFail
+	}
+
+	w := md2jsLib.NewWatcher(md2jsLib.WatchOptions{
+		Inputs:   inputs,
+		Deps:     func(fil string) []string {return jsf.deps(cfg, fil)},
+		OutDir:   *out,
+		Workers:  *workers,
+		Options:  func(fil string) (md2jsLib.Options, error) {return jsf.options(cfg, fil)},
+		Interval: *interval,
+		Config:   cfg.File(),
+		// the inputs and the output directory stay those of the start
+		Reload: func() error {
+			c, err := md2jsLib.LoadConfig(cfg.File())
+			if err != nil {return err}
+			cfg = c
+			return nil
+		},
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Printf("watching %s, writing to %s\n", strings.Join(pos, " "), *out)
+	_ = w.Run(ctx, func(man *md2jsLib.Manifest, err error) {
+		if err != nil {errorf("%v", err)}
+		if man == nil {return}
+		reportManifest(man)
+		fmt.Printf("%s rebuilt %d files\n", time.Now().Format("15:04:05"), len(man.Files))
+	})
+	return exitOK
+}
+
+// batchInputs converts the inputs of the command line into batch inputs.
+// The output path mirrors the path relative to the directory argument.
+func batchInputs(inputs []input) (bins []md2jsLib.BatchInput, err error) {
+	for _, in := range inputs {
+		if in.path == "-" {return nil, fmt.Errorf("cannot read stdin")}
+		bins = append(bins, md2jsLib.BatchInput{
+			Path: in.path,
+			Rel:  strings.TrimSuffix(in.rel, filepath.Ext(in.rel)),
+		})
+	}
+	return bins, nil
+}
+
+// reportManifest prints the diagnostics, their summary and the errors of a batch
+// and returns the number of failed files.
+func reportManifest(man *md2jsLib.Manifest) int {
+	for _, ent := range man.Files {
+		for _, d := range ent.Diagnostics {errorf("%s: %s", ent.Source, d)}
+	}
+	diagSummary(man.DiagnosticCounts())
+	failed := man.Failed()
+	for _, ent := range failed {errorf("%s: %s", ent.Source, ent.Error)}
+	return len(failed)
+}
@@ -0,0 +1,200 @@
+// assets.go
+// local image pipeline: the images of a document are resolved relative to the markdown
+// file, copied into the asset directory under content-hashed names, measured for their
+// width and height and small images are inlined as data urls
+// only images inside the input root are processed; a missing image, a file that is not
+// an image and a path outside the root are reported as diagnostics
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// AssetOptions configure the pipeline of the local images of a document.
+type AssetOptions struct {
+	// Dir is the directory the images are copied to under content-hashed names.
+	// If empty, the images stay in place and are only checked and measured.
+	Dir string
+	// URL is the url of Dir in the scripts, relative to the script or page, e.g. "assets/".
+	URL string
+	// InlineMax inlines images of up to InlineMax bytes as data urls; 0 inlines none.
+	InlineMax int64
+	// Root is the directory the images must be in, e.g. the input directory of a build.
+	// If empty, it is the directory of the markdown file.
+	Root string
+}
+
+// processImages resolves the local images of a document against dir and rewrites their
+// destinations. width and height are set from the image size if neither is given.
+// With image variants of the renderer config rc the srcset is set from the variant files,
+// which are copied with the image.
+func (res *Result) processImages(doc ast.Node, source []byte, firstLine int, dir string, ao *AssetOptions, rc *md2js.Config) {
+	root := ao.Root
+	if len(root) == 0 {root = dir}
+	policy := rc.URLPolicy
+	if policy == nil {
+		def := md2js.DefaultURLPolicy()
+		policy = &def
+	}
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		n, ok := node.(*ast.Image)
+		if !entering || !ok || !md2js.IsLocalURL(string(n.Destination)) {return ast.WalkContinue, nil}
+		line := firstLine + nodeLine(n, source)
+		diag := func(sev Severity, format string, args ...interface{}) {
+			res.Diagnostics = append(res.Diagnostics, Diagnostic{Severity: sev, Kind: "Image", Line: line, Msg: fmt.Sprintf(format, args...)})
+		}
+
+		dest, err := url.PathUnescape(string(n.Destination))
+		if err != nil {dest = string(n.Destination)}
+		fil := filepath.Join(dir, filepath.FromSlash(dest))
+		data, err := os.ReadFile(fil)
+		if err != nil {
+			diag(SevWarning, "image not found: %s", n.Destination)
+			return ast.WalkContinue, nil
+		}
+		// the variants are found by the destination before it is rewritten
+		var variants []md2js.ImageVariant
+		if _, ok := n.AttributeString("srcset"); !ok && rc.ImageVariants {variants = md2js.ImageVariants(dir, string(n.Destination))}
+		if !insideRoot(root, fil) {
+			diag(SevError, "image outside of the input directory: %s", n.Destination)
+			return ast.WalkContinue, nil
+		}
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+		typ := mime.TypeByExtension(strings.ToLower(filepath.Ext(fil)))
+		if err != nil && !strings.HasPrefix(typ, "image/") {
+			diag(SevError, "not an image: %s", n.Destination)
+			return ast.WalkContinue, nil
+		}
+
+		_, hasWidth := n.AttributeString("width")
+		_, hasHeight := n.AttributeString("height")
+		if err == nil && !hasWidth && !hasHeight {
+			n.SetAttributeString("width", []byte(strconv.Itoa(cfg.Width)))
+			n.SetAttributeString("height", []byte(strconv.Itoa(cfg.Height)))
+		}
+
+		var dataURL []byte
+		if int64(len(data)) <= ao.InlineMax && strings.HasPrefix(typ, "image/") {
+			dataURL = []byte("data:" + typ + ";base64," + base64.StdEncoding.EncodeToString(data))
+		}
+		switch {
+		// a data url the image rule of the url policy blocks would be removed by the renderer
+		case dataURL != nil && (rc.Unsafe || policy.Images.Allowed(dataURL)):
+			n.Destination = dataURL
+		case len(ao.Dir) > 0:
+			nam, err := copyAsset(ao.Dir, fil, data)
+			if err != nil {
+				diag(SevError, "image %s not copied: %v", n.Destination, err)
+				return ast.WalkContinue, nil
+			}
+			n.Destination = []byte(ao.URL + url.PathEscape(nam))
+		}
+		if len(variants) == 0 {return ast.WalkContinue, nil}
+
+		kept := variants[:0]
+		for _, v := range variants {
+			switch {
+			case v.File == fil:
+				v.URL = string(n.Destination)
+			case len(ao.Dir) > 0:
+				vdata, err := os.ReadFile(v.File)
+				if err == nil && !insideRoot(root, v.File) {err = fmt.Errorf("outside of the input directory")}
+				var nam string
+				if err == nil {nam, err = copyAsset(ao.Dir, v.File, vdata)}
+				if err != nil {
+					diag(SevError, "image variant %s not copied: %v", v.URL, err)
+					continue
+				}
+				v.URL = ao.URL + url.PathEscape(nam)
+			}
+			kept = append(kept, v)
+		}
+		if len(kept) > 0 {n.SetAttributeString("srcset", []byte(md2js.Srcset(kept)))}
+		return ast.WalkContinue, nil
+	})
+}
+
+// insideRoot reports whether a file is inside the root directory, following symbolic links.
+func insideRoot(root, fil string) bool {
+	if r, err := filepath.EvalSymlinks(root); err == nil {root = r}
+	if f, err := filepath.EvalSymlinks(fil); err == nil {fil = f}
+	root, err := filepath.Abs(root)
+	if err != nil {return false}
+	fil, err = filepath.Abs(fil)
+	if err != nil {return false}
+	rel, err := filepath.Rel(root, fil)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// copyAsset copies an image into the asset directory as <name>-<hash>.<ext> and returns the name.
+// Concurrent conversions may copy the same image: the file is written under a temporary
+// name and renamed.
+func copyAsset(dir, fil string, data []byte) (string, error) {
+	sum := sha256.Sum256(data)
+	ext := filepath.Ext(fil)
+	nam := strings.TrimSuffix(filepath.Base(fil), ext) + "-" + hex.EncodeToString(sum[:5]) + ext
+	out := filepath.Join(dir, nam)
+	if _, err := os.Stat(out); err == nil {return nam, nil}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {return "", err}
+	tmp, err := os.CreateTemp(dir, ".asset-*")
+	if err != nil {return "", err}
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {err = cerr}
+	if err == nil {err = os.Rename(tmp.Name(), out)}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return nam, nil
+}
+
+// nodeLine returns the line of a node, counted from 0: the line of its first text or of
+// its block. An autolink has no segment: its label is searched after the previous text.
+func nodeLine(node ast.Node, source []byte) int {
+	if n, ok := node.(*ast.AutoLink); ok {
+		start := -1
+		for p := n.PreviousSibling(); p != nil && start < 0; p = p.PreviousSibling() {
+			if t, ok := p.(*ast.Text); ok {start = t.Segment.Stop}
+		}
+		if par := n.Parent(); start < 0 && par != nil && par.Type() == ast.TypeBlock && par.Lines().Len() > 0 {
+			start = par.Lines().At(0).Start
+		}
+		if start >= 0 {
+			if i := bytes.Index(source[start:], n.Label(source)); i >= 0 {return bytes.Count(source[:start+i], []byte("\n"))}
+		}
+	}
+	for c := node; c != nil; c = c.FirstChild() {
+		if t, ok := c.(*ast.Text); ok {return bytes.Count(source[:t.Segment.Start], []byte("\n"))}
+	}
+	for p := node; p != nil; p = p.Parent() {
+		if p.Type() == ast.TypeBlock && p.Lines().Len() > 0 {
+			return bytes.Count(source[:p.Lines().At(0).Start], []byte("\n"))
+		}
+	}
+	return 0
+}
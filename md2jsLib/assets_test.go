@@ -0,0 +1,147 @@
+// assets_test.go
+// tests of the local image pipeline: hashed copies, image sizes, data urls and
+// diagnostics of missing images
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	md2js "goDemo/goldmark/samples/rendererV3"
+)
+
+func writePng(t *testing.T, fil string, w, h int) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, w, h))); err != nil {t.Fatal(err)}
+	if err := os.WriteFile(fil, buf.Bytes(), 0666); err != nil {t.Fatal(err)}
+}
+
+func TestAssets(t *testing.T) {
+
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "img"), 0755); err != nil {t.Fatal(err)}
+	writePng(t, filepath.Join(dir, "img", "small.png"), 3, 2)
+	writePng(t, filepath.Join(dir, "img", "big cat.png"), 300, 200)
+	src := "# images\n\n![s](img/small.png) ![b](img/big%20cat.png)\n\n![m](img/missing.png) ![r](https://example.com/r.png)\n"
+	md := filepath.Join(dir, "doc.md")
+	if err := os.WriteFile(md, []byte(src), 0666); err != nil {t.Fatal(err)}
+
+	out := filepath.Join(dir, "out", "assets")
+	ao := &AssetOptions{Dir: out, URL: "assets/", InlineMax: 200}
+	res, err := ConvertFile(context.Background(), md, Options{Name: "doc", Assets: ao})
+	if err != nil {t.Fatal(err)}
+	js := string(res.JS)
+
+	if !strings.Contains(js, "data:image/png;base64,") {t.Errorf("small image not inlined:\n%s", js)}
+	copied := regexp.MustCompile(`assets/big%20cat-[0-9a-f]{10}\.png`).FindString(js)
+	if len(copied) == 0 {t.Fatalf("big image not copied:\n%s", js)}
+	nam, _ := strings.CutPrefix(copied, "assets/")
+	if _, err := os.Stat(filepath.Join(out, strings.ReplaceAll(nam, "%20", " "))); err != nil {t.Error(err)}
+	for _, want := range []string{"width=3;", "height=2;", "width=300;", "height=200;", "https://example.com/r.png"} {
+		if !strings.Contains(js, want) {t.Errorf("missing %q in:\n%s", want, js)}
+	}
+
+	if len(res.Diagnostics) != 1 {t.Fatalf("diagnostics: %v", res.Diagnostics)}
+	if d := res.Diagnostics[0]; d.Line != 5 || d.Severity != SevWarning || !strings.Contains(d.Msg, "img/missing.png") {
+		t.Errorf("diagnostic: %v", d)
+	}
+
+	// the copy is named after the content: a second conversion finds it
+	res, err = ConvertFile(context.Background(), md, Options{Name: "doc", Assets: ao})
+	if err != nil {t.Fatal(err)}
+	if !strings.Contains(string(res.JS), copied) {t.Errorf("copy renamed:\n%s", res.JS)}
+	entries, _ := os.ReadDir(out)
+	if len(entries) != 1 {t.Errorf("asset directory: %v", entries)}
+}
+
+// only images inside the root are processed: no copies of other files
+func TestAssetsRoot(t *testing.T) {
+
+	dir := t.TempDir()
+	docs := filepath.Join(dir, "docs")
+	if err := os.Mkdir(docs, 0755); err != nil {t.Fatal(err)}
+	writePng(t, filepath.Join(dir, "outside.png"), 2, 2)
+	if err := os.WriteFile(filepath.Join(docs, "id_rsa"), []byte("-----BEGIN KEY-----\n"), 0600); err != nil {t.Fatal(err)}
+	src := "![o](../outside.png)\n\n![k](id_rsa)\n"
+	md := filepath.Join(docs, "doc.md")
+	if err := os.WriteFile(md, []byte(src), 0666); err != nil {t.Fatal(err)}
+
+	out := filepath.Join(dir, "out", "assets")
+	res, err := ConvertFile(context.Background(), md, Options{Name: "doc", Assets: &AssetOptions{Dir: out, URL: "assets/", InlineMax: 1000}})
+	if err != nil {t.Fatal(err)}
+	js := string(res.JS)
+	for _, want := range []string{"'../outside.png'", "'id_rsa'"} {
+		if !strings.Contains(js, want) {t.Errorf("missing %s in:\n%s", want, js)}
+	}
+	if strings.Contains(js, "data:") {t.Errorf("file inlined:\n%s", js)}
+	if _, err := os.Stat(out); !os.IsNotExist(err) {t.Errorf("asset directory created: %v", err)}
+
+	var got []string
+	for _, d := range res.Diagnostics {got = append(got, d.String())}
+	want := []string{
+		"error: line 1: Image: image outside of the input directory: ../outside.png",
+		"error: line 3: Image: not an image: id_rsa",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {t.Errorf("diagnostics:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))}
+}
+
+// the srcset of the variants is computed before the copy and the variants are copied with the image
+func TestAssetsVariants(t *testing.T) {
+
+	dir := t.TempDir()
+	writePng(t, filepath.Join(dir, "cat.png"), 40, 30)
+	writePng(t, filepath.Join(dir, "cat@2x.png"), 80, 60)
+	md := filepath.Join(dir, "doc.md")
+	if err := os.WriteFile(md, []byte("![c](cat.png)\n"), 0666); err != nil {t.Fatal(err)}
+
+	out := filepath.Join(dir, "out", "assets")
+	opts := Options{
+		Name:            "doc",
+		Assets:          &AssetOptions{Dir: out, URL: "assets/"},
+		RendererOptions: []md2js.Option{md2js.WithImageVariants()},
+	}
+	res, err := ConvertFile(context.Background(), md, opts)
+	if err != nil {t.Fatal(err)}
+	srcset := regexp.MustCompile(`srcset='([^']*)'`).FindStringSubmatch(string(res.JS))
+	if srcset == nil {t.Fatalf("no srcset:\n%s", res.JS)}
+	if !regexp.MustCompile(`^assets/cat-[0-9a-f]{10}\.png 1x, assets/cat@2x-[0-9a-f]{10}\.png 2x$`).MatchString(srcset[1]) {
+		t.Errorf("srcset: %s", srcset[1])
+	}
+	entries, _ := os.ReadDir(out)
+	if len(entries) != 2 {t.Errorf("asset directory: %v", entries)}
+	if len(res.Diagnostics) != 0 {t.Errorf("diagnostics: %v", res.Diagnostics)}
+}
+
+// a small image is not inlined if the image rule of the url policy blocks its data url
+func TestAssetsURLPolicy(t *testing.T) {
+
+	dir := t.TempDir()
+	writePng(t, filepath.Join(dir, "dot.png"), 1, 1)
+	md := filepath.Join(dir, "doc.md")
+	if err := os.WriteFile(md, []byte("![d](dot.png)\n"), 0666); err != nil {t.Fatal(err)}
+
+	policy := md2js.DefaultURLPolicy()
+	policy.Images.Block = append(policy.Images.Block, "data:image/png")
+	opts := Options{
+		Name:            "doc",
+		Assets:          &AssetOptions{Dir: filepath.Join(dir, "assets"), URL: "assets/", InlineMax: 1000},
+		RendererOptions: []md2js.Option{md2js.WithURLPolicy(policy)},
+	}
+	res, err := ConvertFile(context.Background(), md, opts)
+	if err != nil {t.Fatal(err)}
+	if js := string(res.JS); strings.Contains(js, "data:") || !strings.Contains(js, "src='assets/dot-") {t.Errorf("data url inlined:\n%s", js)}
+	if len(res.Diagnostics) != 0 {t.Errorf("diagnostics: %v", res.Diagnostics)}
+}
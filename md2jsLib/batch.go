@@ -0,0 +1,208 @@
+// batch.go
+// batch conversion of markdown files with a pool of workers
+// the outputs and their meta data are listed in a manifest
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A BatchInput is a markdown file of a batch.
+type BatchInput struct {
+	// Path is the file to convert.
+	Path string
+	// Rel is the path of the output relative to the output directory, without extension.
+	Rel string
+}
+
+// BatchOptions configures a batch conversion.
+type BatchOptions struct {
+	Inputs []BatchInput
+	// OutDir is the directory of the js scripts.
+	OutDir string
+	// Workers is the number of concurrent conversions. It defaults to the number of cpus.
+	Workers int
+	// Options returns the conversion options of a markdown file.
+	// The files of one directory share a Converter built from the options of the first file.
+	Options func(mdFil string) (Options, error)
+	// Page writes standalone html pages instead of js scripts.
+	Page bool
+}
+
+// A ManifestEntry describes the conversion of one file.
+type ManifestEntry struct {
+	Source      string     `json:"source"`
+	Output      string     `json:"output,omitempty"`
+	Meta        *Meta      `json:"meta,omitempty"`
+	Toc         []TocEntry `json:"toc,omitempty"`
+	Diagnostics []string   `json:"diagnostics,omitempty"`
+	Errors      int        `json:"errors,omitempty"`
+	Warnings    int        `json:"warnings,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	Bytes       int        `json:"bytes"`
+}
+
+// A Manifest lists the outputs of a batch conversion in the order of the inputs.
+type Manifest struct {
+	Generated time.Time       `json:"generated"`
+	Files     []ManifestEntry `json:"files"`
+}
+
+// TreeInputs returns the markdown files in root and its sub directories.
+// The relative paths keep the directory structure below root.
+func TreeInputs(root string) (inputs []BatchInput, err error) {
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {return err}
+		if d.IsDir() || filepath.Ext(p) != ".md" {return nil}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {return err}
+		inputs = append(inputs, BatchInput{Path: p, Rel: strings.TrimSuffix(rel, ".md")})
+		return nil
+	})
+	if err != nil {return nil, err}
+	sort.Slice(inputs, func(i, j int) bool {return inputs[i].Path < inputs[j].Path})
+	return inputs, nil
+}
+
+// Batch converts the inputs into js scripts in the output directory.
+// A failed file is recorded in its manifest entry and does not abort the batch.
+// Batch returns an error only if the context is cancelled.
+func Batch(ctx context.Context, bo BatchOptions) (*Manifest, error) {
+
+	workers := bo.Workers
+	if workers < 1 {workers = runtime.NumCPU()}
+	optsFunc := bo.Options
+	if optsFunc == nil {optsFunc = func(string) (Options, error) {return Options{}, nil}}
+
+	man := &Manifest{
+		Generated: time.Now().UTC(),
+		Files:     make([]ManifestEntry, len(bo.Inputs)),
+	}
+
+	// converters are shared by the files of a directory
+	var mu sync.Mutex
+	convs := make(map[string]*Converter)
+	converter := func(fil string) (*Converter, error) {
+		dir := filepath.Dir(fil)
+		mu.Lock()
+		defer mu.Unlock()
+		if c, ok := convs[dir]; ok {return c, nil}
+		opts, err := optsFunc(fil)
+		if err != nil {return nil, err}
+		c := NewConverter(opts)
+		convs[dir] = c
+		return c, nil
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				man.Files[idx] = bo.convertEntry(ctx, bo.Inputs[idx], converter)
+			}
+		}()
+	}
+
+	var err error
+	for idx := range bo.Inputs {
+		if err = ctx.Err(); err != nil {break}
+		jobs <- idx
+	}
+	close(jobs)
+	wg.Wait()
+	return man, err
+}
+
+// convertEntry converts one input and returns its manifest entry.
+func (bo *BatchOptions) convertEntry(ctx context.Context, in BatchInput, converter func(string) (*Converter, error)) (ent ManifestEntry) {
+
+	ent.Source = in.Path
+	c, err := converter(in.Path)
+	if err != nil {
+		ent.Error = err.Error()
+		return ent
+	}
+	res, err := c.ConvertFile(ctx, in.Path)
+	if res == nil {
+		ent.Error = err.Error()
+		return ent
+	}
+	if err != nil {ent.Error = err.Error()}
+
+	ent.Meta = res.Meta
+	ent.Toc = res.Toc
+	for _, d := range res.Diagnostics {ent.Diagnostics = append(ent.Diagnostics, d.String())}
+	ent.Errors, ent.Warnings = CountDiagnostics(res.Diagnostics)
+
+	data, ext := res.JS, ".js"
+	if bo.Page {
+		po, perr := c.PageOptions()
+		if perr != nil {
+			ent.Error = perr.Error()
+			return ent
+		}
+		po.Title = filepath.Base(in.Rel)
+		data, ext = res.Page(po), ".html"
+	}
+
+	outFil := filepath.Join(bo.OutDir, in.Rel+ext)
+	if werr := os.MkdirAll(filepath.Dir(outFil), 0755); werr != nil {
+		ent.Error = werr.Error()
+		return ent
+	}
+	if werr := os.WriteFile(outFil, data, 0666); werr != nil {
+		ent.Error = fmt.Sprintf("write output: %v", werr)
+		return ent
+	}
+	ent.Output = outFil
+	ent.Bytes = len(data)
+	return ent
+}
+
+// Failed returns the entries of the files that could not be converted.
+func (man *Manifest) Failed() []ManifestEntry {
+	var failed []ManifestEntry
+	for _, ent := range man.Files {
+		if len(ent.Error) > 0 {failed = append(failed, ent)}
+	}
+	return failed
+}
+
+// DiagnosticCounts returns the number of errors and warnings of the batch
+// and the number of files with any.
+func (man *Manifest) DiagnosticCounts() (errors, warnings, files int) {
+	for _, ent := range man.Files {
+		errors += ent.Errors
+		warnings += ent.Warnings
+		if ent.Errors+ent.Warnings > 0 {files++}
+	}
+	return errors, warnings, files
+}
+
+// WriteFile writes the manifest as json.
+func (man *Manifest) WriteFile(fil string) error {
+	data, err := json.MarshalIndent(man, "", "  ")
+	if err != nil {return fmt.Errorf("manifest: %v", err)}
+	err = os.WriteFile(fil, append(data, '\n'), 0666)
+	if err != nil {return fmt.Errorf("write manifest: %v", err)}
+	return nil
+}
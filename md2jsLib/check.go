@@ -0,0 +1,258 @@
+// check.go
+// offline link checker: the links and images of a document set must point at existing
+// files, fragments at the heading or attribute ids of their document, reference links at
+// a link reference definition and footnote references at a footnote; external urls are
+// listed, not fetched
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// CheckOptions configures a link check.
+type CheckOptions struct {
+	// Inputs are the markdown files to check.
+	Inputs []string
+	// Root is the directory of absolute paths (/img/cat.png); they are not checked if empty.
+	Root string
+	// Options returns the conversion options of a markdown file; only the extensions are used.
+	Options func(mdFil string) (Options, error)
+}
+
+// An ExternalURL is a link or image that points at another host.
+type ExternalURL struct {
+	URL  string `json:"url"`
+	Line int    `json:"line"`
+}
+
+// A CheckFile is the result of the check of one file.
+type CheckFile struct {
+	Path        string
+	Diagnostics []Diagnostic
+	External    []ExternalURL
+	// Error is set if the file could not be read or parsed.
+	Error error
+}
+
+// A CheckReport lists the results of the checked files in the order of the inputs.
+type CheckReport struct {
+	Files []CheckFile
+}
+
+// Errors returns the number of broken links and unreadable files.
+func (rep *CheckReport) Errors() int {
+	n := 0
+	for _, f := range rep.Files {
+		errs, _ := CountDiagnostics(f.Diagnostics)
+		n += errs
+		if f.Error != nil {n++}
+	}
+	return n
+}
+
+// checkDoc is a parsed document with its anchors.
+type checkDoc struct {
+	source    []byte
+	firstLine int
+	root      ast.Node
+	ids       map[string]bool
+	footnotes bool
+}
+
+type checker struct {
+	co   CheckOptions
+	docs map[string]*checkDoc
+	errs map[string]error
+}
+
+// Check checks the links of the inputs. Linked markdown files outside the inputs are
+// parsed for their anchors but not checked themselves.
+func Check(ctx context.Context, co CheckOptions) (rep *CheckReport, err error) {
+	ck := &checker{co: co, docs: make(map[string]*checkDoc), errs: make(map[string]error)}
+	rep = &CheckReport{}
+	for _, in := range co.Inputs {
+		if err := ctx.Err(); err != nil {return nil, err}
+		cf := CheckFile{Path: in}
+		doc, err := ck.doc(in)
+		if err != nil {
+			cf.Error = err
+		} else {
+			ck.checkLinks(&cf, doc, filepath.Dir(in))
+		}
+		rep.Files = append(rep.Files, cf)
+	}
+	return rep, nil
+}
+
+// doc returns the parsed document of a markdown file.
+func (ck *checker) doc(fil string) (*checkDoc, error) {
+	key, err := filepath.Abs(fil)
+	if err != nil {key = fil}
+	if doc, ok := ck.docs[key]; ok {return doc, nil}
+	if err, ok := ck.errs[key]; ok {return nil, err}
+	doc, err := ck.parse(fil)
+	if err != nil {
+		ck.errs[key] = err
+		return nil, err
+	}
+	ck.docs[key] = doc
+	return doc, nil
+}
+
+// parse parses a markdown file with the parser of the converter and collects its ids.
+func (ck *checker) parse(fil string) (*checkDoc, error) {
+	var opts Options
+	if ck.co.Options != nil {
+		var err error
+		opts, err = ck.co.Options(fil)
+		if err != nil {return nil, err}
+	}
+	src, err := os.ReadFile(fil)
+	if err != nil {return nil, err}
+	parts, err := SplitSource(src)
+	if err != nil {return nil, fmt.Errorf("split: %v", err)}
+
+	md := goldmark.New(
+		goldmark.WithExtensions(opts.Extensions...),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+	doc := &checkDoc{source: parts.Main, firstLine: parts.MainLine, ids: make(map[string]bool)}
+	doc.root = md.Parser().Parse(text.NewReader(parts.Main))
+	for _, ext := range opts.Extensions {
+		if ext == extension.Footnote {doc.footnotes = true}
+	}
+	_ = ast.Walk(doc.root, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {return ast.WalkContinue, nil}
+		if id, ok := node.AttributeString("id"); ok {
+			switch v := id.(type) {
+			case []byte:
+				doc.ids[string(v)] = true
+			default:
+				doc.ids[fmt.Sprint(v)] = true
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	return doc, nil
+}
+
+// checkLinks checks the links, images, reference links and footnote references of a document in dir.
+func (ck *checker) checkLinks(cf *CheckFile, doc *checkDoc, dir string) {
+	_ = ast.Walk(doc.root, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {return ast.WalkContinue, nil}
+		line := doc.firstLine + nodeLine(node, doc.source)
+		switch n := node.(type) {
+		case *ast.Link:
+			ck.checkURL(cf, doc, dir, "Link", string(n.Destination), line)
+		case *ast.Image:
+			ck.checkURL(cf, doc, dir, "Image", string(n.Destination), line)
+		case *ast.AutoLink:
+			if n.AutoLinkType == ast.AutoLinkURL {ck.checkURL(cf, doc, dir, "AutoLink", string(n.URL(doc.source)), line)}
+		case *ast.Text:
+			ck.checkRefs(cf, doc, n)
+		case *ast.CodeSpan:
+			return ast.WalkSkipChildren, nil
+		}
+		return ast.WalkContinue, nil
+	})
+}
+
+// checkURL checks one destination: a fragment of the document, a relative or absolute file,
+// optionally with a fragment of a markdown file, or an external url.
+func (ck *checker) checkURL(cf *CheckFile, doc *checkDoc, dir, kind, dest string, line int) {
+	diag := func(format string, args ...interface{}) {
+		cf.Diagnostics = append(cf.Diagnostics, Diagnostic{Severity: SevError, Kind: kind, Line: line, Msg: fmt.Sprintf(format, args...)})
+	}
+	switch {
+	case len(dest) == 0:
+		return
+	case strings.HasPrefix(dest, "//"):
+		cf.External = append(cf.External, ExternalURL{dest, line})
+		return
+	case dest[0] == '#':
+		if frag := unescape(dest[1:]); !doc.ids[frag] {diag("anchor #%s not found", frag)}
+		return
+	}
+	if i := strings.IndexAny(dest, ":/?#"); i > 0 && dest[i] == ':' {
+		if scheme := strings.ToLower(dest[:i]); scheme == "http" || scheme == "https" {
+			cf.External = append(cf.External, ExternalURL{dest, line})
+		}
+		return
+	}
+
+	p, frag := dest, ""
+	if i := strings.IndexByte(p, '#'); i >= 0 {p, frag = p[:i], unescape(p[i+1:])}
+	if i := strings.IndexByte(p, '?'); i >= 0 {p = p[:i]}
+	p = unescape(p)
+	fil := filepath.Join(dir, filepath.FromSlash(p))
+	if strings.HasPrefix(p, "/") {
+		if len(ck.co.Root) == 0 {return}
+		fil = filepath.Join(ck.co.Root, filepath.FromSlash(p))
+	}
+	fi, err := os.Stat(fil)
+	if err != nil {
+		diag("file not found: %s", p)
+		return
+	}
+	if len(frag) == 0 || fi.IsDir() || !strings.EqualFold(filepath.Ext(fil), ".md") {return}
+	target, err := ck.doc(fil)
+	if err != nil {
+		diag("%s: %v", p, err)
+		return
+	}
+	if !target.ids[frag] {diag("anchor #%s not found in %s", frag, p)}
+}
+
+var footnoteRe = regexp.MustCompile(`\[\^([^\]\s]+)\]`)
+
+// referenceRe matches a full [text][label] or a collapsed [label][] reference link.
+var referenceRe = regexp.MustCompile(`\[([^\]\[^][^\]\[]*)\]\[([^\]\[]*)\]`)
+
+// checkRefs reports the reference links and footnote references in a run of texts starting
+// with n: the parser keeps a reference without definition as text.
+func (ck *checker) checkRefs(cf *CheckFile, doc *checkDoc, n *ast.Text) {
+	if prev := n.PreviousSibling(); prev != nil && prev.Kind() == ast.KindText {return}
+	start, stop := n.Segment.Start, n.Segment.Stop
+	for c := n.NextSibling(); c != nil && c.Kind() == ast.KindText; c = c.NextSibling() {stop = c.(*ast.Text).Segment.Stop}
+	run := doc.source[start:stop]
+	diag := func(kind string, pos int, format string, args ...interface{}) {
+		line := doc.firstLine + bytes.Count(doc.source[:pos], []byte("\n"))
+		col := pos - bytes.LastIndexByte(doc.source[:pos], '\n')
+		cf.Diagnostics = append(cf.Diagnostics, Diagnostic{Severity: SevError, Kind: kind, Line: line, Col: col,
+			Msg: fmt.Sprintf(format, args...)})
+	}
+	for _, m := range referenceRe.FindAllSubmatchIndex(run, -1) {
+		label := run[m[4]:m[5]]
+		if len(label) == 0 {label = run[m[2]:m[3]]}
+		diag("Link", start+m[0], "reference [%s] not defined", label)
+	}
+	if !doc.footnotes {return}
+	for _, m := range footnoteRe.FindAllSubmatchIndex(run, -1) {
+		diag("Footnote", start+m[0], "footnote [^%s] not defined", run[m[2]:m[3]])
+	}
+}
+
+// unescape returns the percent decoded path or fragment, or s if it is not valid.
+func unescape(s string) string {
+	if u, err := url.PathUnescape(s); err == nil {return u}
+	return s
+}
@@ -0,0 +1,74 @@
+// check_test.go
+// tests of the offline link checker: missing files, anchors of the document and of
+// linked documents, attribute ids, undefined reference links and footnotes and the list of
+// external urls
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	attributes "goDemo/goldmark/samples/extBlockAttr"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+func TestCheck(t *testing.T) {
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.md": "---\ntitle: a\n---\n# Intro\n\n" +
+			"See [lists](sub/Lists.md#ordered), [bad](sub/Lists.md#nope), [gone](missing.md)\n" +
+			"and [top](#intro), [none](#outro), ![img](img/cat%20one.png) <https://example.org/x>.\n\n" +
+			"A note[^1], a missing[^2] one and `[^3]` [abs](/img/cat%20one.png).\n\n[^1]: the note\n\n" +
+			"# Year {id=2024}\n\n[year](#2024), [ref][def], [bad][nodef] and [coll][]\n\n[def]: #intro\n",
+		"sub/Lists.md":    "## Ordered\n\n[up](../a.md#intro) [web](https://example.com/) [mail](mailto:a@b.c)\n",
+		"img/cat one.png": "",
+	}
+	for nam, src := range files {
+		fil := filepath.Join(dir, filepath.FromSlash(nam))
+		if err := os.MkdirAll(filepath.Dir(fil), 0755); err != nil {t.Fatal(err)}
+		if err := os.WriteFile(fil, []byte(src), 0666); err != nil {t.Fatal(err)}
+	}
+
+	co := CheckOptions{
+		Inputs: []string{filepath.Join(dir, "a.md"), filepath.Join(dir, "sub", "Lists.md"), filepath.Join(dir, "none.md")},
+		Root:   dir,
+		Options: func(string) (Options, error) {
+			return Options{Extensions: []goldmark.Extender{extension.Footnote, attributes.Extension}}, nil
+		},
+	}
+	rep, err := Check(context.Background(), co)
+	if err != nil {t.Fatal(err)}
+	if len(rep.Files) != 3 {t.Fatalf("%d files", len(rep.Files))}
+
+	var got []string
+	for _, d := range rep.Files[0].Diagnostics {got = append(got, d.String())}
+	want := []string{
+		"error: line 6: Link: anchor #nope not found in sub/Lists.md",
+		"error: line 6: Link: file not found: missing.md",
+		"error: line 7: Link: anchor #outro not found",
+		"error: line 9:22: Footnote: footnote [^2] not defined",
+		"error: line 15:28: Link: reference [nodef] not defined",
+		"error: line 15:45: Link: reference [coll] not defined",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diagnostics:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if ext := rep.Files[0].External; len(ext) != 1 || ext[0] != (ExternalURL{"https://example.org/x", 7}) {
+		t.Errorf("external urls: %v", ext)
+	}
+	if f := rep.Files[1]; len(f.Diagnostics) != 0 || len(f.External) != 1 {t.Errorf("sub/Lists.md: %v %v", f.Diagnostics, f.External)}
+	if rep.Files[2].Error == nil {t.Errorf("missing input not reported")}
+	if n := rep.Errors(); n != 7 {t.Errorf("%d errors, want 7", n)}
+}
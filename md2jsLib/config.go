@@ -0,0 +1,440 @@
+// config.go
+// project configuration file md2js.yaml
+//
+// example:
+//
+//	inDir: md
+//	outDir: script
+//	theme: style/mdStyle.js
+//	site: site/mdSite.js
+//	azul: "1.0"                   # azul runtime version, default: latest
+//	links:
+//	  base: https://example.com/docs/   # url of inDir
+//	  route: "{path}.html"        # url of a linked markdown file
+//	  renderCall: false           # site.open(path, fragment) renders linked files
+//	  schemes:
+//	    ticket: https://tracker.example.com/issue/{ref}
+//	assets:
+//	  dir: assets                 # build copies the local images into outDir/assets
+//	  inline: 2048                # images up to 2048 bytes become data urls
+//	extensions:
+//	  tables: true
+//	  footnotes: true
+//	  attributes: true
+//	  imageAttributes: false
+//	  linkify: true               # urls in the text become links
+//	renderer:
+//	  hardWraps: false
+//	  unsafe: false
+//	  eastAsianLineBreaks: none   # none, simple or css3draft
+//	  figures: true                # figure and figcaption for images alone in a paragraph
+//	  figureLabel: Figure          # numbered captions "Figure 1:"
+//	  lazyImages: true             # loading=lazy, decoding=async
+//	  imageVariants: true          # srcset from cat@2x.png, cat-800w.png
+//	  externalLinks:               # target=_blank, rel="noopener noreferrer"
+//	    internal: [example.com]    # and its sub domains
+//	    marker: "↗"
+//	  urls:                        # url policy, a rule replaces the default rule
+//	    links:
+//	      allowList: true          # remove the urls of the other schemes
+//	      allow: [http, https, mailto, tel, ssh]
+//	    images:
+//	      block: [javascript, vbscript, file, data]
+//	      allow: [data:image/png]  # more specific than data
+//	overrides:
+//	  - dir: md/blog
+//	    theme: style/blogStyle.js
+//	    renderer:
+//	      hardWraps: true
+//
+// relative paths are resolved against the directory of the config file
+// an override applies to the markdown files in its directory and below;
+// more specific directories win
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"goDemo/goldmark/samples/azul"
+	md2js "goDemo/goldmark/samples/rendererV3"
+	attributes "goDemo/goldmark/samples/extBlockAttr"
+
+	"github.com/goccy/go-yaml"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// ConfigFile is the name of the project configuration file.
+const ConfigFile = "md2js.yaml"
+
+// ExtConfig selects the goldmark extensions. A nil value leaves the setting unchanged.
+type ExtConfig struct {
+	Tables          *bool `yaml:"tables"`
+	Footnotes       *bool `yaml:"footnotes"`
+	Attributes      *bool `yaml:"attributes"`
+	// ImageAttributes enables the attributes extension as Attributes does
+	ImageAttributes *bool `yaml:"imageAttributes"`
+	// Linkify turns urls in the text into autolinks.
+	Linkify *bool `yaml:"linkify"`
+}
+
+// RendererConfig holds the md2jsV3 renderer options. A nil value leaves the setting unchanged.
+type RendererConfig struct {
+	HardWraps *bool `yaml:"hardWraps"`
+	Unsafe    *bool `yaml:"unsafe"`
+	// EastAsianLineBreaks is one of none, simple or css3draft.
+	EastAsianLineBreaks string `yaml:"eastAsianLineBreaks"`
+	// Figures renders images alone in a paragraph as figures.
+	Figures *bool `yaml:"figures"`
+	// FigureLabel numbers the figures: "Figure" gives "Figure 1:".
+	FigureLabel string `yaml:"figureLabel"`
+	LazyImages  *bool  `yaml:"lazyImages"`
+	// ImageVariants sets the srcset of images from the variant files next to them.
+	ImageVariants *bool `yaml:"imageVariants"`
+	// URLs is the url policy of links and images.
+	URLs *URLPolicyConfig `yaml:"urls"`
+	// ExternalLinks opens the links to other hosts in a new tab.
+	ExternalLinks *ExternalLinkConfig `yaml:"externalLinks"`
+}
+
+// ExternalLinkConfig configures the links to hosts outside the internal domains.
+type ExternalLinkConfig struct {
+	// Internal are the internal domains, including their sub domains.
+	Internal []string `yaml:"internal"`
+	// Marker is the text of the marker appended to external links; empty for none.
+	Marker string `yaml:"marker"`
+}
+
+// URLPolicyConfig has the url rules of links and images; a missing rule is the rule of
+// md2js.DefaultURLPolicy.
+type URLPolicyConfig struct {
+	Links  *URLRuleConfig `yaml:"links"`
+	Images *URLRuleConfig `yaml:"images"`
+}
+
+// URLRuleConfig is a blocklist or, with AllowList, an allowlist of schemes (mailto) and
+// data url media types (data:image/png).
+type URLRuleConfig struct {
+	AllowList bool     `yaml:"allowList"`
+	Allow     []string `yaml:"allow"`
+	Block     []string `yaml:"block"`
+}
+
+// policy returns the renderer url policy of the config.
+func (uc *URLPolicyConfig) policy() md2js.URLPolicy {
+	policy := md2js.DefaultURLPolicy()
+	if rc := uc.Links; rc != nil {policy.Links = md2js.URLRule{AllowList: rc.AllowList, Allow: rc.Allow, Block: rc.Block}}
+	if rc := uc.Images; rc != nil {policy.Images = md2js.URLRule{AllowList: rc.AllowList, Allow: rc.Allow, Block: rc.Block}}
+	return policy
+}
+
+// validate checks the entries of the rules.
+func (uc *URLPolicyConfig) validate() error {
+	if uc == nil {return nil}
+	for _, rc := range []*URLRuleConfig{uc.Links, uc.Images} {
+		if rc == nil {continue}
+		for _, e := range append(rc.Allow[:len(rc.Allow):len(rc.Allow)], rc.Block...) {
+			if !md2js.ValidSchemeEntry(e) {return fmt.Errorf("urls: invalid scheme %q", e)}
+		}
+	}
+	return nil
+}
+
+// AssetConfig configures the local image pipeline.
+type AssetConfig struct {
+	// Dir is the asset directory below the output directory; empty keeps the images in place.
+	Dir string `yaml:"dir"`
+	// Inline is the size in bytes up to which images are inlined as data urls.
+	Inline int64 `yaml:"inline"`
+}
+
+// LinkConfig configures the link resolver of the renderer.
+type LinkConfig struct {
+	// Base is the url of the input directory: the links of a file are resolved against Base
+	// and the directory of the file below inDir.
+	Base string `yaml:"base"`
+	// Route is the url of a converted document, {path} is the path of the markdown file without .md.
+	Route string `yaml:"route"`
+	// RenderCall renders linked documents with site.open(path, fragment).
+	RenderCall bool `yaml:"renderCall"`
+	// Schemes map custom schemes to urls, {ref} is the reference: ticket: "https://tracker/{ref}".
+	Schemes map[string]string `yaml:"schemes"`
+}
+
+// DirConfig holds the settings that can be overridden per directory.
+type DirConfig struct {
+	Theme      string         `yaml:"theme"`
+	Site       string         `yaml:"site"`
+	Extensions ExtConfig      `yaml:"extensions"`
+	Renderer   RendererConfig `yaml:"renderer"`
+}
+
+// An Override applies settings to the markdown files in Dir and below.
+type Override struct {
+	Dir       string `yaml:"dir"`
+	DirConfig `yaml:",inline"`
+}
+
+// Config is the project configuration.
+type Config struct {
+	InDir     string     `yaml:"inDir"`
+	OutDir    string     `yaml:"outDir"`
+	// Azul is the version of the azul runtime the scripts target.
+	Azul      string     `yaml:"azul"`
+	// Assets configures the local image pipeline of build.
+	Assets    AssetConfig `yaml:"assets"`
+	// Links configures the link resolver.
+	Links     LinkConfig `yaml:"links"`
+	DirConfig `yaml:",inline"`
+	Overrides []Override `yaml:"overrides"`
+
+	// file is the config file, empty for DefaultConfig
+	file string
+	// dir is the directory of the config file
+	dir string
+}
+
+// DefaultConfig returns the configuration used without a config file.
+// It matches the directory layout of this project.
+func DefaultConfig() *Config {
+	return &Config{
+		InDir:  "md",
+		OutDir: "script",
+		DirConfig: DirConfig{
+			Theme: "style/mdStyle.js",
+			Site:  "site/mdSite.js",
+		},
+		dir: ".",
+	}
+}
+
+// LoadConfig reads a config file. Missing directories default to those of DefaultConfig;
+// theme and site scripts are only included if the file names them.
+func LoadConfig(fil string) (cfg *Config, err error) {
+
+	data, err := os.ReadFile(fil)
+	if err != nil {return nil, fmt.Errorf("read config: %v", err)}
+
+	def := DefaultConfig()
+	cfg = &Config{InDir: def.InDir, OutDir: def.OutDir}
+	err = yaml.Unmarshal(data, cfg)
+	if err != nil {return nil, fmt.Errorf("config %s: %v", fil, err)}
+	cfg.file = fil
+	cfg.dir = filepath.Dir(fil)
+
+	if _, err := eastAsian(cfg.Renderer.EastAsianLineBreaks); err != nil {
+		return nil, fmt.Errorf("config %s: %v", fil, err)
+	}
+	if err := cfg.Renderer.URLs.validate(); err != nil {return nil, fmt.Errorf("config %s: %v", fil, err)}
+	if len(cfg.Azul) > 0 {
+		if _, err := azul.Runtime(cfg.Azul); err != nil {return nil, fmt.Errorf("config %s: %v", fil, err)}
+	}
+	for i, ov := range cfg.Overrides {
+		if len(ov.Dir) == 0 {return nil, fmt.Errorf("config %s: override %d has no dir", fil, i+1)}
+		if _, err := eastAsian(ov.Renderer.EastAsianLineBreaks); err != nil {
+			return nil, fmt.Errorf("config %s: override %s: %v", fil, ov.Dir, err)
+		}
+		if err := ov.Renderer.URLs.validate(); err != nil {
+			return nil, fmt.Errorf("config %s: override %s: %v", fil, ov.Dir, err)
+		}
+	}
+	return cfg, nil
+}
+
+// FindConfig searches dir and its parents for the config file.
+// It returns an empty name if there is none.
+func FindConfig(dir string) (fil string, err error) {
+	dir, err = filepath.Abs(dir)
+	if err != nil {return "", err}
+	for {
+		fil = filepath.Join(dir, ConfigFile)
+		if _, err := os.Stat(fil); err == nil {return fil, nil}
+		par := filepath.Dir(dir)
+		if par == dir {return "", nil}
+		dir = par
+	}
+}
+
+// LoadProjectConfig loads the config file fil or, if fil is empty,
+// the config file found from the working directory. Without a config
+// file it returns DefaultConfig.
+func LoadProjectConfig(fil string) (cfg *Config, err error) {
+	if len(fil) == 0 {
+		fil, err = FindConfig(".")
+		if err != nil {return nil, err}
+		if len(fil) == 0 {return DefaultConfig(), nil}
+	}
+	return LoadConfig(fil)
+}
+
+// Path resolves a path of the config file against the config directory.
+func (cfg *Config) Path(p string) string {
+	if len(p) == 0 || filepath.IsAbs(p) {return p}
+	return filepath.Join(cfg.dir, p)
+}
+
+// Dir returns the directory of the config file.
+func (cfg *Config) Dir() string {
+	return cfg.dir
+}
+
+// File returns the name of the config file or an empty string for DefaultConfig.
+func (cfg *Config) File() string {
+	return cfg.file
+}
+
+// For returns the settings for a markdown file with the overrides applied.
+func (cfg *Config) For(mdFil string) DirConfig {
+
+	dc := cfg.DirConfig
+	absFil, err := filepath.Abs(mdFil)
+	if err != nil {return dc}
+
+	// apply the matching overrides from the least to the most specific directory
+	ovs := make([]Override, 0, len(cfg.Overrides))
+	for _, ov := range cfg.Overrides {
+		absDir, err := filepath.Abs(cfg.Path(ov.Dir))
+		if err != nil {continue}
+		if absFil == absDir || strings.HasPrefix(absFil, absDir+string(os.PathSeparator)) {
+			ov.Dir = absDir
+			ovs = append(ovs, ov)
+		}
+	}
+	sort.SliceStable(ovs, func(i, j int) bool {return len(ovs[i].Dir) < len(ovs[j].Dir)})
+	for _, ov := range ovs {dc.merge(ov.DirConfig)}
+	return dc
+}
+
+// merge overrides the settings of dc with the settings present in ov.
+func (dc *DirConfig) merge(ov DirConfig) {
+	if len(ov.Theme) > 0 {dc.Theme = ov.Theme}
+	if len(ov.Site) > 0 {dc.Site = ov.Site}
+	mergeBool(&dc.Extensions.Tables, ov.Extensions.Tables)
+	mergeBool(&dc.Extensions.Footnotes, ov.Extensions.Footnotes)
+	mergeBool(&dc.Extensions.Attributes, ov.Extensions.Attributes)
+	mergeBool(&dc.Extensions.ImageAttributes, ov.Extensions.ImageAttributes)
+	mergeBool(&dc.Extensions.Linkify, ov.Extensions.Linkify)
+	mergeBool(&dc.Renderer.HardWraps, ov.Renderer.HardWraps)
+	mergeBool(&dc.Renderer.Unsafe, ov.Renderer.Unsafe)
+	mergeBool(&dc.Renderer.Figures, ov.Renderer.Figures)
+	mergeBool(&dc.Renderer.LazyImages, ov.Renderer.LazyImages)
+	mergeBool(&dc.Renderer.ImageVariants, ov.Renderer.ImageVariants)
+	if len(ov.Renderer.EastAsianLineBreaks) > 0 {dc.Renderer.EastAsianLineBreaks = ov.Renderer.EastAsianLineBreaks}
+	if len(ov.Renderer.FigureLabel) > 0 {dc.Renderer.FigureLabel = ov.Renderer.FigureLabel}
+	if ov.Renderer.URLs != nil {dc.Renderer.URLs = ov.Renderer.URLs}
+	if ov.Renderer.ExternalLinks != nil {dc.Renderer.ExternalLinks = ov.Renderer.ExternalLinks}
+}
+
+func mergeBool(dst **bool, src *bool) {
+	if src != nil {*dst = src}
+}
+
+func isSet(b *bool) bool {
+	return b != nil && *b
+}
+
+// Goldmark returns the goldmark extensions selected by the settings.
+func (dc DirConfig) Goldmark() []goldmark.Extender {
+	var exts []goldmark.Extender
+	if isSet(dc.Extensions.Tables) {exts = append(exts, extension.Table)}
+	if isSet(dc.Extensions.Footnotes) {exts = append(exts, extension.Footnote)}
+	if isSet(dc.Extensions.Linkify) {exts = append(exts, extension.Linkify)}
+	// image attributes are part of the attributes extension
+	if isSet(dc.Extensions.Attributes) || isSet(dc.Extensions.ImageAttributes) {exts = append(exts, attributes.Extension)}
+	return exts
+}
+
+// RendererOptions returns the md2jsV3 renderer options of the settings.
+func (dc DirConfig) RendererOptions() []md2js.Option {
+	var opts []md2js.Option
+	if isSet(dc.Renderer.HardWraps) {opts = append(opts, md2js.WithHardWraps())}
+	if isSet(dc.Renderer.Unsafe) {opts = append(opts, md2js.WithUnsafe())}
+	if ea, _ := eastAsian(dc.Renderer.EastAsianLineBreaks); ea != md2js.EastAsianLineBreaksNone {
+		opts = append(opts, md2js.WithEastAsianLineBreaks(ea))
+	}
+	if len(dc.Renderer.FigureLabel) > 0 {
+		opts = append(opts, md2js.WithFigureNumbers(dc.Renderer.FigureLabel))
+	} else if isSet(dc.Renderer.Figures) {
+		opts = append(opts, md2js.WithFigures())
+	}
+	if isSet(dc.Renderer.LazyImages) {opts = append(opts, md2js.WithLazyImages())}
+	if isSet(dc.Renderer.ImageVariants) {opts = append(opts, md2js.WithImageVariants())}
+	if dc.Renderer.URLs != nil {opts = append(opts, md2js.WithURLPolicy(dc.Renderer.URLs.policy()))}
+	if xc := dc.Renderer.ExternalLinks; xc != nil {
+		opts = append(opts, md2js.WithExternalLinks(md2js.ExternalLinks{Internal: xc.Internal, Marker: xc.Marker}))
+	}
+	return opts
+}
+
+func eastAsian(nam string) (md2js.EastAsianLineBreaks, error) {
+	switch strings.ToLower(nam) {
+	case "", "none":
+		return md2js.EastAsianLineBreaksNone, nil
+	case "simple":
+		return md2js.EastAsianLineBreaksSimple, nil
+	case "css3draft":
+		return md2js.EastAsianLineBreaksCSS3Draft, nil
+	}
+	return md2js.EastAsianLineBreaksNone, fmt.Errorf("invalid eastAsianLineBreaks: %s", nam)
+}
+
+// Options returns the conversion options for a markdown file.
+// The theme and site scripts are read from their files. A missing file is
+// an error if it is named in a config file and skipped for DefaultConfig.
+func (cfg *Config) Options(mdFil string) (opts Options, err error) {
+
+	dc := cfg.For(mdFil)
+	opts.Style, err = cfg.readScript(dc.Theme)
+	if err != nil {return opts, fmt.Errorf("theme: %v", err)}
+	opts.Site, err = cfg.readScript(dc.Site)
+	if err != nil {return opts, fmt.Errorf("site: %v", err)}
+	opts.Extensions = dc.Goldmark()
+	opts.RendererOptions = dc.RendererOptions()
+	if links, ok := cfg.links(mdFil); ok {opts.RendererOptions = append(opts.RendererOptions, md2js.WithLinks(links))}
+	opts.Azul = cfg.Azul
+	return opts, nil
+}
+
+// links returns the link resolver of a markdown file; ok is false if the config has none.
+func (cfg *Config) links(mdFil string) (links md2js.Links, ok bool) {
+	lc := cfg.Links
+	if len(lc.Base) == 0 && len(lc.Route) == 0 && !lc.RenderCall && len(lc.Schemes) == 0 {return links, false}
+	links = md2js.Links{Route: lc.Route, RenderCall: lc.RenderCall}
+	// base is the url of the directory inDir: relative urls resolve below it
+	if len(lc.Base) > 0 {links.BaseURL = strings.TrimSuffix(lc.Base, "/") + "/"}
+	// the directory of a file below inDir is the directory of the render calls and of the base
+	inDir, err := filepath.Abs(cfg.Path(cfg.InDir))
+	dir, derr := filepath.Abs(filepath.Dir(mdFil))
+	rel, rerr := filepath.Rel(inDir, dir)
+	if err == nil && derr == nil && rerr == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+		links.Dir = filepath.ToSlash(rel)
+		if len(lc.Base) > 0 {links.BaseURL += links.Dir + "/"}
+	}
+	if len(lc.Schemes) > 0 {
+		links.Schemes = make(map[string]md2js.SchemeResolver, len(lc.Schemes))
+		for nam, tmpl := range lc.Schemes {
+			tmpl := tmpl
+			links.Schemes[strings.ToLower(nam)] = func(ref string) (string, bool) {
+				return strings.ReplaceAll(tmpl, "{ref}", ref), true
+			}
+		}
+	}
+	return links, true
+}
+
+func (cfg *Config) readScript(fil string) ([]byte, error) {
+	if len(fil) == 0 {return nil, nil}
+	data, err := os.ReadFile(cfg.Path(fil))
+	if err != nil && len(cfg.file) == 0 && os.IsNotExist(err) {return nil, nil}
+	return data, err
+}
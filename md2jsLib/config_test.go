@@ -0,0 +1,45 @@
+// config_test.go
+// tests of the link settings of the config: the base url of the files in inDir and
+// in its sub directories and the directory of the render calls
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfigLinks(t *testing.T) {
+
+	dir := t.TempDir()
+	for _, base := range []string{"https://example.com/docs", "https://example.com/docs/"} {
+		cfgFil := filepath.Join(dir, ConfigFile)
+		if err := os.WriteFile(cfgFil, []byte("inDir: md\nlinks:\n  base: "+base+"\n"), 0666); err != nil {t.Fatal(err)}
+		cfg, err := LoadConfig(cfgFil)
+		if err != nil {t.Fatal(err)}
+
+		tests := []struct {
+			fil  string
+			base string
+			dir  string
+		}{
+			{"md/index.md", "https://example.com/docs/", ""},
+			{"md/guide/intro.md", "https://example.com/docs/guide/", "guide"},
+			{"md/guide/ref/api.md", "https://example.com/docs/guide/ref/", "guide/ref"},
+			{"other/x.md", "https://example.com/docs/", ""},
+		}
+		for _, test := range tests {
+			links, ok := cfg.links(filepath.Join(dir, filepath.FromSlash(test.fil)))
+			if !ok {t.Fatalf("%s: no links", test.fil)}
+			if links.BaseURL != test.base || links.Dir != test.dir {
+				t.Errorf("base %s, %s: %q %q, want %q %q", base, test.fil, links.BaseURL, links.Dir, test.base, test.dir)
+			}
+		}
+	}
+}
@@ -0,0 +1,318 @@
+// md2jsLib.go
+// library that converts markdown sources into js scripts with the md2jsV3 renderer
+// the conversion programs are thin wrappers around Convert
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"goDemo/goldmark/samples/azul"
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// Options configures a conversion.
+type Options struct {
+	// Name is the name of the document. It defaults to "doc".
+	Name string
+	// Dbg adds debug comments to the script.
+	Dbg bool
+	// Meta is the content of a separate .meta file.
+	// Front matter in the source takes precedence.
+	Meta []byte
+	// Style is the style script (mdStyle) written ahead of the document.
+	Style []byte
+	// Site is the site script written after the document.
+	Site []byte
+	// Extensions are the goldmark extensions enabled for parsing.
+	Extensions []goldmark.Extender
+	// RendererOptions are passed to the md2jsV3 renderer.
+	RendererOptions []md2js.Option
+	// Azul is the version of the azul runtime the script targets. It defaults to azul.Latest.
+	Azul string
+	// Dir is the directory relative image paths are resolved against.
+	// ConvertFile uses the directory of the input file.
+	Dir string
+	// Assets enables the pipeline of the local images: copies, sizes and data urls.
+	Assets *AssetOptions
+}
+
+// Severity is the severity of a diagnostic.
+type Severity = md2js.Severity
+
+const (
+	SevInfo    = md2js.SevInfo
+	SevWarning = md2js.SevWarning
+	SevError   = md2js.SevError
+)
+
+// A Diagnostic reports a problem found during a conversion.
+// The diagnostics of the renderer carry the node kind and the column as well.
+type Diagnostic = md2js.Diagnostic
+
+// CountDiagnostics returns the number of errors and warnings.
+func CountDiagnostics(diags []Diagnostic) (errors, warnings int) {
+	for _, d := range diags {
+		switch d.Severity {
+		case SevError:
+			errors++
+		case SevWarning:
+			warnings++
+		}
+	}
+	return errors, warnings
+}
+
+// A TocEntry is a heading of the table of contents.
+type TocEntry struct {
+	Level int    `json:"level"`
+	ID    string `json:"id"`
+	Text  string `json:"text"`
+	Line  int    `json:"line"`
+}
+
+// Stats holds counters of a conversion.
+type Stats struct {
+	SrcBytes int
+	JSBytes  int
+	Nodes    int
+	Headings int
+	Duration time.Duration
+}
+
+// Result is the result of a conversion.
+type Result struct {
+	// JS is the complete script: render start function, style, document and site.
+	JS []byte
+	// Body is the rendered document function body.
+	Body []byte
+	// Meta is nil if the document has no meta data.
+	Meta *Meta
+	// Summary is the text of the '# Summary' section.
+	Summary     []byte
+	Toc         []TocEntry
+	Diagnostics []Diagnostic
+	Stats       Stats
+}
+
+// A Converter converts markdown sources with fixed options.
+// The goldmark parser and the md2jsV3 renderer are built once and shared,
+// so a Converter can be used by several goroutines at the same time.
+type Converter struct {
+	opts Options
+	md   goldmark.Markdown
+	// rc is the renderer config the image pipeline follows
+	rc md2js.Config
+}
+
+// NewConverter returns a Converter for the options.
+// Options.Name and Options.Meta serve as defaults for the converted documents.
+func NewConverter(opts Options) *Converter {
+	if len(opts.Azul) == 0 {opts.Azul = azul.Latest}
+	name := opts.Name
+	if len(name) == 0 {name = "doc"}
+	md := goldmark.New(
+		goldmark.WithExtensions(opts.Extensions...),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+	// extensions ship the js emitters of their node kinds
+	ropts := opts.RendererOptions
+	for _, ext := range opts.Extensions {
+		if ep, ok := ext.(md2js.EmitterProvider); ok {
+			ropts = append(ropts[:len(ropts):len(ropts)], md2js.WithEmitters(ep.Emitters()...))
+		}
+	}
+	md.SetRenderer(md2js.GetRenderer(name, opts.Dbg, ropts...))
+	rc := md2js.NewConfig()
+	for _, o := range opts.RendererOptions {o.SetHTMLOption(&rc)}
+	return &Converter{opts: opts, md: md, rc: rc}
+}
+
+// PageOptions returns the options of a standalone page of the converter:
+// the document name is the title, the runtime is the targeted azul version and,
+// without a site script, RenderCall renders the document.
+func (c *Converter) PageOptions() (po PageOptions, err error) {
+	po.Runtime, err = azul.Runtime(c.opts.Azul)
+	if err != nil {return po, err}
+	po.Title = c.opts.Name
+	po.Render = len(c.opts.Site) == 0
+	return po, nil
+}
+
+// Convert converts a markdown source into a js script.
+// If rendering fails, Convert returns the partial Result together with the error.
+func Convert(ctx context.Context, src []byte, opts Options) (res *Result, err error) {
+	return NewConverter(opts).Convert(ctx, src, opts.Meta)
+}
+
+// Convert converts a markdown source into a js script.
+// metaData is the content of a separate .meta file; nil selects the Meta of the options.
+// If rendering fails, Convert returns the partial Result together with the error.
+func (c *Converter) Convert(ctx context.Context, src, metaData []byte) (res *Result, err error) {
+	return c.convert(ctx, src, metaData, c.opts.Dir)
+}
+
+// convert converts a markdown source whose relative paths are resolved against dir.
+func (c *Converter) convert(ctx context.Context, src, metaData []byte, dir string) (res *Result, err error) {
+
+	start := time.Now()
+	if err := ctx.Err(); err != nil {return nil, err}
+
+	res = &Result{}
+	res.Stats.SrcBytes = len(src)
+
+	parts, err := SplitSource(src)
+	if err != nil {return nil, fmt.Errorf("split: %v", err)}
+	res.Summary = parts.Summary
+
+	if metaData == nil {metaData = c.opts.Meta}
+	if parts.Meta != nil {metaData = parts.Meta}
+	if len(metaData) > 0 {
+		meta, err := ParseMeta(metaData)
+		if err != nil {
+			res.addDiag(SevWarning, 0, fmt.Sprintf("meta: %v", err))
+		} else {
+			res.Meta = meta
+		}
+	}
+
+	doc := c.md.Parser().Parse(text.NewReader(parts.Main))
+	if len(dir) > 0 {md2js.SetSourceDir(doc, dir)}
+	res.walkDoc(doc, parts.Main, parts.MainLine)
+	if c.opts.Assets != nil {res.processImages(doc, parts.Main, parts.MainLine, dir, c.opts.Assets, &c.rc)}
+
+	if err := ctx.Err(); err != nil {return nil, err}
+
+	var buf bytes.Buffer
+	errcon := render(c.md, &buf, parts.Main, doc)
+	res.Body = buf.Bytes()
+	// the renderer counts the lines of the main part
+	for _, d := range md2js.Diagnostics(doc) {
+		if d.Line > 0 {d.Line += parts.MainLine - 1}
+		res.Diagnostics = append(res.Diagnostics, d)
+	}
+
+	var js bytes.Buffer
+	js.WriteString("// requires azul runtime " + c.opts.Azul + "\n")
+	js.Write(md2js.JSRenderStartFunc())
+	js.Write(c.opts.Style)
+	js.Write(res.Body)
+	js.Write(c.opts.Site)
+	res.JS = js.Bytes()
+
+	// the script may only call the functions of the targeted runtime
+	missing, err := azul.Check(c.opts.Azul, res.JS)
+	if err != nil {return nil, err}
+	for _, nam := range missing {
+		res.addDiag(SevError, 0, fmt.Sprintf("azul runtime %s has no member %s", c.opts.Azul, nam))
+	}
+
+	res.Stats.JSBytes = len(res.JS)
+	res.Stats.Duration = time.Since(start)
+
+	if errcon != nil {
+		res.addDiag(SevError, 0, errcon.Error())
+		return res, fmt.Errorf("render: %v", errcon)
+	}
+	return res, nil
+}
+
+// render renders the document and turns a panic of a node renderer into an error.
+func render(md goldmark.Markdown, buf *bytes.Buffer, source []byte, doc ast.Node) (err error) {
+	defer func() {
+		if r := recover(); r != nil {err = fmt.Errorf("renderer panic: %v", r)}
+	}()
+	return md.Renderer().Render(buf, source, doc)
+}
+
+// walkDoc collects the node statistics and the table of contents.
+func (res *Result) walkDoc(doc ast.Node, source []byte, firstLine int) {
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {return ast.WalkContinue, nil}
+		res.Stats.Nodes++
+		hd, ok := node.(*ast.Heading)
+		if !ok {return ast.WalkContinue, nil}
+		res.Stats.Headings++
+		entry := TocEntry{
+			Level: hd.Level,
+			Text:  string(nodeText(hd, source)),
+		}
+		if id, ok := hd.AttributeString("id"); ok {
+			if idb, ok := id.([]byte); ok {entry.ID = string(idb)}
+		}
+		if hd.Lines().Len() > 0 {
+			entry.Line = firstLine + bytes.Count(source[:hd.Lines().At(0).Start], []byte("\n"))
+		}
+		res.Toc = append(res.Toc, entry)
+		return ast.WalkContinue, nil
+	})
+}
+
+func (res *Result) addDiag(sev Severity, line int, msg string) {
+	res.Diagnostics = append(res.Diagnostics, Diagnostic{Severity: sev, Line: line, Msg: msg})
+}
+
+// nodeText returns the text of the inline children of a node.
+func nodeText(node ast.Node, source []byte) []byte {
+	var buf bytes.Buffer
+	for c := node.FirstChild(); c != nil; c = c.NextSibling() {
+		switch n := c.(type) {
+		case *ast.Text:
+			buf.Write(n.Segment.Value(source))
+		case *ast.String:
+			buf.Write(n.Value)
+		default:
+			buf.Write(nodeText(c, source))
+		}
+	}
+	return buf.Bytes()
+}
+
+// ConvertFile reads and converts a markdown file.
+// If opts.Meta is nil, the meta data is read from a .meta file next to the input file, if present.
+// If opts.Name is empty, the base name of the input file is used.
+func ConvertFile(ctx context.Context, inFilnam string, opts Options) (res *Result, err error) {
+	if len(opts.Name) == 0 {
+		opts.Name = filepath.Base(strings.TrimSuffix(inFilnam, filepath.Ext(inFilnam)))
+	}
+	return NewConverter(opts).ConvertFile(ctx, inFilnam)
+}
+
+// ConvertFile reads and converts a markdown file.
+// The meta data is read from a .meta file next to the input file, if present,
+// and defaults to the Meta of the options.
+func (c *Converter) ConvertFile(ctx context.Context, inFilnam string) (res *Result, err error) {
+
+	mdData, err := os.ReadFile(inFilnam)
+	if err != nil {return nil, fmt.Errorf("read input: %v", err)}
+
+	var metaData []byte
+	if c.opts.Meta == nil {
+		metaData, err = os.ReadFile(strings.TrimSuffix(inFilnam, filepath.Ext(inFilnam)) + ".meta")
+		if err != nil {metaData = nil}
+	}
+	return c.convert(ctx, mdData, metaData, filepath.Dir(inFilnam))
+}
+
+// WriteFile writes the js script of the result to a file.
+func (res *Result) WriteFile(outFilnam string) (err error) {
+	err = os.WriteFile(outFilnam, res.JS, 0666)
+	if err != nil {return fmt.Errorf("write output: %v", err)}
+	return nil
+}
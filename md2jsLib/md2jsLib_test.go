@@ -0,0 +1,78 @@
+// md2jsLib_test.go
+// tests of the converter: one shared Converter converts documents from several
+// goroutines with the results of sequential conversions; run with -race
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	attributes "goDemo/goldmark/samples/extBlockAttr"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+func TestConverterConcurrent(t *testing.T) {
+
+	c := NewConverter(Options{
+		Name:       "doc",
+		Extensions: []goldmark.Extender{extension.Table, extension.Footnote, attributes.Extension},
+	})
+	var srcs [][]byte
+	for i := 0; i < 8; i++ {
+		srcs = append(srcs, []byte(fmt.Sprintf("---\ntitle: doc %d\n---\n# Head %d {#h%d .c}\n\n"+
+			"Text *%d* with a note[^1] and [a link](#h%d){.l} and [bad](javascript:x).\n\n"+
+			"| a | b |\n|---|---|\n| %d | x |\n\n![img](i%d.png){width=%d}\n\n[^1]: note %d\n", i, i, i, i, i, i, i, 10+i, i)))
+	}
+
+	ctx := context.Background()
+	want := make([]*Result, len(srcs))
+	for i, src := range srcs {
+		res, err := c.Convert(ctx, src, nil)
+		if err != nil {t.Fatal(err)}
+		want[i] = res
+	}
+
+	const rounds = 4
+	var wg sync.WaitGroup
+	errs := make(chan error, rounds*len(srcs))
+	for r := 0; r < rounds; r++ {
+		for i, src := range srcs {
+			wg.Add(1)
+			go func(i int, src []byte) {
+				defer wg.Done()
+				res, err := c.Convert(ctx, src, nil)
+				switch {
+				case err != nil:
+					errs <- fmt.Errorf("doc %d: %v", i, err)
+				case !bytes.Equal(res.JS, want[i].JS):
+					errs <- fmt.Errorf("doc %d: script differs:\n%s\nwant:\n%s", i, res.JS, want[i].JS)
+				case diagText(res.Diagnostics) != diagText(want[i].Diagnostics):
+					errs <- fmt.Errorf("doc %d: diagnostics:\n%s\nwant:\n%s", i, diagText(res.Diagnostics), diagText(want[i].Diagnostics))
+				case res.Meta == nil || res.Meta.Title != fmt.Sprintf("doc %d", i):
+					errs <- fmt.Errorf("doc %d: meta %v", i, res.Meta)
+				}
+			}(i, src)
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {t.Error(err)}
+}
+
+func diagText(diags []Diagnostic) string {
+	var txt []string
+	for _, d := range diags {txt = append(txt, d.String())}
+	return strings.Join(txt, "\n")
+}
@@ -0,0 +1,121 @@
+// meta.go
+// meta data and section handling for the md2js conversion library
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Meta holds the meta data of a document.
+// The data is read from the yaml front matter of the markdown file
+// or from a separate .meta file.
+type Meta struct {
+	Title       string   `yaml:"title" json:"title,omitempty"`
+	Author      string   `yaml:"author" json:"author,omitempty"`
+	Date        string   `yaml:"date" json:"date,omitempty"`
+	Name        string   `yaml:"name" json:"name,omitempty"`
+	Layout      string   `yaml:"layout" json:"layout,omitempty"`
+	Description string   `yaml:"description" json:"description,omitempty"`
+	Tags        []string `yaml:"tags" json:"tags,omitempty"`
+}
+
+// Parts holds the sections of a markdown source.
+type Parts struct {
+	// Meta is the yaml front matter without the '---' delimiter lines.
+	Meta []byte
+	// Summary is the text of the '# Summary' section without the heading.
+	Summary []byte
+	// Main is the markdown following the front matter.
+	Main []byte
+	// MainLine is the line number of the first line of Main in the source.
+	MainLine int
+}
+
+var metaDelim = []byte("---\n")
+var sumHeading = []byte("# Summary")
+
+// ParseMeta parses yaml meta data.
+func ParseMeta(indata []byte) (meta *Meta, err error) {
+
+	var metaData Meta
+	err = yaml.Unmarshal(indata, &metaData)
+	if err != nil {return nil, fmt.Errorf("unmarshal: %v", err)}
+
+	return &metaData, nil
+}
+
+// PrintMeta prints the meta data to stdout.
+func PrintMeta(meta *Meta) {
+
+	fmt.Println("****** MetaData ******")
+	fmt.Printf("Title:  %s\n", meta.Title)
+	fmt.Printf("Author: %s\n", meta.Author)
+	fmt.Printf("Name:   %s\n", meta.Name)
+	fmt.Printf("Date:   %s\n", meta.Date)
+	fmt.Println("**** end MetaData ****")
+}
+
+// SplitSource splits a markdown source into front matter, summary and main section.
+// Unlike GetMetaSum of the renderer, front matter is only recognised at the
+// start of the source, so thematic breaks ('---') in the body are left alone.
+// The summary section remains part of Main.
+func SplitSource(src []byte) (parts Parts, err error) {
+
+	parts.Main = src
+	parts.MainLine = 1
+
+	if bytes.HasPrefix(src, metaDelim) {
+		rest := src[len(metaDelim):]
+		idx := 0
+		switch {
+		case bytes.HasPrefix(rest, metaDelim):
+			idx = 0
+		default:
+			idx = bytes.Index(rest, []byte("\n---\n"))
+			if idx == -1 {
+				if !bytes.HasSuffix(rest, []byte("\n---")) {return parts, fmt.Errorf("no meta end!")}
+				idx = len(rest) - 4
+			}
+			idx++
+		}
+		parts.Meta = rest[:idx]
+		end := len(metaDelim) + idx + len(metaDelim)
+		if end > len(src) {end = len(src)}
+		parts.Main = src[end:]
+		parts.MainLine = bytes.Count(src[:end], []byte("\n")) + 1
+	}
+
+	sumidx := -1
+	for off := 0; off < len(parts.Main); {
+		line := parts.Main[off:]
+		if nl := bytes.IndexByte(line, '\n'); nl > -1 {line = line[:nl+1]}
+		if bytes.Equal(bytes.TrimSpace(line), sumHeading) {
+			sumidx = off + len(line)
+			break
+		}
+		off += len(line)
+	}
+	if sumidx == -1 {return parts, nil}
+
+	sum := parts.Main[sumidx:]
+	for off := 0; off < len(sum); {
+		line := sum[off:]
+		if nl := bytes.IndexByte(line, '\n'); nl > -1 {line = line[:nl+1]}
+		if line[0] == '#' {
+			sum = sum[:off]
+			break
+		}
+		off += len(line)
+	}
+	parts.Summary = bytes.TrimSpace(sum)
+	return parts, nil
+}
@@ -0,0 +1,74 @@
+// page.go
+// standalone html page: the azul runtime, the render start function, the theme,
+// the document script and the site script are inlined into one html file
+// the title and meta tags are taken from the meta data of the document
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// RenderCall appends the rendered document to the page body.
+// It is needed if the site script does not render the document.
+const RenderCall = "azul.docbody.appendChild(site.render());\n"
+
+// PageOptions configures a standalone html page.
+type PageOptions struct {
+	// Runtime is the azul runtime script, see Converter.PageOptions.
+	Runtime []byte
+	// Title is used if the meta data has no title.
+	Title string
+	// Render adds RenderCall after the scripts.
+	Render bool
+}
+
+// Page returns a self-contained html page of the result.
+func (res *Result) Page(po PageOptions) []byte {
+
+	title := po.Title
+	if res.Meta != nil && len(res.Meta.Title) > 0 {title = res.Meta.Title}
+
+	var buf bytes.Buffer
+	buf.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	buf.WriteString("<meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n")
+	buf.WriteString("<title>" + html.EscapeString(title) + "</title>\n")
+	if m := res.Meta; m != nil {
+		writeMetaTag(&buf, "author", m.Author)
+		writeMetaTag(&buf, "description", m.Description)
+		writeMetaTag(&buf, "keywords", strings.Join(m.Tags, ", "))
+		writeMetaTag(&buf, "date", m.Date)
+	}
+	buf.WriteString("</head>\n<body>\n")
+	writeScript(&buf, po.Runtime)
+	js := res.JS
+	if po.Render {js = append(js[:len(js):len(js)], RenderCall...)}
+	writeScript(&buf, js)
+	buf.WriteString("</body>\n</html>\n")
+	return buf.Bytes()
+}
+
+func writeMetaTag(buf *bytes.Buffer, nam, val string) {
+	if len(val) == 0 {return}
+	buf.WriteString("<meta name=\"" + nam + "\" content=\"" + html.EscapeString(val) + "\">\n")
+}
+
+// scriptEnd matches the sequences that end or change the parsing of an inline script.
+var scriptEnd = regexp.MustCompile(`(?i)<(/script|!--)`)
+
+// writeScript writes an inline script. A closing script tag or a comment start
+// inside the script is escaped, so it cannot end the element.
+func writeScript(buf *bytes.Buffer, js []byte) {
+	buf.WriteString("<script>\n")
+	buf.Write(scriptEnd.ReplaceAll(js, []byte("<\\$1")))
+	if len(js) > 0 && js[len(js)-1] != '\n' {buf.WriteByte('\n')}
+	buf.WriteString("</script>\n")
+}
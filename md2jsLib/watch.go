@@ -0,0 +1,177 @@
+// watch.go
+// watch mode: polls the markdown, meta, theme and site files and the config file of the inputs
+// and reconverts the outputs whose inputs changed
+// a file is only reconverted if the content hash of its inputs differs from the last build,
+// so touching a file or saving it unchanged does not trigger a build
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"context"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// WatchOptions configures a Watcher.
+type WatchOptions struct {
+	// Inputs returns the current markdown files. It is called on every scan,
+	// so new files are picked up.
+	Inputs func() ([]BatchInput, error)
+	// Deps returns the shared files a markdown file depends on, such as the theme and site scripts.
+	// The .meta file next to the markdown file is always watched.
+	Deps func(mdFil string) []string
+	// Config is the project config file. It is a dependency of every input,
+	// so a change of the config reconverts all files.
+	Config string
+	// Reload is called when the content of the config file changed, before the inputs are listed.
+	Reload func() error
+	// OutDir, Workers and Options are passed to Batch by Scan.
+	OutDir  string
+	Workers int
+	Options func(mdFil string) (Options, error)
+	// Interval is the poll interval. It defaults to 500ms.
+	Interval time.Duration
+}
+
+type fileStat struct {
+	modTime time.Time
+	size    int64
+	hash    [sha256.Size]byte
+}
+
+// A Watcher rebuilds the outputs of changed inputs.
+type Watcher struct {
+	wo WatchOptions
+	// files caches the stat and content hash of every watched file
+	files map[string]fileStat
+	// built holds the input hash of each markdown file when it was last reported as changed
+	built map[string][sha256.Size]byte
+	// config is the hash of the config file at the last call of Changed
+	config  [sha256.Size]byte
+	scanned bool
+}
+
+// NewWatcher returns a Watcher. Its first Scan converts all inputs.
+func NewWatcher(wo WatchOptions) *Watcher {
+	if wo.Interval <= 0 {wo.Interval = 500 * time.Millisecond}
+	return &Watcher{
+		wo:    wo,
+		files: make(map[string]fileStat),
+		built: make(map[string][sha256.Size]byte),
+	}
+}
+
+// Changed returns the inputs whose input hash differs from the last call
+// and records their new hashes. The first call returns all inputs.
+// If the config file changed, Reload is called first; its error is returned.
+func (w *Watcher) Changed() (changed []BatchInput, err error) {
+
+	if len(w.wo.Config) > 0 {
+		w.update(w.wo.Config)
+		fh := w.files[w.wo.Config].hash
+		old := w.config
+		// a config that fails to load is reported once; the last config stays in use
+		w.config = fh
+		if w.scanned && fh != old && w.wo.Reload != nil {
+			if err := w.wo.Reload(); err != nil {return nil, err}
+		}
+	}
+	w.scanned = true
+
+	inputs, err := w.wo.Inputs()
+	if err != nil {return nil, err}
+
+	// a shared file is hashed once per call
+	seen := make(map[string]bool)
+	current := make(map[string]bool, len(inputs))
+
+	for _, in := range inputs {
+		current[in.Path] = true
+		deps := []string{in.Path, strings.TrimSuffix(in.Path, filepath.Ext(in.Path)) + ".meta"}
+		if w.wo.Deps != nil {deps = append(deps, w.wo.Deps(in.Path)...)}
+		if len(w.wo.Config) > 0 {deps = append(deps, w.wo.Config)}
+
+		h := sha256.New()
+		for _, dep := range deps {
+			if !seen[dep] {
+				w.update(dep)
+				seen[dep] = true
+			}
+			fh := w.files[dep].hash
+			h.Write([]byte(dep))
+			h.Write(fh[:])
+		}
+		var sum [sha256.Size]byte
+		copy(sum[:], h.Sum(nil))
+		if old, ok := w.built[in.Path]; ok && old == sum {continue}
+		w.built[in.Path] = sum
+		changed = append(changed, in)
+	}
+
+	// forget removed inputs, so they count as changed if they come back
+	for p := range w.built {
+		if !current[p] {delete(w.built, p)}
+	}
+	return changed, nil
+}
+
+// Scan checks the inputs once and converts the files whose input hash changed.
+// A failed file is not converted again until one of its inputs changes.
+// The returned manifest lists the converted files; it is empty if nothing changed.
+func (w *Watcher) Scan(ctx context.Context) (*Manifest, error) {
+
+	changed, err := w.Changed()
+	if err != nil {return nil, err}
+	if len(changed) == 0 {return &Manifest{Generated: time.Now().UTC()}, nil}
+
+	return Batch(ctx, BatchOptions{
+		Inputs:  changed,
+		OutDir:  w.wo.OutDir,
+		Workers: w.wo.Workers,
+		Options: w.wo.Options,
+	})
+}
+
+// update refreshes the cached hash of a file if its size or modification time changed.
+// A missing file has a zero hash.
+func (w *Watcher) update(fil string) {
+	info, err := os.Stat(fil)
+	if err != nil {
+		delete(w.files, fil)
+		return
+	}
+	old, ok := w.files[fil]
+	if ok && old.size == info.Size() && old.modTime.Equal(info.ModTime()) {return}
+
+	fs := fileStat{modTime: info.ModTime(), size: info.Size()}
+	data, err := os.ReadFile(fil)
+	if err == nil {fs.hash = sha256.Sum256(data)}
+	w.files[fil] = fs
+}
+
+// Run scans the inputs every interval until the context is cancelled.
+// report is called with the manifest of every scan that converted files
+// and with the error of a failed scan; the manifest is nil if the inputs could not be listed.
+func (w *Watcher) Run(ctx context.Context, report func(*Manifest, error)) error {
+
+	tick := time.NewTicker(w.wo.Interval)
+	defer tick.Stop()
+	for {
+		man, err := w.Scan(ctx)
+		if ctx.Err() != nil {return ctx.Err()}
+		if err != nil || len(man.Files) > 0 {report(man, err)}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-tick.C:
+		}
+	}
+}
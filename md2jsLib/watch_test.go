@@ -0,0 +1,104 @@
+// watch_test.go
+// tests of the watcher: only the files whose markdown, meta, theme or config file changed
+// are reconverted; touching a file does not trigger a build
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsLib
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatcher(t *testing.T) {
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "script")
+	cfgFil := filepath.Join(dir, ConfigFile)
+	// the modification time moves on with every write, so the stat cache sees each write
+	mod := time.Now().Add(-time.Hour)
+	write := func(nam, src string) {
+		t.Helper()
+		fil := filepath.Join(dir, filepath.FromSlash(nam))
+		if err := os.MkdirAll(filepath.Dir(fil), 0755); err != nil {t.Fatal(err)}
+		if err := os.WriteFile(fil, []byte(src), 0666); err != nil {t.Fatal(err)}
+		mod = mod.Add(time.Second)
+		if err := os.Chtimes(fil, mod, mod); err != nil {t.Fatal(err)}
+	}
+	write(ConfigFile, "theme: style.js\n")
+	write("style.js", "// theme one\n")
+	write("md/a.md", "# A\n\nsee https://example.com\n")
+	write("md/b.md", "# B\n")
+
+	cfg, err := LoadConfig(cfgFil)
+	if err != nil {t.Fatal(err)}
+	reloads := 0
+	w := NewWatcher(WatchOptions{
+		Inputs:  func() ([]BatchInput, error) {return TreeInputs(filepath.Join(dir, "md"))},
+		Deps:    func(fil string) []string {return []string{cfg.Path(cfg.For(fil).Theme)}},
+		OutDir:  out,
+		Workers: 2,
+		Options: func(fil string) (Options, error) {return cfg.Options(fil)},
+		Config:  cfgFil,
+		Reload: func() error {
+			reloads++
+			c, err := LoadConfig(cfgFil)
+			if err != nil {return err}
+			cfg = c
+			return nil
+		},
+	})
+
+	scan := func(step string, want ...string) {
+		t.Helper()
+		man, err := w.Scan(context.Background())
+		if err != nil {t.Fatalf("%s: %v", step, err)}
+		var got []string
+		for _, ent := range man.Files {
+			if len(ent.Error) > 0 {t.Errorf("%s: %s: %s", step, ent.Source, ent.Error)}
+			got = append(got, filepath.Base(ent.Source))
+		}
+		if strings.Join(got, " ") != strings.Join(want, " ") {t.Errorf("%s: converted %v, want %v", step, got, want)}
+	}
+	script := func(nam string) string {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join(out, nam+".js"))
+		if err != nil {t.Fatal(err)}
+		return string(data)
+	}
+
+	scan("first scan", "a.md", "b.md")
+	scan("no change")
+	write("md/a.md", "# A\n\nsee https://example.com\n")
+	scan("same content")
+	write("md/a.md", "# A\n\nsee https://example.org\n")
+	scan("markdown changed", "a.md")
+	write("md/b.meta", "title: bee\n")
+	scan("meta added", "b.md")
+
+	write("style.js", "// theme two\n")
+	scan("theme changed", "a.md", "b.md")
+	if !strings.Contains(script("b"), "// theme two") {t.Errorf("theme not rebuilt:\n%s", script("b"))}
+
+	before := script("a")
+	write(ConfigFile, "theme: style.js\nextensions:\n  linkify: true\n")
+	scan("config changed", "a.md", "b.md")
+	if reloads != 1 {t.Errorf("%d reloads, want 1", reloads)}
+	if a := script("a"); a == before || !strings.Contains(a, ".href='https://example.org'") {t.Errorf("config not applied:\n%s", a)}
+	write(ConfigFile, "theme: style.js\nextensions:\n  linkify: true\n")
+	scan("config unchanged")
+
+	// a config that fails to load is reported once and the last config stays in use
+	write(ConfigFile, "theme: [\n")
+	if _, err := w.Scan(context.Background()); err == nil {t.Errorf("no error for a broken config")}
+	scan("broken config", "a.md", "b.md")
+	if reloads != 2 {t.Errorf("%d reloads, want 2", reloads)}
+}
@@ -0,0 +1,492 @@
+package md2jsV2
+
+// dom attribute mapping: an html attribute of a node is set on the dom element either
+// as a property (className, htmlFor, tabIndex) or with setAttribute (data-*, aria-*,
+// unknown and hyphenated names); class lists are merged, a style attribute becomes
+// style assignments and boolean, number and list values are converted; the presentational
+// attributes width, height, border, float and align are validated per element and mostly
+// become styles
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+var (
+	dataPrefix = []byte("data-")
+	ariaPrefix = []byte("aria-")
+)
+
+// attrKind is the kind of value of an html attribute.
+type attrKind int
+
+const (
+	attrText attrKind = iota
+	// attrBool is a boolean attribute: present if true, omitted if false
+	attrBool
+	// attrNumber is reflected by a number property
+	attrNumber
+	// attrTrueFalse is an enumerated attribute with the values true and false
+	attrTrueFalse
+	// attrYesNo is an enumerated attribute with the values yes and no
+	attrYesNo
+)
+
+// A domAttr describes how an html attribute is set on an element.
+type domAttr struct {
+	// prop is the reflecting property; empty to use setAttribute
+	prop string
+	kind attrKind
+}
+
+// domAttrs are the attributes that need a property name or a value conversion.
+// Other attributes are set with setAttribute and their text.
+var domAttrs = map[string]domAttr{
+	"accesskey":       {"accessKey", attrText},
+	"alt":             {"alt", attrText},
+	"autofocus":       {"", attrBool},
+	"colspan":         {"colSpan", attrNumber},
+	"contenteditable": {"", attrTrueFalse},
+	"decoding":        {"decoding", attrText},
+	"dir":             {"dir", attrText},
+	"draggable":       {"", attrTrueFalse},
+	"for":             {"htmlFor", attrText},
+	"hidden":          {"hidden", attrBool},
+	"href":            {"href", attrText},
+	"id":              {"id", attrText},
+	"inert":           {"", attrBool},
+	"ismap":           {"", attrBool},
+	"itemscope":       {"", attrBool},
+	"lang":            {"lang", attrText},
+	"loading":         {"loading", attrText},
+	"reversed":        {"reversed", attrBool},
+	"rowspan":         {"rowSpan", attrNumber},
+	"sizes":           {"sizes", attrText},
+	"spellcheck":      {"", attrTrueFalse},
+	"src":             {"src", attrText},
+	"srcset":          {"srcset", attrText},
+	"start":           {"start", attrNumber},
+	"tabindex":        {"tabIndex", attrNumber},
+	"title":           {"title", attrText},
+	"translate":       {"", attrYesNo},
+}
+
+// attrValues are the values of enumerated attributes.
+var attrValues = map[string][]string{
+	"decoding": {"sync", "async", "auto"},
+	"loading":  {"lazy", "eager"},
+}
+
+// RenderElAttributes renders the attributes of a node as js statements on the element elNam.
+// You can specify attribute names to render by the filter; data-* and aria-* attributes
+// always pass. If filter is nil, RenderElAttributes renders all attributes.
+// Dropped attributes are reported as diagnostics.
+func RenderElAttributes(w util.BufWriter, node ast.Node, filter util.BytesFilter, elNam string) {
+	RenderElAttributesClass(w, node, filter, elNam)
+}
+
+// RenderElAttributesClass renders the attributes of a node like RenderElAttributes and
+// merges the classes of the renderer with the class attribute of the node.
+func RenderElAttributesClass(w util.BufWriter, node ast.Node, filter util.BytesFilter, elNam string, classes ...string) {
+	for _, attr := range node.Attributes() {
+		if isRenderAttr(attr.Name) {continue}
+		nam := bytes.ToLower(attr.Name)
+		if filter != nil && !filter.Contains(nam) &&
+			!bytes.HasPrefix(nam, dataPrefix) && !bytes.HasPrefix(nam, ariaPrefix) {
+			addDiag(node, SevWarning, "attribute dropped: %s", attr.Name)
+			continue
+		}
+		switch string(nam) {
+		case "class":
+			list, ok := attrList(attr.Value)
+			if !ok {
+				addDiag(node, SevWarning, "attribute dropped: class has a %T value", attr.Value)
+				continue
+			}
+			classes = mergeClasses(classes, list...)
+		case "style":
+			writeStyle(w, node, elNam, attr.Value)
+		case "width", "height", "border", "float", "align":
+			writePresAttr(w, node, elNam, string(nam), attr.Value)
+		case "href", "src", "srcset", "ping":
+			writeURLAttr(w, node, elNam, string(nam), attr.Value)
+		// external is read by the link renderers
+		case "external":
+		default:
+			writeAttr(w, node, elNam, string(nam), attr.Value)
+		}
+	}
+	if len(classes) > 0 {
+		_, _ = w.WriteString(elNam + ".className=" + jsQuote([]byte(strings.Join(classes, " "))) + ";\n")
+	}
+}
+
+// writeURLAttr writes an attribute with urls: the urls the url policy removes are dropped
+// from the candidates of a srcset and from the list of a ping.
+func writeURLAttr(w util.BufWriter, node ast.Node, elNam, nam string, value interface{}) {
+	text, ok := attrString(value)
+	if !ok {
+		addDiag(node, SevWarning, "attribute dropped: %s has a %T value", nam, value)
+		return
+	}
+	var kept []string
+	switch nam {
+	case "srcset":
+		for _, c := range srcsetCandidates(text) {
+			if keepAttrURL(node, []byte(c[0])) {kept = append(kept, strings.TrimSpace(c[0]+" "+c[1]))}
+		}
+		text = strings.Join(kept, ", ")
+	case "ping":
+		for _, u := range strings.Fields(text) {
+			if keepAttrURL(node, []byte(u)) {kept = append(kept, u)}
+		}
+		text = strings.Join(kept, " ")
+	default:
+		if !keepAttrURL(node, []byte(text)) {return}
+	}
+	if len(text) == 0 {return}
+	writeAttr(w, node, elNam, nam, text)
+}
+
+// srcsetCandidates splits a srcset into its candidates of url and descriptors: the url
+// extends to the next white space, a trailing comma ends the candidate.
+func srcsetCandidates(text string) [][2]string {
+	var list [][2]string
+	i := 0
+	for i < len(text) {
+		for i < len(text) && (isSpace(text[i]) || text[i] == ',') {i++}
+		if i == len(text) {break}
+		start := i
+		for i < len(text) && !isSpace(text[i]) {i++}
+		u := text[start:i]
+		if strings.HasSuffix(u, ",") {
+			list = append(list, [2]string{strings.TrimRight(u, ","), ""})
+			continue
+		}
+		start = i
+		depth := 0
+		for ; i < len(text) && (text[i] != ',' || depth > 0); i++ {
+			if text[i] == '(' {depth++} else if text[i] == ')' && depth > 0 {depth--}
+		}
+		list = append(list, [2]string{u, strings.TrimSpace(text[start:i])})
+	}
+	return list
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// mergeClasses appends the classes that are not in the list yet.
+func mergeClasses(list []string, classes ...string) []string {
+	for _, c := range classes {
+		if len(c) == 0 {continue}
+		dup := false
+		for _, l := range list {
+			if l == c {dup = true; break}
+		}
+		if !dup {list = append(list, c)}
+	}
+	return list
+}
+
+// writeAttr writes the statement that sets an attribute.
+func writeAttr(w util.BufWriter, node ast.Node, elNam, nam string, value interface{}) {
+	da := domAttrs[nam]
+	b, isBool := attrBoolValue(value)
+	switch {
+	// a boolean attribute is set by any value but false, as hidden="" in html
+	case da.kind == attrBool:
+		if isBool && !b {return}
+		if len(da.prop) > 0 {
+			_, _ = w.WriteString(elNam + "." + da.prop + "=true;\n")
+			return
+		}
+		value = ""
+	case da.kind == attrYesNo && isBool:
+		value = "no"
+		if b {value = "yes"}
+	}
+	text, ok := attrString(value)
+	if !ok {
+		addDiag(node, SevWarning, "attribute dropped: %s has a %T value", nam, value)
+		return
+	}
+	if values, ok := attrValues[nam]; ok && !hasValue(values, text) {
+		addDiag(node, SevWarning, "attribute %s: %q is not one of %s", nam, text, strings.Join(values, ", "))
+		return
+	}
+	if da.kind == attrNumber && len(da.prop) > 0 {
+		if num, err := strconv.ParseFloat(text, 64); err == nil {
+			_, _ = w.WriteString(elNam + "." + da.prop + "=" + strconv.FormatFloat(num, 'f', -1, 64) + ";\n")
+			return
+		}
+		addDiag(node, SevWarning, "attribute %s: %q is not a number", nam, text)
+	}
+	if len(da.prop) > 0 && da.kind != attrNumber {
+		_, _ = w.WriteString(elNam + "." + da.prop + "=" + jsQuote([]byte(text)) + ";\n")
+		return
+	}
+	_, _ = w.WriteString(elNam + ".setAttribute(" + jsQuote([]byte(nam)) + ", " + jsQuote([]byte(text)) + ");\n")
+}
+
+// A TypedAttrValue is an attribute value with a type and its source text, such as the
+// numbers, lengths and booleans of the attributes extension. The text is written, so 1.10
+// stays 1.10; TypedValue decides whether a boolean attribute is set.
+type TypedAttrValue interface {
+	String() string
+	TypedValue() interface{}
+}
+
+// attrBoolValue returns the value of a bool or of a typed bool; a quoted "true" is text.
+func attrBoolValue(value interface{}) (bool, bool) {
+	if tv, ok := value.(TypedAttrValue); ok {value = tv.TypedValue()}
+	b, ok := value.(bool)
+	return b, ok
+}
+
+func hasValue(values []string, text string) bool {
+	for _, v := range values {
+		if v == text {return true}
+	}
+	return false
+}
+
+// attrString returns the text of an attribute value: lists are joined with spaces,
+// numbers are written without exponent.
+func attrString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", true
+	case []byte:
+		return string(v), true
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), true
+	case TypedAttrValue:
+		return v.String(), true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+	list, ok := attrList(value)
+	return strings.Join(list, " "), ok
+}
+
+// attrList returns the items of a list value; a text value is split at white space.
+func attrList(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case []interface{}:
+		var list []string
+		for _, item := range v {
+			text, ok := attrString(item)
+			if !ok {return nil, false}
+			list = append(list, strings.Fields(text)...)
+		}
+		return list, true
+	case []string:
+		var list []string
+		for _, item := range v {list = append(list, strings.Fields(item)...)}
+		return list, true
+	case [][]byte:
+		var list []string
+		for _, item := range v {list = append(list, strings.Fields(string(item))...)}
+		return list, true
+	case map[string]interface{}:
+		return nil, false
+	}
+	text, ok := attrString(value)
+	if !ok {return nil, false}
+	return strings.Fields(text), true
+}
+
+// writeStyle writes the declarations of a style attribute as style assignments.
+// The value is css text ("color: red; font-size: 2em") or an object of the attribute parser.
+func writeStyle(w util.BufWriter, node ast.Node, elNam string, value interface{}) {
+	var decls [][2]string
+	if m, ok := value.(map[string]interface{}); ok {
+		keys := make([]string, 0, len(m))
+		for k := range m {keys = append(keys, k)}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v, ok := attrString(m[k])
+			if !ok {
+				addDiag(node, SevWarning, "style %s dropped: %T value", k, m[k])
+				continue
+			}
+			decls = append(decls, [2]string{k, v})
+		}
+	} else {
+		text, ok := attrString(value)
+		if !ok {
+			addDiag(node, SevWarning, "attribute dropped: style has a %T value", value)
+			return
+		}
+		for _, decl := range splitStyle(text) {
+			decl = strings.TrimSpace(decl)
+			if len(decl) == 0 {continue}
+			i := strings.IndexByte(decl, ':')
+			if i <= 0 {
+				addDiag(node, SevWarning, "style declaration dropped: %q", decl)
+				continue
+			}
+			decls = append(decls, [2]string{decl[:i], decl[i+1:]})
+		}
+	}
+	for _, d := range decls {
+		prop, val := strings.TrimSpace(d[0]), strings.TrimSpace(d[1])
+		if !strings.HasPrefix(prop, "--") {prop = strings.ToLower(prop)}
+		important := false
+		if strings.HasSuffix(strings.ToLower(val), "!important") {
+			important = true
+			val = strings.TrimSpace(val[:len(val)-len("!important")])
+		}
+		camel := cssCamel(prop)
+		if important || strings.HasPrefix(prop, "--") || !isJSIdent([]byte(camel)) {
+			stmt := elNam + ".style.setProperty(" + jsQuote([]byte(prop)) + ", " + jsQuote([]byte(val))
+			if important {stmt += ", 'important'"}
+			_, _ = w.WriteString(stmt + ");\n")
+			continue
+		}
+		_, _ = w.WriteString(elNam + ".style." + camel + "=" + jsQuote([]byte(val)) + ";\n")
+	}
+}
+
+// splitStyle splits css declarations at the semicolons outside of quotes and parentheses.
+func splitStyle(text string) []string {
+	var decls []string
+	depth, quote, start := 0, byte(0), 0
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {i++} else if c == quote {quote = 0}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case c == ';' && depth == 0:
+			decls = append(decls, text[start:i])
+			start = i + 1
+		}
+	}
+	return append(decls, text[start:])
+}
+
+// cssCamel converts a css property name into the name of the style property: font-size is fontSize.
+func cssCamel(prop string) string {
+	var sb strings.Builder
+	up := false
+	for i := 0; i < len(prop); i++ {
+		c := prop[i]
+		if c == '-' {
+			up = sb.Len() > 0
+			continue
+		}
+		if up && c >= 'a' && c <= 'z' {c -= 'a' - 'A'}
+		up = false
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+// cssUnits are the css length units accepted by the presentational attributes.
+var cssUnits = map[string]bool{
+	"px": true, "%": true, "em": true, "rem": true, "ex": true, "ch": true,
+	"vw": true, "vh": true, "vmin": true, "vmax": true,
+	"cm": true, "mm": true, "in": true, "pt": true, "pc": true,
+}
+
+// parseLength parses a length: a number with an optional css unit.
+func parseLength(text string) (float64, string, bool) {
+	text = strings.TrimSpace(text)
+	i := len(text)
+	for i > 0 && (text[i-1] == '%' || (text[i-1] >= 'a' && text[i-1] <= 'z') || (text[i-1] >= 'A' && text[i-1] <= 'Z')) {i--}
+	num, err := strconv.ParseFloat(text[:i], 64)
+	if err != nil {return 0, "", false}
+	return num, strings.ToLower(text[i:]), true
+}
+
+// writePresAttr validates a presentational attribute and writes it as a property, an attribute
+// or a style of the element. width, height and border are lengths (a number is px), float
+// and align are keywords: align floats an image or a table, aligns an image vertically and
+// the text of other elements.
+func writePresAttr(w util.BufWriter, node ast.Node, elNam, nam string, value interface{}) {
+	text, ok := attrString(value)
+	if !ok {
+		addDiag(node, SevWarning, "attribute dropped: %s has a %T value", nam, value)
+		return
+	}
+	text = strings.ToLower(strings.TrimSpace(text))
+	img := node.Kind() == ast.KindImage
+	table := node.Kind() == east.KindTable
+
+	switch nam {
+	case "width", "height", "border":
+		num, unit, ok := parseLength(text)
+		switch {
+		case !ok:
+			addDiag(node, SevWarning, "attribute %s: %q is not a length", nam, text)
+			return
+		case num < 0:
+			addDiag(node, SevWarning, "attribute %s: %q is negative", nam, text)
+			return
+		case unit != "" && !cssUnits[unit]:
+			addDiag(node, SevWarning, "attribute %s: unknown unit %q", nam, unit)
+			return
+		}
+		if unit == "" {unit = "px"}
+		css := strconv.FormatFloat(num, 'f', -1, 64) + unit
+		switch {
+		// the pixel size of an image is its width and height
+		case img && nam != "border" && unit == "px":
+			_, _ = w.WriteString(elNam + "." + nam + "=" + strconv.FormatFloat(num, 'f', -1, 64) + ";\n")
+		// the border of a table also draws the cell borders
+		case table && nam == "border" && unit == "px":
+			_, _ = w.WriteString(elNam + ".setAttribute('border', " + jsQuote([]byte(strconv.FormatFloat(num, 'f', -1, 64))) + ");\n")
+		case nam == "border" && num == 0:
+			writeStyle(w, node, elNam, "border-style: none")
+		case nam == "border":
+			writeStyle(w, node, elNam, "border: "+css+" solid")
+		default:
+			writeStyle(w, node, elNam, nam+": "+css)
+		}
+	case "float":
+		switch text {
+		case "left", "right", "none", "inline-start", "inline-end":
+			writeStyle(w, node, elNam, "float: "+text)
+		default:
+			addDiag(node, SevWarning, "attribute float: %q is not left, right or none", text)
+		}
+	case "align":
+		switch {
+		case (img || table) && (text == "left" || text == "right"):
+			writeStyle(w, node, elNam, "float: "+text)
+		case img && (text == "top" || text == "middle" || text == "bottom" || text == "baseline"):
+			writeStyle(w, node, elNam, "vertical-align: "+text)
+		case table && text == "center":
+			writeStyle(w, node, elNam, "margin-left: auto; margin-right: auto")
+		case !img && !table && (text == "left" || text == "right" || text == "center" || text == "justify"):
+			writeStyle(w, node, elNam, "text-align: "+text)
+		default:
+			addDiag(node, SevWarning, "attribute align: %q is not an alignment of the element", text)
+		}
+	}
+}
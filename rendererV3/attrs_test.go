@@ -0,0 +1,145 @@
+// attrs_test.go
+// tests of the dom attribute mapping: heading attributes of the goldmark attribute
+// syntax are rendered, run in the fake dom and compared as html
+// the placement rules and typed values of the attributes extension
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsV2_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	attributes "goDemo/goldmark/samples/extBlockAttr"
+	"goDemo/goldmark/samples/jsdom"
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestAttributes(t *testing.T) {
+
+	tests := []struct {
+		src   string
+		want  string
+		diags []string
+	}{
+		{"# h {#top .a .b class=\"b c\"}\n", `<h1 class="a b c" id="top">h</h1>`, nil},
+		{"# h {data-line=3 aria-label=\"x y\" role=\"note\"}\n", `<h1 aria-label="x y" data-line="3" role="note">h</h1>`, nil},
+		{"# h {hidden=true inert=false tabindex=2}\n", `<h1 hidden="" tabindex="2">h</h1>`, nil},
+		{"# h {hidden=\"\"}\n", `<h1 hidden="">h</h1>`, nil},
+		{"# h {hidden=hidden inert=\"false\"}\n", `<h1 hidden="" inert="">h</h1>`, nil},
+		{"# h {spellcheck=false translate=true}\n", `<h1 spellcheck="false" translate="yes">h</h1>`, nil},
+		{"# h {style=\"color: red; font-size: 2em; --gap: 1px; background: url('a;b')\"}\n",
+			`<h1 style="--gap: 1px; background: url(&#39;a;b&#39;); color: red; font-size: 2em">h</h1>`, nil},
+		{"# h {style=\"color red\" onclick=\"x()\"}\n", `<h1>h</h1>`, []string{
+			`warning: line 1:3: Heading: style declaration dropped: "color red"`,
+			"warning: line 1:3: Heading: attribute dropped: onclick",
+		}},
+		{"# h {data-list=[\"a\", 2] tabindex=\"x\"}\n", `<h1 data-list="a 2" tabindex="x">h</h1>`, []string{
+			`warning: line 1:3: Heading: attribute tabindex: "x" is not a number`,
+		}},
+		{"```go\n```\n", `<pre><code class="language-go"></code></pre>`, nil},
+	}
+
+	md := goldmark.New(goldmark.WithParserOptions(parser.WithAttribute()))
+	md.SetRenderer(md2js.GetRenderer("attrs", false))
+
+	for _, test := range tests {
+		source := []byte(test.src)
+		doc := md.Parser().Parse(text.NewReader(source))
+		got, diags, js, err := renderDOM(t, md, source, doc, jsdom.NormOptions{KeepStyle: true})
+		if err != nil {
+			t.Errorf("%q: %v\n%s", test.src, err, js)
+			continue
+		}
+		if got != test.want {t.Errorf("%q:\n got: %s\nwant: %s\n%s", test.src, got, test.want, js)}
+		if strings.Join(diags, "\n") != strings.Join(test.diags, "\n") {
+			t.Errorf("%q: diagnostics:\n%s\nwant:\n%s", test.src, strings.Join(diags, "\n"), strings.Join(test.diags, "\n"))
+		}
+	}
+}
+
+func TestAttributeExtension(t *testing.T) {
+
+	tests := []struct {
+		src   string
+		want  string
+		diags []string
+	}{
+		{"# head *x* {#h .k}\n", `<h1 class="k" id="h">head <em>x</em></h1>`, nil},
+		{"para\n{.p data-x='1'}\n", `<p class="p" data-x="1">para</p>`, nil},
+		{"- item {.li}\n- two\n{.list}\n", `<ul class="list"><li class="li">item</li><li>two</li></ul>`, nil},
+		{"- tight\n  {.inner}\n", `<ul><li class="inner">tight</li></ul>`, nil},
+		{"1. a\n{reversed=\"\"}\n", `<ol reversed=""><li>a</li></ol>`, nil},
+		{"1. a\n{reversed=false hidden=true}\n", `<ol hidden=""><li>a</li></ol>`, nil},
+		{"| a |\n|---|\n| 1 |\n{.t}\n", `<table class="t"><thead><tr><th>a</th></tr></thead><tbody><tr><td>1</td></tr></tbody></table>`, nil},
+		{"```go {.num}\n```\n", `<pre class="num"><code class="language-go"></code></pre>`, nil},
+		{"[l](u){.d} *e*{#f} `c`{.cc}\n", `<p><a class="d" href="u">l</a> <em id="f">e</em> <code class="cc">c</code></p>`, nil},
+		{"a [b *c*]{.s} [x [y]{.in} z]{.out}\n", `<p>a <span class="s">b <em>c</em></span> <span class="out">x <span class="in">y</span> z</span></p>`, nil},
+		{"not {.x} [no] {.y}\n\n{.z}\n", `<p>not {.x} [no] {.y}</p><p>{.z}</p>`, nil},
+		{"![a](i.png){width=100 height=50% border=2 align=middle}\n",
+			`<p><img alt="a" src="i.png" style="border: 2px solid; height: 50%; vertical-align: middle" width="100"></p>`, nil},
+		{"![a](i.png){width=12rem float=left border=0 data-n=2.50 data-e=1e3}\n",
+			`<p><img alt="a" data-e="1e3" data-n="2.50" src="i.png" style="border-style: none; float: left; width: 12rem"></p>`, nil},
+		{"para\n{align=center width=20}\n", `<p>para</p>`, []string{
+			"warning: line 1:1: Paragraph: attribute dropped: align",
+			"warning: line 1:1: Paragraph: attribute dropped: width",
+		}},
+		{"| a |\n|---|\n| 1 |\n{border=1 align=center}\n",
+			`<table border="1" style="margin-left: auto; margin-right: auto"><thead><tr><th>a</th></tr></thead><tbody><tr><td>1</td></tr></tbody></table>`, nil},
+		{"![a](i.png){width=-3 height=2parsec border=thick align=center float=top}\n", `<p><img alt="a" src="i.png"></p>`, []string{
+			`warning: line 1:3: Image: attribute width: "-3" is negative`,
+			`warning: line 1:3: Image: attribute height: unknown unit "parsec"`,
+			`warning: line 1:3: Image: attribute border: "thick" is not a length`,
+			`warning: line 1:3: Image: attribute align: "center" is not an alignment of the element`,
+			`warning: line 1:3: Image: attribute float: "top" is not left, right or none`,
+		}},
+	}
+
+	ext := attributes.Extension.(md2js.EmitterProvider)
+	md := goldmark.New(goldmark.WithExtensions(attributes.Extension, extension.Table))
+	md.SetRenderer(md2js.GetRenderer("attrs", false, md2js.WithEmitters(ext.Emitters()...)))
+
+	for _, test := range tests {
+		source := []byte(test.src)
+		doc := md.Parser().Parse(text.NewReader(source))
+		got, diags, js, err := renderDOM(t, md, source, doc, jsdom.NormOptions{KeepStyle: true})
+		if err != nil {
+			t.Errorf("%q: %v\n%s", test.src, err, js)
+			continue
+		}
+		if got != test.want {t.Errorf("%q:\n got: %s\nwant: %s\n%s", test.src, got, test.want, js)}
+		if strings.Join(diags, "\n") != strings.Join(test.diags, "\n") {
+			t.Errorf("%q: diagnostics:\n%s\nwant:\n%s", test.src, strings.Join(diags, "\n"), strings.Join(test.diags, "\n"))
+		}
+	}
+}
+
+// the goldmark html renderer writes the text of the attribute values
+func TestAttributeExtensionHTML(t *testing.T) {
+
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"# h {tabindex=2 data-ver=1.10 data-zip=01234}\n", `<h1 id="h" tabindex="2" data-ver="1.10" data-zip="01234">h</h1>`},
+		{"![a](i.png){width=100 height=50% data-n=-2.50}\n", `<p><img src="i.png" alt="a" width="100" height="50%" data-n="-2.50"></p>`},
+		{"para\n{hidden=true data-e=1e3}\n", `<p hidden="true" data-e="1e3">para</p>`},
+	}
+
+	md := goldmark.New(goldmark.WithExtensions(attributes.Extension), goldmark.WithParserOptions(parser.WithAutoHeadingID()))
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := md.Convert([]byte(test.src), &buf); err != nil {t.Fatal(err)}
+		if got := strings.TrimSpace(buf.String()); got != test.want {t.Errorf("%q:\n got: %s\nwant: %s", test.src, got, test.want)}
+	}
+}
@@ -0,0 +1,161 @@
+package md2jsV2
+
+// diagnostics of a render: nodes the renderer does not support, dropped attributes,
+// removed urls and broken element chains are collected with their source position
+// in the render context of the document instead of stopping the render
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+)
+
+// Severity is the severity of a diagnostic.
+type Severity int
+
+const (
+	SevInfo Severity = iota
+	SevWarning
+	SevError
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SevInfo:
+		return "info"
+	case SevWarning:
+		return "warning"
+	case SevError:
+		return "error"
+	}
+	return fmt.Sprintf("severity(%d)", int(s))
+}
+
+// A Diagnostic reports a problem found while rendering a document.
+type Diagnostic struct {
+	Severity Severity
+	// Kind is the kind of the ast node, empty if the diagnostic is not tied to a node.
+	Kind string
+	// Line and Col are the 1-based source position (Col in bytes); 0 if unknown.
+	Line int
+	Col  int
+	Msg  string
+}
+
+func (d Diagnostic) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(d.Severity.String())
+	switch {
+	case d.Line > 0 && d.Col > 0:
+		fmt.Fprintf(&buf, ": line %d:%d", d.Line, d.Col)
+	case d.Line > 0:
+		fmt.Fprintf(&buf, ": line %d", d.Line)
+	}
+	if len(d.Kind) > 0 {fmt.Fprintf(&buf, ": %s", d.Kind)}
+	fmt.Fprintf(&buf, ": %s", d.Msg)
+	return buf.String()
+}
+
+// Diagnostics returns the diagnostics of the last render of a document.
+func Diagnostics(doc ast.Node) []Diagnostic {
+	v, ok := doc.Attribute(ctxAttr)
+	if !ok {return nil}
+	rc, ok := v.(*renderContext)
+	if !ok {return nil}
+	return rc.diags
+}
+
+// addDiag records a diagnostic of a node in the render context of its document.
+// It is a function rather than a method, as the attribute writers have no renderer.
+func addDiag(node ast.Node, sev Severity, format string, args ...interface{}) {
+	root := node
+	for root.Parent() != nil {root = root.Parent()}
+	v, _ := root.Attribute(ctxAttr)
+	rc, ok := v.(*renderContext)
+	if !ok {
+		rc = &renderContext{count: 1}
+		root.SetAttribute(ctxAttr, rc)
+	}
+	d := Diagnostic{Severity: sev, Kind: node.Kind().String(), Msg: fmt.Sprintf(format, args...)}
+	if offset := nodeOffset(node); offset >= 0 && offset <= len(rc.source) {
+		d.Line = 1 + bytes.Count(rc.source[:offset], []byte("\n"))
+		d.Col = offset - bytes.LastIndexByte(rc.source[:offset], '\n')
+	}
+	rc.diags = append(rc.diags, d)
+}
+
+// fail records an error of a node that can not be rendered and skips its children,
+// so that the rest of the document is rendered.
+// Nodes inside an unsupported node have no parent element; the unsupported node is
+// reported once by checkKinds.
+func (r *Renderer) fail(node ast.Node, format string, args ...interface{}) (ast.WalkStatus, error) {
+	for p := node.Parent(); p != nil; p = p.Parent() {
+		if _, ok := p.Attribute(unsupportedAttr); ok {return ast.WalkSkipChildren, nil}
+	}
+	addDiag(node, SevError, format, args...)
+	return ast.WalkSkipChildren, nil
+}
+
+var unsupportedAttr = []byte("md2jsUnsupported")
+
+// isRenderAttr reports whether an attribute is set by the renderer itself
+// rather than by the markdown: the element variables el, elDiv, elHead and elBody.
+func isRenderAttr(nam []byte) bool {
+	switch string(nam) {
+	case "el", "elDiv", "elHead", "elBody":
+		return true
+	}
+	return bytes.Equal(nam, ctxAttr) || bytes.Equal(nam, unsupportedAttr) || bytes.Equal(nam, dirAttr)
+}
+
+// kindRegisterer records the node kinds registered by RegisterFuncs.
+type kindRegisterer struct {
+	renderer.NodeRendererFuncRegisterer
+	kinds map[ast.NodeKind]bool
+}
+
+func (k kindRegisterer) Register(kind ast.NodeKind, f renderer.NodeRendererFunc) {
+	k.kinds[kind] = true
+	k.NodeRendererFuncRegisterer.Register(kind, f)
+}
+
+// checkKinds warns of the nodes of a document that have no render function.
+// goldmark skips them but walks their children.
+func (r *Renderer) checkKinds(doc ast.Node) {
+	if r.kinds == nil {return}
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || r.kinds[n.Kind()] {return ast.WalkContinue, nil}
+		n.SetAttribute(unsupportedAttr, true)
+		addDiag(n, SevWarning, "unsupported node, not rendered")
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+// nodeOffset returns the source offset of a node: the start of its first line, of its
+// text, or of its first descendant or ancestor with a source position; -1 if there is none.
+func nodeOffset(node ast.Node) int {
+	for n := node; n != nil; n = n.Parent() {
+		if off := ownOffset(n); off >= 0 {return off}
+		// inline nodes without a segment take the position of their first text
+		for c := n.FirstChild(); c != nil; c = c.FirstChild() {
+			if off := ownOffset(c); off >= 0 {return off}
+		}
+		// or start after the text in front of them
+		if t, ok := n.PreviousSibling().(*ast.Text); ok {return t.Segment.Stop}
+	}
+	return -1
+}
+
+func ownOffset(n ast.Node) int {
+	switch t := n.(type) {
+	case *ast.Text:
+		return t.Segment.Start
+	case *ast.RawHTML:
+		if t.Segments.Len() > 0 {return t.Segments.At(0).Start}
+		return -1
+	}
+	if n.Type() == ast.TypeBlock && n.Lines() != nil && n.Lines().Len() > 0 {return n.Lines().At(0).Start}
+	return -1
+}
@@ -0,0 +1,58 @@
+// diag_test.go
+// tests of the render diagnostics: unsupported nodes, removed urls and dropped
+// attributes are reported with the node kind and the source position
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsV2_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestDiagnostics(t *testing.T) {
+
+	source := []byte("# title\n\n" +
+		"a [x](javascript:alert(1)) b\n\n" +
+		"~~gone~~ text\n\n" +
+		"<div>raw</div>\n\n" +
+		"see <http://a.b>\n")
+
+	md := goldmark.New(goldmark.WithExtensions(extension.Strikethrough))
+	md.SetRenderer(md2js.GetRenderer("diag", false))
+	doc := md.Parser().Parse(text.NewReader(source))
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {t.Fatal(err)}
+
+	want := []string{
+		"warning: line 5:3: Strikethrough: unsupported node, not rendered",
+		`warning: line 3:4: Link: url removed by the url policy: "javascript:alert(1)"`,
+		"info: line 7:1: HTMLBlock: raw html omitted",
+	}
+	var got []string
+	for _, d := range md2js.Diagnostics(doc) {got = append(got, d.String())}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diagnostics:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	// the text of the unsupported node has no parent element and is not reported again
+	if strings.Contains(buf.String(), "gone") {t.Errorf("text of the unsupported node rendered:\n%s", buf.String())}
+	if !strings.Contains(buf.String(), "text") {t.Errorf("text after the unsupported node missing:\n%s", buf.String())}
+	if !strings.Contains(buf.String(), ".href='http://a.b'") {t.Errorf("autolink missing:\n%s", buf.String())}
+
+	// a second render starts with no diagnostics
+	buf.Reset()
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {t.Fatal(err)}
+	if n := len(md2js.Diagnostics(doc)); n != len(want) {t.Errorf("second render: %d diagnostics, want %d", n, len(want))}
+}
@@ -0,0 +1,38 @@
+// dom_test.go
+// test helper: renders a document, runs the script in the fake dom and returns the
+// normalized html and the diagnostics
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsV2_test
+
+import (
+	"bytes"
+	"testing"
+
+	"goDemo/goldmark/samples/azul"
+	"goDemo/goldmark/samples/jsdom"
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+)
+
+// renderDOM renders the document doc of source with md and runs the script in the fake dom.
+// It returns the html of the dom normalized with norm and the diagnostics of the render;
+// js is the script for the error messages.
+func renderDOM(t *testing.T, md goldmark.Markdown, source []byte, doc ast.Node, norm jsdom.NormOptions) (html string, diags []string, js string, err error) {
+	t.Helper()
+	runtime, err := azul.Runtime(azul.Latest)
+	if err != nil {t.Fatal(err)}
+	var buf bytes.Buffer
+	buf.Write(md2js.JSRenderStartFunc())
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {t.Fatal(err)}
+	for _, d := range md2js.Diagnostics(doc) {diags = append(diags, d.String())}
+	root, err := jsdom.Run(buf.Bytes(), jsdom.RunOptions{Runtime: runtime})
+	if err != nil {return "", diags, buf.String(), err}
+	return jsdom.Normalize(root, norm).HTML(), diags, buf.String(), nil
+}
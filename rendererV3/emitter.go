@@ -0,0 +1,216 @@
+package md2jsV2
+
+// js emitters for node kinds of extension packages: an Emitter writes the elements of
+// a node with a Builder, which names the js variables, links the element of the node
+// to the element of its parent and escapes the text and attribute values
+
+import (
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// An Emitter renders the nodes of extension kinds as js statements.
+// Emit is called when entering and when leaving a node, as a goldmark render function.
+// The element created with Builder.Element when entering is appended to the element of
+// the parent when leaving. Block children are rendered by the walk and appended to it;
+// inline children are rendered with Builder.Children.
+// An emitter of a kind the renderer supports replaces the renderer function.
+type Emitter interface {
+	Kinds() []ast.NodeKind
+	Emit(b *Builder, node ast.Node, entering bool) (ast.WalkStatus, error)
+}
+
+// An EmitterProvider is an extension (goldmark.Extender) that ships emitters for its node kinds.
+// md2jsLib adds the emitters of the enabled extensions to the renderer.
+type EmitterProvider interface {
+	Emitters() []Emitter
+}
+
+// EmitFunc is the function of an emitter made with NewEmitter.
+type EmitFunc func(b *Builder, node ast.Node, entering bool) (ast.WalkStatus, error)
+
+type funcEmitter struct {
+	kinds []ast.NodeKind
+	fn    EmitFunc
+}
+
+func (e *funcEmitter) Kinds() []ast.NodeKind {return e.kinds}
+
+func (e *funcEmitter) Emit(b *Builder, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	return e.fn(b, node, entering)
+}
+
+// NewEmitter returns an emitter that renders the given kinds with a function.
+func NewEmitter(fn EmitFunc, kinds ...ast.NodeKind) Emitter {
+	return &funcEmitter{kinds: kinds, fn: fn}
+}
+
+// SkipEmitter returns an emitter that renders nothing for the given kinds, e.g. for
+// marker nodes of a parser extension.
+func SkipEmitter(kinds ...ast.NodeKind) Emitter {
+	return NewEmitter(func(b *Builder, node ast.Node, entering bool) (ast.WalkStatus, error) {
+		return ast.WalkSkipChildren, nil
+	}, kinds...)
+}
+
+// Emitters is an option name used in WithEmitters.
+const optEmitters renderer.OptionName = "Emitters"
+
+type withEmitters struct {
+	emitters []Emitter
+}
+
+func (o *withEmitters) SetConfig(c *renderer.Config) {
+	// several extensions may add emitters
+	prev, _ := c.Options[optEmitters].([]Emitter)
+	c.Options[optEmitters] = append(prev[:len(prev):len(prev)], o.emitters...)
+}
+
+func (o *withEmitters) SetHTMLOption(c *Config) {
+	c.Emitters = append(c.Emitters, o.emitters...)
+}
+
+// WithEmitters is a functional option that adds emitters for node kinds of extensions.
+func WithEmitters(emitters ...Emitter) interface {
+	renderer.Option
+	Option
+} {
+	return &withEmitters{emitters}
+}
+
+// registerEmitters registers the emitters after the renderer functions, so that an
+// emitter replaces the function of its kind.
+func (r *Renderer) registerEmitters(reg renderer.NodeRendererFuncRegisterer) {
+	r.emitters = make(map[ast.NodeKind]Emitter)
+	for _, e := range r.Emitters {
+		for _, kind := range e.Kinds() {
+			r.emitters[kind] = e
+			reg.Register(kind, r.emitFunc(e))
+		}
+	}
+}
+
+func (r *Renderer) emitFunc(e Emitter) renderer.NodeRendererFunc {
+	return func(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+		return r.emit(e, w, source, node, entering)
+	}
+}
+
+// emit calls an emitter and appends the element of the node to its parent when leaving.
+func (r *Renderer) emit(e Emitter, w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	b := &Builder{r: r, w: w, source: source, node: node}
+	status, err := e.Emit(b, node, entering)
+	if err != nil {return ast.WalkStop, err}
+	if entering {return status, nil}
+	elNam, ok := node.AttributeString("el")
+	if !ok {return status, nil}
+	if _, err := r.appendToParent(w, node, elNam.(string)); err != nil {return ast.WalkStop, err}
+	return status, nil
+}
+
+// A Builder writes the js statements of a node for an Emitter.
+type Builder struct {
+	r      *Renderer
+	w      util.BufWriter
+	source []byte
+	node   ast.Node
+}
+
+// Source returns the markdown source.
+func (b *Builder) Source() []byte {return b.source}
+
+// Unsafe reports whether raw html and dangerous urls are rendered (WithUnsafe).
+func (b *Builder) Unsafe() bool {return b.r.Unsafe}
+
+// Element creates the element of the node. It is appended to the element of the parent
+// when the node is left; the block children of the node are appended to it.
+// The style of the tag in mdStyle is assigned to the element.
+func (b *Builder) Element(tag string) *Element {
+	el := b.newElement(tag)
+	b.node.SetAttributeString("el", el.nam)
+	return el
+}
+
+// Children renders the inline children of the node into its element.
+// Emit returns ast.WalkSkipChildren after it.
+func (b *Builder) Children() {
+	if _, ok := b.node.AttributeString("el"); !ok {
+		addDiag(b.node, SevError, "no element name, children not rendered")
+		return
+	}
+	_, _ = b.r.renderTextChildren(b.w, b.source, b.node, true)
+}
+
+// Diag records a diagnostic of the node.
+func (b *Builder) Diag(sev Severity, format string, args ...interface{}) {
+	addDiag(b.node, sev, format, args...)
+}
+
+func (b *Builder) newElement(tag string) *Element {
+	nam := b.r.newElNam(b.node)
+	_, _ = b.w.WriteString("let " + nam + "=document.createElement(" + jsQuote([]byte(tag)) + ");\n")
+	if isJSIdent([]byte(tag)) {
+		_, _ = b.w.WriteString("Object.assign(" + nam + ".style, mdStyle." + tag + ");\n")
+	}
+	return &Element{b: b, nam: nam}
+}
+
+// An Element is a dom element of a Builder. The setters return the element for chaining.
+type Element struct {
+	b   *Builder
+	nam string
+}
+
+// Name returns the js variable of the element.
+func (e *Element) Name() string {return e.nam}
+
+// Prop sets a property of the element; a name that is no js identifier is set as attribute.
+func (e *Element) Prop(nam string, value []byte) *Element {
+	if !isJSIdent([]byte(nam)) {return e.Attr(nam, value)}
+	_, _ = e.b.w.WriteString(e.nam + "." + nam + "=" + jsQuote(value) + ";\n")
+	return e
+}
+
+// Attr sets an attribute of the element.
+func (e *Element) Attr(nam string, value []byte) *Element {
+	_, _ = e.b.w.WriteString(e.nam + ".setAttribute(" + jsQuote([]byte(nam)) + ", " + jsQuote(value) + ");\n")
+	return e
+}
+
+// Style sets a style property (camel case, e.g. textAlign) of the element.
+func (e *Element) Style(prop string, value []byte) *Element {
+	if !isJSIdent([]byte(prop)) {
+		_, _ = e.b.w.WriteString(e.nam + ".style.setProperty(" + jsQuote([]byte(prop)) + ", " + jsQuote(value) + ");\n")
+		return e
+	}
+	_, _ = e.b.w.WriteString(e.nam + ".style." + prop + "=" + jsQuote(value) + ";\n")
+	return e
+}
+
+// Attributes renders the attributes of the node that pass the filter.
+func (e *Element) Attributes(filter util.BytesFilter) *Element {
+	if e.b.node.Attributes() != nil {RenderElAttributes(e.b.w, e.b.node, filter, e.nam)}
+	return e
+}
+
+// Text sets the text content of the element.
+func (e *Element) Text(text []byte) *Element {
+	_, _ = e.b.w.WriteString(e.nam + ".textContent=`" + jsTemplate(text) + "`;\n")
+	return e
+}
+
+// AppendText appends a text node to the element.
+func (e *Element) AppendText(text []byte) *Element {
+	nam := e.b.r.newElNam(e.b.node)
+	_, _ = e.b.w.WriteString("const " + nam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n")
+	_, _ = e.b.w.WriteString(e.nam + ".appendChild(" + nam + ");\n")
+	return e
+}
+
+// Child creates an element and appends it to the element.
+func (e *Element) Child(tag string) *Element {
+	child := e.b.newElement(tag)
+	_, _ = e.b.w.WriteString(e.nam + ".appendChild(" + child.nam + ");\n")
+	return child
+}
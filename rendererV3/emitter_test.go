@@ -0,0 +1,63 @@
+// emitter_test.go
+// tests of the emitter interface: emitters for the strikethrough and definition list
+// extensions render inline and block nodes; the scripts are run in the fake dom
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsV2_test
+
+import (
+	"testing"
+
+	"goDemo/goldmark/samples/jsdom"
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// strikeEmitter renders an inline node with inline children.
+var strikeEmitter = md2js.NewEmitter(func(b *md2js.Builder, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {return ast.WalkContinue, nil}
+	el := b.Element("del").Prop("title", []byte("it's gone")).Style("text-decoration-color", []byte("red"))
+	el.Child("span").Attr("aria-hidden", []byte("true")).Text([]byte("~"))
+	b.Children()
+	return ast.WalkSkipChildren, nil
+}, east.KindStrikethrough)
+
+// defEmitter renders the block nodes of definition lists: the descriptions hold paragraphs.
+var defEmitter = md2js.NewEmitter(func(b *md2js.Builder, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {return ast.WalkContinue, nil}
+	switch node.Kind() {
+	case east.KindDefinitionList:
+		b.Element("dl")
+	case east.KindDefinitionTerm:
+		b.Element("dt")
+		b.Children()
+		return ast.WalkSkipChildren, nil
+	case east.KindDefinitionDescription:
+		b.Element("dd").Attr("aria-label", []byte("description"))
+	}
+	return ast.WalkContinue, nil
+}, east.KindDefinitionList, east.KindDefinitionTerm, east.KindDefinitionDescription)
+
+func TestEmitters(t *testing.T) {
+
+	source := []byte("a ~~b *c*~~ d\n\nterm `x`\n: desc\n\n  more\n")
+
+	md := goldmark.New(goldmark.WithExtensions(extension.Strikethrough, extension.DefinitionList))
+	md.SetRenderer(md2js.GetRenderer("emit", false, md2js.WithEmitters(strikeEmitter, defEmitter)))
+	doc := md.Parser().Parse(text.NewReader(source))
+	got, _, js, err := renderDOM(t, md, source, doc, jsdom.NormOptions{KeepStyle: true})
+	if err != nil {t.Fatalf("%v\n%s", err, js)}
+
+	want := `<p>a <del style="text-decoration-color: red" title="it&#39;s gone"><span aria-hidden="true">~</span>b <em>c</em></del> d</p>` +
+		`<dl><dt>term <code>x</code></dt><dd aria-label="description">desc<p>more</p></dd></dl>`
+	if got != want {t.Errorf("dom:\n got: %s\nwant: %s\n%s", got, want, js)}
+}
@@ -0,0 +1,109 @@
+// fuzz_test.go
+// fuzz test of the md2jsV3 renderer: random markdown is rendered with GetRenderer and
+// the script must parse as js (goja parser) and keep all text in string literals:
+// the script may only use the identifiers the renderer writes, and template literals
+// may not have substitutions
+//
+// run the fuzzer:
+//   go test ./rendererV3 -run XXX -fuzz FuzzRender
+// crashing inputs are saved in testdata/fuzz/FuzzRender and run as regression seeds
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsV2_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	jsast "github.com/dop251/goja/ast"
+	jsparser "github.com/dop251/goja/parser"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// jsIdents are the identifiers written by the renderer: the element variables and
+// the names of the dom, the runtime and the style objects.
+var jsIdents = regexp.MustCompile(`^(el\d+(Txt|txt|Span\d+)?|mdDiv|mdDivObj|site|document|azul|mdStyle|Object|` +
+	`name|render|typ|id|style|margin|border|position|minHeight|addElement|createElement|createTextNode|appendChild|` +
+	`assign|setAttribute|textContent|innerHTML|href|title|alt|src|start|className|textAlign|` +
+	`h[1-6]|p|ul|ol|li|a|block|table|thead|tbody|tr|th|td)$`)
+
+func FuzzRender(f *testing.F) {
+
+	files, err := filepath.Glob(filepath.Join(mdDir, "*.md"))
+	if err != nil {f.Fatal(err)}
+	for _, fil := range files {
+		source, err := os.ReadFile(fil)
+		if err != nil {f.Fatal(err)}
+		f.Add(source)
+	}
+	for _, seed := range []string{
+		"text with a ` backtick and ${x} and \\\\",
+		"*emph with `code` and **strong***",
+		"a ![img *alt*](/i.png 'ti\"t`le') b",
+		"[link](/u 'it\\'s') and <http://a.b/'x'>",
+		"<div onclick='x'>\n`${alert(1)}`\n</div>\n",
+		"```js'\nlet a = `b`;\n```\n",
+		"    code ${x} \\`\n",
+		"| a | `b` |\n|---|---|\n| ${c} | d\\` |\n",
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, source []byte) {
+		for _, cfg := range []goldenConfig{
+			{name: "default"},
+			{name: "unsafe", exts: []goldmark.Extender{extension.Table, extension.Footnote}, opts: []md2js.Option{md2js.WithUnsafe()}},
+		} {
+			out, err := render(cfg, source)
+			if err != nil {t.Fatalf("%s: %v", cfg.name, err)}
+			script := string(md2js.JSRenderStartFunc()) + string(out)
+			prog, err := jsparser.ParseFile(nil, "", script, 0)
+			if err != nil {t.Fatalf("%s: script does not parse: %v\n%s", cfg.name, err, script)}
+			if msg := checkLiterals(reflect.ValueOf(prog)); len(msg) > 0 {
+				t.Fatalf("%s: text escaped into code: %s\n%s", cfg.name, msg, script)
+			}
+		}
+	})
+}
+
+// checkLiterals walks a js ast and returns a description of the first node that
+// is not written by the renderer itself.
+func checkLiterals(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {return ""}
+		switch n := v.Interface().(type) {
+		case *jsast.Identifier:
+			if !jsIdents.MatchString(n.Name.String()) {return "identifier " + n.Name.String()}
+		case *jsast.TemplateLiteral:
+			if n.Tag != nil || len(n.Expressions) > 0 {return "template literal with substitutions"}
+		case *jsast.RegExpLiteral:
+			return "regexp literal " + n.Literal
+		}
+		return checkLiterals(v.Elem())
+	case reflect.Struct:
+		if id, ok := v.Interface().(jsast.Identifier); ok {
+			if !jsIdents.MatchString(id.Name.String()) {return "identifier " + id.Name.String()}
+			return ""
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {continue}
+			if msg := checkLiterals(v.Field(i)); len(msg) > 0 {return msg}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if msg := checkLiterals(v.Index(i)); len(msg) > 0 {return msg}
+		}
+	}
+	return ""
+}
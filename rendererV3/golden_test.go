@@ -0,0 +1,127 @@
+// golden_test.go
+// golden file tests: every sample document of md/ is converted with each renderer
+// configuration and compared with the expected script in testdata/golden/<config>/<name>.js
+// a render error is recorded in the golden file as a comment
+//
+// regenerate the golden files after an intended change of the output:
+//   go test ./rendererV3 -run TestGolden -update
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsV2_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	attributes "goDemo/goldmark/samples/extBlockAttr"
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// mdDir holds the sample documents.
+const mdDir = "../md"
+
+// goldenConfig is a renderer configuration of the golden tests.
+type goldenConfig struct {
+	name string
+	dbg  bool
+	exts []goldmark.Extender
+	opts []md2js.Option
+}
+
+var goldenConfigs = []goldenConfig{
+	{name: "default"},
+	{name: "dbg", dbg: true},
+	{name: "hardwraps", opts: []md2js.Option{md2js.WithHardWraps()}},
+	{name: "unsafe", opts: []md2js.Option{md2js.WithUnsafe()}},
+	{name: "attributes", exts: []goldmark.Extender{attributes.Extension}},
+	{name: "tables", exts: []goldmark.Extender{extension.Table, extension.Footnote}},
+}
+
+// render converts a source with a configuration. A panic of a node renderer is returned as an error.
+func render(cfg goldenConfig, source []byte) (out []byte, err error) {
+	md := goldmark.New(
+		goldmark.WithExtensions(cfg.exts...),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+	md.SetRenderer(md2js.GetRenderer("golden", cfg.dbg, cfg.opts...))
+
+	var buf bytes.Buffer
+	defer func() {
+		if r := recover(); r != nil {
+			out = buf.Bytes()
+			err = fmt.Errorf("renderer panic: %v", r)
+		}
+	}()
+	err = md.Convert(source, &buf)
+	return buf.Bytes(), err
+}
+
+// goldenOutput returns the content of a golden file: the script followed by the error, if any.
+func goldenOutput(cfg goldenConfig, source []byte) []byte {
+	out, err := render(cfg, source)
+	if err != nil {
+		out = append(out[:len(out):len(out)], []byte("\n// render error: "+err.Error()+"\n")...)
+	}
+	return out
+}
+
+func TestGolden(t *testing.T) {
+
+	files, err := filepath.Glob(filepath.Join(mdDir, "*.md"))
+	if err != nil {t.Fatal(err)}
+	if len(files) == 0 {t.Fatalf("no sample documents in %s", mdDir)}
+
+	for _, cfg := range goldenConfigs {
+		cfg := cfg
+		t.Run(cfg.name, func(t *testing.T) {
+			for _, fil := range files {
+				nam := strings.TrimSuffix(filepath.Base(fil), ".md")
+				t.Run(nam, func(t *testing.T) {
+					source, err := os.ReadFile(fil)
+					if err != nil {t.Fatal(err)}
+					got := goldenOutput(cfg, source)
+
+					goldFil := filepath.Join("testdata", "golden", cfg.name, nam+".js")
+					if *update {
+						if err := os.MkdirAll(filepath.Dir(goldFil), 0755); err != nil {t.Fatal(err)}
+						if err := os.WriteFile(goldFil, got, 0666); err != nil {t.Fatal(err)}
+						return
+					}
+					want, err := os.ReadFile(goldFil)
+					if err != nil {t.Fatalf("%v (run with -update to create the golden files)", err)}
+					if !bytes.Equal(got, want) {
+						t.Errorf("output differs from %s:\n%s", goldFil, firstDiff(want, got))
+					}
+				})
+			}
+		})
+	}
+}
+
+// firstDiff describes the first line that differs between want and got.
+func firstDiff(want, got []byte) string {
+	wl := strings.Split(string(want), "\n")
+	gl := strings.Split(string(got), "\n")
+	for i := 0; i < len(wl) || i < len(gl); i++ {
+		var w, g string
+		if i < len(wl) {w = wl[i]}
+		if i < len(gl) {g = gl[i]}
+		if w != g {return fmt.Sprintf("line %d:\n  want: %q\n  got:  %q", i+1, w, g)}
+	}
+	return "outputs differ in length"
+}
@@ -0,0 +1,258 @@
+package md2jsV2
+
+// images: figure mode (figure and figcaption for an image alone in a paragraph or with
+// a title, optionally numbered), lazy loading and the srcset of variant files next to
+// the image (cat@2x.png, cat-800w.png)
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+var dirAttr = []byte("md2jsDir")
+
+// SetSourceDir sets the directory of the markdown file of a document. Relative image
+// paths are resolved against it; the default is the working directory.
+func SetSourceDir(doc ast.Node, dir string) {
+	doc.SetAttribute(dirAttr, dir)
+}
+
+// Figures is an option name used in WithFigures.
+const optFigures renderer.OptionName = "Figures"
+
+// FigureLabel is an option name used in WithFigureNumbers.
+const optFigureLabel renderer.OptionName = "FigureLabel"
+
+type withFigures struct {
+	label string
+}
+
+func (o *withFigures) SetConfig(c *renderer.Config) {
+	c.Options[optFigures] = true
+	if len(o.label) > 0 {c.Options[optFigureLabel] = o.label}
+}
+
+func (o *withFigures) SetHTMLOption(c *Config) {
+	c.Figures = true
+	if len(o.label) > 0 {c.FigureLabel = o.label}
+}
+
+// WithFigures is a functional option that renders an image alone in a paragraph as figure
+// with a figcaption (the title, else the alt text). An image in text stays an img: a p
+// may not contain a figure.
+func WithFigures() interface {
+	renderer.Option
+	Option
+} {
+	return &withFigures{}
+}
+
+// WithFigureNumbers is a functional option like WithFigures that numbers the captions:
+// "<label> 1:".
+func WithFigureNumbers(label string) interface {
+	renderer.Option
+	Option
+} {
+	return &withFigures{label}
+}
+
+// LazyImages is an option name used in WithLazyImages.
+const optLazyImages renderer.OptionName = "LazyImages"
+
+type withLazyImages struct {
+}
+
+func (o *withLazyImages) SetConfig(c *renderer.Config) {
+	c.Options[optLazyImages] = true
+}
+
+func (o *withLazyImages) SetHTMLOption(c *Config) {
+	c.LazyImages = true
+}
+
+// WithLazyImages is a functional option that loads images lazily and decodes them
+// asynchronously unless the image attributes set loading or decoding.
+func WithLazyImages() interface {
+	renderer.Option
+	Option
+} {
+	return &withLazyImages{}
+}
+
+// ImageVariants is an option name used in WithImageVariants.
+const optImageVariants renderer.OptionName = "ImageVariants"
+
+type withImageVariants struct {
+}
+
+func (o *withImageVariants) SetConfig(c *renderer.Config) {
+	c.Options[optImageVariants] = true
+}
+
+func (o *withImageVariants) SetHTMLOption(c *Config) {
+	c.ImageVariants = true
+}
+
+// WithImageVariants is a functional option that sets the srcset of a local image from the
+// variant files next to it: cat@2x.png (pixel density) or cat-800w.png (width), unless
+// the image attributes set srcset.
+func WithImageVariants() interface {
+	renderer.Option
+	Option
+} {
+	return &withImageVariants{}
+}
+
+// figureParagraph reports whether a node is a paragraph with only an image.
+func figureParagraph(node ast.Node) bool {
+	return node != nil && node.Kind() == ast.KindParagraph && node.ChildCount() == 1 &&
+		node.FirstChild().Kind() == ast.KindImage
+}
+
+// isFigure reports whether an image is rendered as figure: only an image alone in its
+// paragraph, which the figure replaces.
+func (r *Renderer) isFigure(n *ast.Image) bool {
+	return r.Figures && figureParagraph(n.Parent())
+}
+
+// renderFigure writes the figure element of an image; the attributes of a figure paragraph
+// are set on it.
+func (r *Renderer) renderFigure(w util.BufWriter, n *ast.Image) string {
+	figNam := r.newElNam(n)
+	_, _ = w.WriteString("let " + figNam + "=document.createElement('figure');\n")
+	_, _ = w.WriteString("Object.assign(" + figNam + ".style, mdStyle.figure);\n")
+	if para := n.Parent(); figureParagraph(para) && para.Attributes() != nil {
+		RenderElAttributes(w, para, ParagraphAttributeFilter, figNam)
+	}
+	return figNam
+}
+
+// renderCaption appends the image and the caption to the figure: the title of the image
+// or its alt text, after the number of the figure.
+func (r *Renderer) renderCaption(w util.BufWriter, source []byte, n *ast.Image, figNam, elNam string) {
+	_, _ = w.WriteString(figNam + ".appendChild(" + elNam + ");\n")
+	caption := n.Title
+	if caption == nil {caption = nodeTexts(source, n)}
+	if len(caption) == 0 && len(r.FigureLabel) == 0 {return}
+
+	capNam := r.newElNam(n)
+	_, _ = w.WriteString("let " + capNam + "=document.createElement('figcaption');\n")
+	_, _ = w.WriteString("Object.assign(" + capNam + ".style, mdStyle.figcaption);\n")
+	if len(r.FigureLabel) > 0 {
+		rc := r.ctx(n)
+		rc.figures++
+		numNam := r.newElNam(n)
+		_, _ = w.WriteString("let " + numNam + "=document.createElement('span');\n")
+		_, _ = w.WriteString(numNam + ".className='figure-number';\n")
+		_, _ = w.WriteString(numNam + ".textContent=" + jsQuote([]byte(r.FigureLabel+" "+strconv.Itoa(rc.figures)+":")) + ";\n")
+		_, _ = w.WriteString(capNam + ".appendChild(" + numNam + ");\n")
+		if len(caption) > 0 {caption = append([]byte(" "), caption...)}
+	}
+	if len(caption) > 0 {
+		txtNam := r.newElNam(n)
+		_, _ = w.WriteString("const " + txtNam + "=document.createTextNode(`" + jsTemplate(caption) + "`);\n")
+		_, _ = w.WriteString(capNam + ".appendChild(" + txtNam + ");\n")
+	}
+	_, _ = w.WriteString(figNam + ".appendChild(" + capNam + ");\n")
+}
+
+// renderImageDefaults writes the lazy loading and the srcset of the variant files of an image.
+func (r *Renderer) renderImageDefaults(w util.BufWriter, n *ast.Image, elNam string) {
+	if r.LazyImages {
+		if _, ok := n.AttributeString("loading"); !ok {_, _ = w.WriteString(elNam + ".loading='lazy';\n")}
+		if _, ok := n.AttributeString("decoding"); !ok {_, _ = w.WriteString(elNam + ".decoding='async';\n")}
+	}
+	if r.ImageVariants {
+		if _, ok := n.AttributeString("srcset"); ok {return}
+		if vs := ImageVariants(r.ctx(n).dir, string(n.Destination)); len(vs) > 0 {
+			_, _ = w.WriteString(elNam + ".srcset=" + jsQuote([]byte(Srcset(vs))) + ";\n")
+		}
+	}
+}
+
+// IsLocalURL reports whether an url is a relative path of a local file.
+func IsLocalURL(dest string) bool {
+	if len(dest) == 0 || strings.HasPrefix(dest, "/") || strings.HasPrefix(dest, "#") || strings.HasPrefix(dest, "//") {return false}
+	if i := strings.IndexAny(dest, ":/?#"); i >= 0 && dest[i] == ':' {return false}
+	return !strings.ContainsAny(dest, "?#")
+}
+
+var variantRe = regexp.MustCompile(`^-([0-9]+)w$|^@([0-9]+(\.[0-9]+)?)x$`)
+
+// An ImageVariant is a variant file of an image in a srcset.
+type ImageVariant struct {
+	// URL is the url of the variant, relative like the url of the image.
+	URL string
+	// File is the path of the variant file.
+	File string
+	// Descriptor is the width (480w) or pixel density (2x) of the variant.
+	Descriptor string
+}
+
+// ImageVariants returns the variant files next to the local image dest resolved against
+// dir: the width variants (cat-480w.png 480w) or else the density variants with the image
+// as 1x (cat.png 1x, cat@2x.png 2x), sorted by size. It returns nil if there are none.
+func ImageVariants(dir, dest string) []ImageVariant {
+	if !IsLocalURL(dest) {return nil}
+	p, err := url.PathUnescape(dest)
+	if err != nil {p = dest}
+	file := filepath.Join(dir, filepath.FromSlash(p))
+	entries, err := os.ReadDir(filepath.Dir(file))
+	if err != nil {return nil}
+	ext := filepath.Ext(file)
+	base := strings.TrimSuffix(filepath.Base(file), ext)
+	prefix := dest[:strings.LastIndex(dest, "/")+1]
+
+	type variant struct {
+		nam string
+		num float64
+	}
+	var widths, densities []variant
+	for _, e := range entries {
+		nam := e.Name()
+		if e.IsDir() || !strings.HasPrefix(nam, base) || !strings.HasSuffix(nam, ext) || len(nam) <= len(base)+len(ext) {continue}
+		m := variantRe.FindStringSubmatch(nam[len(base) : len(nam)-len(ext)])
+		if m == nil {continue}
+		if len(m[1]) > 0 {
+			num, _ := strconv.ParseFloat(m[1], 64)
+			widths = append(widths, variant{nam, num})
+		} else {
+			num, _ := strconv.ParseFloat(m[2], 64)
+			densities = append(densities, variant{nam, num})
+		}
+	}
+
+	list, unit := widths, "w"
+	if len(list) == 0 {
+		if len(densities) == 0 {return nil}
+		list, unit = append(densities, variant{filepath.Base(file), 1}), "x"
+	}
+	sort.Slice(list, func(i, j int) bool {return list[i].num < list[j].num})
+	vs := make([]ImageVariant, len(list))
+	for i, v := range list {
+		vs[i] = ImageVariant{
+			URL:        prefix + url.PathEscape(v.nam),
+			File:       filepath.Join(filepath.Dir(file), v.nam),
+			Descriptor: strconv.FormatFloat(v.num, 'f', -1, 64) + unit,
+		}
+		// the image itself keeps its url
+		if unit == "x" && v.nam == filepath.Base(file) {vs[i].URL = dest}
+	}
+	return vs
+}
+
+// Srcset returns the srcset of image variants.
+func Srcset(vs []ImageVariant) string {
+	items := make([]string, len(vs))
+	for i, v := range vs {items[i] = v.URL + " " + v.Descriptor}
+	return strings.Join(items, ", ")
+}
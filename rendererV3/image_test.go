@@ -0,0 +1,88 @@
+// image_test.go
+// tests of the image options: figures with numbered captions, lazy loading and the
+// srcset of attributes and of variant files next to the image
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsV2_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	attributes "goDemo/goldmark/samples/extBlockAttr"
+	"goDemo/goldmark/samples/jsdom"
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestImages(t *testing.T) {
+
+	dir := t.TempDir()
+	for _, nam := range []string{"cat.png", "cat@2x.png", "cat@1.5x.png", "dog.png", "dog-800w.png", "dog-480w.png", "dog@2x.png"} {
+		if err := os.WriteFile(filepath.Join(dir, nam), nil, 0666); err != nil {t.Fatal(err)}
+	}
+
+	tests := []struct {
+		src  string
+		opts []md2js.Option
+		want string
+	}{
+		{"![a cat](cat.png)\n", []md2js.Option{md2js.WithFigures()},
+			`<figure><img alt="a cat" src="cat.png"><figcaption>a cat</figcaption></figure>`},
+		{"![a](a.png)\n{.wide}\n\ntext ![b](b.png \"the b\") more\n\n![](c.png)\n", []md2js.Option{md2js.WithFigureNumbers("Fig.")},
+			`<figure class="wide"><img alt="a" src="a.png"><figcaption><span class="figure-number">Fig. 1:</span> a</figcaption></figure>` +
+				`<p>text <img alt="b" src="b.png" title="the b"> more</p>` +
+				`<figure><img alt="" src="c.png"><figcaption><span class="figure-number">Fig. 2:</span></figcaption></figure>`},
+		{"![t](t.png \"the t\")\n\na ![u](u.png \"the u\")\n", []md2js.Option{md2js.WithFigures()},
+			`<figure><img alt="t" src="t.png"><figcaption>the t</figcaption></figure><p>a <img alt="u" src="u.png" title="the u"></p>`},
+		{"x ![a](a.png \"t\")\n", nil, `<p>x <img alt="a" src="a.png" title="t"></p>`},
+		{"x ![a](a.png){loading=eager} ![b](b.png)\n", []md2js.Option{md2js.WithLazyImages()},
+			`<p>x <img alt="a" decoding="async" loading="eager" src="a.png"> <img alt="b" decoding="async" loading="lazy" src="b.png"></p>`},
+		{"x ![a](cat.png) ![b](dog.png) ![c](c.png){srcset=\"c2.png 2x\" sizes=\"50vw\"}\n", []md2js.Option{md2js.WithImageVariants()},
+			`<p>x <img alt="a" src="cat.png" srcset="cat.png 1x, cat@1.5x.png 1.5x, cat@2x.png 2x"> ` +
+				`<img alt="b" src="dog.png" srcset="dog-480w.png 480w, dog-800w.png 800w"> ` +
+				`<img alt="c" sizes="50vw" src="c.png" srcset="c2.png 2x"></p>`},
+	}
+
+	ext := attributes.Extension.(md2js.EmitterProvider)
+
+	for _, test := range tests {
+		md := goldmark.New(goldmark.WithExtensions(attributes.Extension))
+		opts := append([]md2js.Option{md2js.WithEmitters(ext.Emitters()...)}, test.opts...)
+		md.SetRenderer(md2js.GetRenderer("images", false, opts...))
+		source := []byte(test.src)
+		doc := md.Parser().Parse(text.NewReader(source))
+		md2js.SetSourceDir(doc, dir)
+		got, diags, js, err := renderDOM(t, md, source, doc, jsdom.NormOptions{})
+		if err != nil {
+			t.Errorf("%q: %v\n%s", test.src, err, js)
+			continue
+		}
+		if got != test.want {t.Errorf("%q:\n got: %s\nwant: %s\n%s", test.src, got, test.want, js)}
+		if len(diags) > 0 {t.Errorf("%q: diagnostics: %v", test.src, diags)}
+	}
+}
+
+// the urls are set as properties: a query string is not html escaped
+func TestURLQuery(t *testing.T) {
+
+	source := []byte("![p](p.png?w=1&h=2) [l](a?x=1&y=2) <https://a.b/?x=1&y=2>\n")
+	md := goldmark.New()
+	md.SetRenderer(md2js.GetRenderer("query", false))
+	doc := md.Parser().Parse(text.NewReader(source))
+	got, _, js, err := renderDOM(t, md, source, doc, jsdom.NormOptions{})
+	if err != nil {t.Fatalf("%v\n%s", err, js)}
+	want := `<p><img alt="p" src="p.png?w=1&amp;h=2"> <a href="a?x=1&amp;y=2">l</a> <a href="https://a.b/?x=1&amp;y=2">https://a.b/?x=1&amp;y=2</a></p>`
+	if got != want {t.Errorf("\n got: %s\nwant: %s\n%s", got, want, js)}
+	for _, prop := range []string{".src='p.png?w=1&h=2'", ".href='a?x=1&y=2'", ".href='https://a.b/?x=1&y=2'"} {
+		if !strings.Contains(js, prop) {t.Errorf("%s missing:\n%s", prop, js)}
+	}
+}
@@ -0,0 +1,204 @@
+package md2jsV2
+
+// link resolver: relative links to markdown files become routes of the converted documents
+// or render calls of the site script, relative urls are resolved against a base url and
+// the urls of custom schemes (ticket:1234) are resolved by callbacks
+// external links: links to hosts outside the internal domains open in a new tab and get
+// an optional marker
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// A SchemeResolver returns the url of the reference of a custom scheme, e.g. "1234" of
+// ticket:1234. If ok is false the url is kept.
+type SchemeResolver func(ref string) (href string, ok bool)
+
+// Links configures the link resolver of links and autolinks.
+type Links struct {
+	// Route is the url of a converted document: {path} is replaced by the path of the
+	// linked markdown file without .md, e.g. "{path}.html". Empty keeps the .md path.
+	Route string
+	// RenderCall renders a linked document with site.open(path, fragment) instead of
+	// following the link if the site script defines site.open. The path is relative to
+	// the document root.
+	RenderCall bool
+	// Dir is the directory of the document relative to the document root, e.g. "guide";
+	// the paths of the render calls are resolved against it.
+	Dir string
+	// BaseURL is the url relative links are resolved against, e.g. "https://example.com/docs/".
+	BaseURL string
+	// Schemes resolve the urls of custom schemes by the lower case scheme name.
+	Schemes map[string]SchemeResolver
+}
+
+// Links is an option name used in WithLinks.
+const optLinks renderer.OptionName = "Links"
+
+type withLinks struct {
+	links Links
+}
+
+func (o *withLinks) SetConfig(c *renderer.Config) {
+	c.Options[optLinks] = o.links
+}
+
+func (o *withLinks) SetHTMLOption(c *Config) {
+	c.Links = &o.links
+}
+
+// WithLinks is a functional option that resolves the destinations of links and autolinks
+// with the link resolver l.
+func WithLinks(l Links) interface {
+	renderer.Option
+	Option
+} {
+	return &withLinks{l}
+}
+
+// Resolve returns the href of a link destination. For a link to a markdown file doc is
+// the path of the document without .md relative to the document root and fragment its
+// fragment; doc is empty otherwise.
+func (l *Links) Resolve(dest string) (href, doc, fragment string) {
+	href = dest
+	if i := schemeEnd(dest); i > 0 {
+		res, ok := l.Schemes[strings.ToLower(dest[:i])]
+		if !ok {return href, "", ""}
+		if h, ok := res(dest[i+1:]); ok {href = h}
+		if schemeEnd(href) > 0 {return href, "", ""}
+	}
+	// a fragment of the document itself
+	if len(href) == 0 || href[0] == '#' {return href, "", ""}
+
+	p, rest := href, ""
+	if i := strings.IndexAny(href, "?#"); i >= 0 {p, rest = href[:i], href[i:]}
+	if strings.HasSuffix(strings.ToLower(p), ".md") && !strings.HasPrefix(p, "//") {
+		rel := p[:len(p)-3]
+		if i := strings.IndexByte(rest, '#'); i >= 0 {fragment = rest[i+1:]}
+		if len(l.Route) > 0 {href = strings.ReplaceAll(l.Route, "{path}", rel) + rest}
+		if u, err := url.PathUnescape(rel); err == nil {rel = u}
+		doc = strings.TrimPrefix(path.Clean("/"+path.Join(l.Dir, rel)), "/")
+		if strings.HasPrefix(rel, "/") {doc = strings.TrimPrefix(path.Clean(rel), "/")}
+	}
+	if len(l.BaseURL) > 0 {
+		base, err := url.Parse(l.BaseURL)
+		ref, rerr := url.Parse(href)
+		if err == nil && rerr == nil {href = base.ResolveReference(ref).String()}
+	}
+	return href, doc, fragment
+}
+
+// schemeEnd returns the index of the colon after the scheme of an url, -1 if the url
+// has no scheme.
+func schemeEnd(dest string) int {
+	i := strings.IndexAny(dest, ":/?#")
+	if i <= 0 || dest[i] != ':' {return -1}
+	return i
+}
+
+// resolveLink returns the href of a link destination and writes the render call of a
+// linked document.
+func (r *Renderer) resolveLink(w util.BufWriter, dest []byte, elNam string) []byte {
+	if r.Links == nil {return dest}
+	href, doc, fragment := r.Links.Resolve(string(dest))
+	if r.Links.RenderCall && len(doc) > 0 {
+		_, _ = w.WriteString(elNam + ".addEventListener('click', function (e) {if (typeof site.open === 'function') {" +
+			"e.preventDefault(); site.open(" + jsQuote([]byte(doc)) + ", " + jsQuote([]byte(fragment)) + ");}});\n")
+	}
+	return []byte(href)
+}
+
+// ExternalLinks configures the links to hosts outside the internal domains.
+type ExternalLinks struct {
+	// Internal are the internal domains; their sub domains are internal as well.
+	Internal []string
+	// Marker is the text of a span.external-link appended to an external link, e.g. "↗".
+	Marker string
+}
+
+// ExternalLinks is an option name used in WithExternalLinks.
+const optExternalLinks renderer.OptionName = "ExternalLinks"
+
+type withExternalLinks struct {
+	external ExternalLinks
+}
+
+func (o *withExternalLinks) SetConfig(c *renderer.Config) {
+	c.Options[optExternalLinks] = o.external
+}
+
+func (o *withExternalLinks) SetHTMLOption(c *Config) {
+	c.ExternalLinks = &o.external
+}
+
+// WithExternalLinks is a functional option that opens the links and autolinks to hosts
+// outside the internal domains in a new tab (target=_blank, rel="noopener noreferrer")
+// and appends the marker. The attribute external=true or external=false of a link
+// overrides the host; a target attribute takes precedence. The tokens of a rel attribute
+// are merged with noopener noreferrer whenever the target is _blank.
+func WithExternalLinks(x ExternalLinks) interface {
+	renderer.Option
+	Option
+} {
+	return &withExternalLinks{x}
+}
+
+// IsExternal reports whether an url is an http or https url of a host outside the
+// internal domains.
+func (x *ExternalLinks) IsExternal(href string) bool {
+	u, err := url.Parse(href)
+	if err != nil || len(u.Host) == 0 {return false}
+	if scheme := strings.ToLower(u.Scheme); len(scheme) > 0 && scheme != "http" && scheme != "https" {return false}
+	host := strings.ToLower(u.Hostname())
+	for _, d := range x.Internal {
+		d = strings.ToLower(strings.TrimPrefix(d, "."))
+		if host == d || strings.HasSuffix(host, "."+d) {return false}
+	}
+	return true
+}
+
+// externalLink writes the target of an external link and reports whether the link is
+// external. A link that opens in a new tab gets noopener noreferrer added to the rel
+// attribute, which is written with the other attributes.
+func (r *Renderer) externalLink(w util.BufWriter, node ast.Node, href []byte, elNam string) bool {
+	external := false
+	if r.ExternalLinks != nil {
+		external = r.ExternalLinks.IsExternal(string(href))
+		if v, ok := node.AttributeString("external"); ok {
+			s, _ := attrString(v)
+			external = s != "false"
+		}
+	}
+	target := ""
+	if v, ok := node.AttributeString("target"); ok {
+		target, _ = attrString(v)
+	} else if external {
+		target = "_blank"
+		_, _ = w.WriteString(elNam + ".target='_blank';\n")
+	}
+	if target != "_blank" {return external}
+
+	var rel []string
+	if v, ok := node.AttributeString("rel"); ok {rel, _ = attrList(v)}
+	rel = mergeClasses(rel, "noopener", "noreferrer")
+	node.SetAttributeString("rel", []byte(strings.Join(rel, " ")))
+	return external
+}
+
+// renderMarker appends the marker of an external link after its text.
+func (r *Renderer) renderMarker(w util.BufWriter, node ast.Node, elNam string) {
+	if len(r.ExternalLinks.Marker) == 0 {return}
+	mNam := r.newElNam(node)
+	_, _ = w.WriteString("let " + mNam + "=document.createElement('span');\n")
+	_, _ = w.WriteString(mNam + ".className='external-link';\n")
+	_, _ = w.WriteString(mNam + ".setAttribute('aria-hidden', 'true');\n")
+	_, _ = w.WriteString(mNam + ".textContent=" + jsQuote([]byte(r.ExternalLinks.Marker)) + ";\n")
+	_, _ = w.WriteString("Object.assign(" + mNam + ".style, mdStyle.externalLink);\n")
+	_, _ = w.WriteString(elNam + ".appendChild(" + mNam + ");\n")
+}
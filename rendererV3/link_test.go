@@ -0,0 +1,99 @@
+// link_test.go
+// tests of the link resolver: routes and render calls of linked markdown files, base
+// urls, fragments and custom schemes, and of the external links
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsV2_test
+
+import (
+	"strings"
+	"testing"
+
+	attributes "goDemo/goldmark/samples/extBlockAttr"
+	"goDemo/goldmark/samples/jsdom"
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestLinks(t *testing.T) {
+
+	ticket := func(ref string) (string, bool) {
+		if len(ref) == 0 {return "", false}
+		return "https://tracker.example.com/issue/" + ref, true
+	}
+	route := md2js.Links{Route: "{path}.html", Schemes: map[string]md2js.SchemeResolver{"ticket": ticket}}
+	base := md2js.Links{Route: "{path}.html", BaseURL: "https://example.com/docs/guide/"}
+
+	tests := []struct {
+		src   string
+		links md2js.Links
+		want  string
+		// open are the render calls
+		open []string
+	}{
+		{"[a](Lists.md#ordered) [b](../ref/API.md) [c](#top) [d](https://example.com/x.md)\n", route,
+			`<p><a href="Lists.html#ordered">a</a> <a href="../ref/API.html">b</a> <a href="#top">c</a> <a href="https://example.com/x.md">d</a></p>`, nil},
+		{"[t](ticket:1234) <ticket:99> [u](TICKET:7) [n](news:x)\n", route,
+			`<p><a href="https://tracker.example.com/issue/1234">t</a> <a href="https://tracker.example.com/issue/99">ticket:99</a> ` +
+				`<a href="https://tracker.example.com/issue/7">u</a> <a href="news:x">n</a></p>`, nil},
+		{"[a](Lists.md?v=2#ordered) [b](../img/cat.png) [c](/about) [d](#top) <https://example.org/>\n", base,
+			`<p><a href="https://example.com/docs/guide/Lists.html?v=2#ordered">a</a> <a href="https://example.com/docs/img/cat.png">b</a> ` +
+				`<a href="https://example.com/about">c</a> <a href="#top">d</a> <a href="https://example.org/">https://example.org/</a></p>`, nil},
+		{"[a](Lists.md#ordered)\n", md2js.Links{RenderCall: true},
+			`<p><a href="Lists.md#ordered">a</a></p>`, []string{"site.open('Lists', 'ordered')"}},
+		// the paths of the render calls are relative to the document root
+		{"[a](../ref/Lists.md#ordered) [b](Intro%20One.md) [c](/API.md)\n", md2js.Links{RenderCall: true, Dir: "guide"},
+			`<p><a href="../ref/Lists.md#ordered">a</a> <a href="Intro%20One.md">b</a> <a href="/API.md">c</a></p>`,
+			[]string{"site.open('ref/Lists', 'ordered')", "site.open('guide/Intro One', '')", "site.open('API', '')"}},
+	}
+
+	for _, test := range tests {
+		md := goldmark.New()
+		md.SetRenderer(md2js.GetRenderer("links", false, md2js.WithLinks(test.links)))
+		source := []byte(test.src)
+		doc := md.Parser().Parse(text.NewReader(source))
+		got, _, js, err := renderDOM(t, md, source, doc, jsdom.NormOptions{})
+		if err != nil {
+			t.Errorf("%q: %v\n%s", test.src, err, js)
+			continue
+		}
+		if got != test.want {t.Errorf("%q:\n got: %s\nwant: %s\n%s", test.src, got, test.want, js)}
+		for _, call := range test.open {
+			if !strings.Contains(js, call) {t.Errorf("%q: no render call %s\n%s", test.src, call, js)}
+		}
+	}
+}
+
+func TestExternalLinks(t *testing.T) {
+
+	src := "[a](https://docs.example.com/x) [b](https://other.org/) <https://other.org/y> see www.other.org/z and [c](Lists.md)\n\n" +
+		"[d](https://other.org/){external=false} [e](/about){external=true} [f](https://other.org/){target=_self rel=nofollow}\n\n" +
+		"[g](https://other.org/){rel=nofollow} [h](/x){target=_blank rel=\"noopener external\"}\n"
+	want := `<p><a href="https://docs.example.com/x">a</a> ` +
+		`<a href="https://other.org/" rel="noopener noreferrer" target="_blank">b<span aria-hidden="true" class="external-link">↗</span></a> ` +
+		`<a href="https://other.org/y" rel="noopener noreferrer" target="_blank">https://other.org/y<span aria-hidden="true" class="external-link">↗</span></a>` +
+		` see <a href="http://www.other.org/z" rel="noopener noreferrer" target="_blank">www.other.org/z<span aria-hidden="true" class="external-link">↗</span></a>` +
+		` and <a href="Lists.md">c</a></p>` +
+		`<p><a href="https://other.org/">d</a> ` +
+		`<a href="/about" rel="noopener noreferrer" target="_blank">e<span aria-hidden="true" class="external-link">↗</span></a> ` +
+		`<a href="https://other.org/" rel="nofollow" target="_self">f<span aria-hidden="true" class="external-link">↗</span></a></p>` +
+		`<p><a href="https://other.org/" rel="nofollow noopener noreferrer" target="_blank">g<span aria-hidden="true" class="external-link">↗</span></a> ` +
+		`<a href="/x" rel="noopener external noreferrer" target="_blank">h</a></p>`
+
+	md := goldmark.New(goldmark.WithExtensions(extension.Linkify, attributes.Extension))
+	external := md2js.ExternalLinks{Internal: []string{"example.com"}, Marker: "↗"}
+	md.SetRenderer(md2js.GetRenderer("external", false, md2js.WithExternalLinks(external)))
+	source := []byte(src)
+	doc := md.Parser().Parse(text.NewReader(source))
+	got, diags, js, err := renderDOM(t, md, source, doc, jsdom.NormOptions{})
+	if err != nil {t.Fatalf("%v\n%s", err, js)}
+	if got != want {t.Errorf("\n got: %s\nwant: %s\n%s", got, want, js)}
+	if len(diags) > 0 {t.Errorf("diagnostics: %v", diags)}
+}
@@ -0,0 +1,204 @@
+package md2jsV2
+
+// js renderers for the goldmark extension nodes: tables and footnotes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// TableAttributeFilter defines attribute names which table elements can have.
+var TableAttributeFilter = GlobalAttributeFilter.Extend(
+	[]byte("align"),       // [Deprecated]
+	[]byte("bgcolor"),     // [Deprecated]
+	[]byte("border"),      // [Deprecated]
+	[]byte("cellpadding"), // [Deprecated]
+	[]byte("cellspacing"), // [Deprecated]
+	[]byte("frame"),       // [Deprecated]
+	[]byte("rules"),       // [Deprecated]
+	[]byte("summary"),     // [Deprecated]
+	[]byte("width"),       // [Deprecated]
+)
+
+// appendToParent writes the appendChild statement of a node to its parent element.
+func (r *Renderer) appendToParent(w util.BufWriter, node ast.Node, elNam string) (ast.WalkStatus, error) {
+	pnode := node.Parent()
+	if pnode == nil {return r.fail(node, "no parent node")}
+	parElNam, res := pnode.AttributeString("el")
+	if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
+	if r.dbg {
+		dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
+		_, _ = w.WriteString(dbgStr)
+	}
+	_, _ = w.WriteString(parElNam.(string) + ".appendChild(" + elNam + ");\n")
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderTable(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		elNam := r.newElNam(node)
+		node.SetAttributeString("el",elNam)
+		_, _ = w.WriteString("let " + elNam + "=document.createElement('table');\n")
+		_, _ = w.WriteString("Object.assign(" + elNam + ".style, mdStyle.table);\n")
+		if node.Attributes() != nil {RenderElAttributes(w, node, TableAttributeFilter, elNam)}
+		return ast.WalkContinue, nil
+	}
+	elNam, res := node.AttributeString("el")
+	if !res {return r.fail(node, "no element name")}
+	return r.appendToParent(w, node, elNam.(string))
+}
+
+func (r *Renderer) renderTableHeader(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	// the header cells are children of the header node: thead > tr > th
+	if entering {
+		headNam := r.newElNam(node)
+		elNam := r.newElNam(node)
+		node.SetAttributeString("elHead",headNam)
+		node.SetAttributeString("el",elNam)
+		_, _ = w.WriteString("let " + headNam + "=document.createElement('thead');\n")
+		_, _ = w.WriteString("let " + elNam + "=document.createElement('tr');\n")
+		return ast.WalkContinue, nil
+	}
+	headNam, res := node.AttributeString("elHead")
+	if !res {return r.fail(node, "no head element name")}
+	elNam, res := node.AttributeString("el")
+	if !res {return r.fail(node, "no element name")}
+	_, _ = w.WriteString(headNam.(string) + ".appendChild(" + elNam.(string) + ");\n")
+	return r.appendToParent(w, node, headNam.(string))
+}
+
+func (r *Renderer) renderTableRow(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	table := node.Parent()
+	if table == nil {return r.fail(node, "no parent node")}
+	if entering {
+		// rows are collected in a tbody that is created with the first row
+		bodyNam, res := table.AttributeString("elBody")
+		if !res {
+			tblNam, res := table.AttributeString("el")
+			if !res {return r.fail(node, "no table element name")}
+			bodyNam = r.newElNam(node)
+			table.SetAttributeString("elBody",bodyNam)
+			_, _ = w.WriteString("let " + bodyNam.(string) + "=document.createElement('tbody');\n")
+			_, _ = w.WriteString(tblNam.(string) + ".appendChild(" + bodyNam.(string) + ");\n")
+		}
+		elNam := r.newElNam(node)
+		node.SetAttributeString("el",elNam)
+		_, _ = w.WriteString("let " + elNam + "=document.createElement('tr');\n")
+		return ast.WalkContinue, nil
+	}
+	elNam, res := node.AttributeString("el")
+	if !res {return r.fail(node, "no element name")}
+	bodyNam, _ := table.AttributeString("elBody")
+	_, _ = w.WriteString(bodyNam.(string) + ".appendChild(" + elNam.(string) + ");\n")
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderTableCell(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {return ast.WalkContinue, nil}
+	n := node.(*east.TableCell)
+	tag := "td"
+	if _, ok := n.Parent().(*east.TableHeader); ok {tag = "th"}
+
+	elNam := r.newElNam(node)
+	node.SetAttributeString("el",elNam)
+	_, _ = w.WriteString("let " + elNam + "=document.createElement('" + tag + "');\n")
+	_, _ = w.WriteString("Object.assign(" + elNam + ".style, mdStyle." + tag + ");\n")
+	if n.Alignment != east.AlignNone {
+		_, _ = w.WriteString(elNam + ".style.textAlign='" + n.Alignment.String() + "';\n")
+	}
+	if node.Attributes() != nil {RenderElAttributes(w, node, GlobalAttributeFilter, elNam)}
+
+	r.renderTextChildren(w, source, node, true)
+
+	if _, err := r.appendToParent(w, node, elNam); err != nil {return ast.WalkStop, err}
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderFootnoteList(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	// div.footnotes > hr + ol; the footnotes are appended to the ol
+	if entering {
+		divNam := r.newElNam(node)
+		hrNam := r.newElNam(node)
+		elNam := r.newElNam(node)
+		node.SetAttributeString("elDiv",divNam)
+		node.SetAttributeString("el",elNam)
+		_, _ = w.WriteString("let " + divNam + "=document.createElement('div');\n")
+		_, _ = w.WriteString(divNam + ".setAttribute('role','doc-endnotes');\n")
+		RenderElAttributesClass(w, node, GlobalAttributeFilter, divNam, "footnotes")
+		_, _ = w.WriteString("let " + hrNam + "=document.createElement('hr');\n")
+		_, _ = w.WriteString(divNam + ".appendChild(" + hrNam + ");\n")
+		_, _ = w.WriteString("let " + elNam + "=document.createElement('ol');\n")
+		_, _ = w.WriteString("Object.assign(" + elNam + ".style, mdStyle.ol);\n")
+		return ast.WalkContinue, nil
+	}
+	divNam, res := node.AttributeString("elDiv")
+	if !res {return r.fail(node, "no div element name")}
+	elNam, res := node.AttributeString("el")
+	if !res {return r.fail(node, "no element name")}
+	_, _ = w.WriteString(divNam.(string) + ".appendChild(" + elNam.(string) + ");\n")
+	return r.appendToParent(w, node, divNam.(string))
+}
+
+func (r *Renderer) renderFootnote(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*east.Footnote)
+	if entering {
+		elNam := r.newElNam(node)
+		node.SetAttributeString("el",elNam)
+		_, _ = w.WriteString("let " + elNam + "=document.createElement('li');\n")
+		_, _ = w.WriteString("Object.assign(" + elNam + ".style, mdStyle.li);\n")
+		_, _ = w.WriteString(elNam + ".id='fn:" + strconv.Itoa(n.Index) + "';\n")
+		if node.Attributes() != nil {RenderElAttributes(w, node, ListItemAttributeFilter, elNam)}
+		return ast.WalkContinue, nil
+	}
+	elNam, res := node.AttributeString("el")
+	if !res {return r.fail(node, "no element name")}
+	return r.appendToParent(w, node, elNam.(string))
+}
+
+// renderFootnoteLink renders <sup id="fnref:n"><a href="#fn:n">n</a></sup>.
+// It is called from renderTextChildren and appends the element to the parent.
+func (r *Renderer) renderFootnoteLink(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {return ast.WalkContinue, nil}
+	n := node.(*east.FootnoteLink)
+	is := strconv.Itoa(n.Index)
+	refId := "fnref"
+	if n.RefIndex > 0 {refId += strconv.Itoa(n.RefIndex)}
+
+	supNam := r.newElNam(node)
+	elNam := r.newElNam(node)
+	node.SetAttributeString("el",supNam)
+	_, _ = w.WriteString("let " + supNam + "=document.createElement('sup');\n")
+	_, _ = w.WriteString(supNam + ".id='" + refId + ":" + is + "';\n")
+	_, _ = w.WriteString("let " + elNam + "=document.createElement('a');\n")
+	_, _ = w.WriteString(elNam + ".href='#fn:" + is + "';\n")
+	_, _ = w.WriteString(elNam + ".className='footnote-ref';\n")
+	_, _ = w.WriteString(elNam + ".setAttribute('role','doc-noteref');\n")
+	_, _ = w.WriteString(elNam + ".textContent='" + is + "';\n")
+	_, _ = w.WriteString(supNam + ".appendChild(" + elNam + ");\n")
+	return r.appendToParent(w, node, supNam)
+}
+
+// renderFootnoteBacklink renders &#160;<a href="#fnref:n">&#x21a9;&#xfe0e;</a>.
+// It is called from renderTextChildren and appends the elements to the parent.
+func (r *Renderer) renderFootnoteBacklink(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {return ast.WalkContinue, nil}
+	n := node.(*east.FootnoteBacklink)
+	refId := "fnref"
+	if n.RefIndex > 0 {refId += strconv.Itoa(n.RefIndex)}
+
+	spNam := r.newElNam(node)
+	elNam := r.newElNam(node)
+	node.SetAttributeString("el",elNam)
+	_, _ = w.WriteString("const " + spNam + "=document.createTextNode('\\u00a0');\n")
+	if _, err := r.appendToParent(w, node, spNam); err != nil {return ast.WalkStop, err}
+	_, _ = w.WriteString("let " + elNam + "=document.createElement('a');\n")
+	_, _ = w.WriteString(elNam + ".href='#" + refId + ":" + strconv.Itoa(n.Index) + "';\n")
+	_, _ = w.WriteString(elNam + ".className='footnote-backref';\n")
+	_, _ = w.WriteString(elNam + ".setAttribute('role','doc-backlink');\n")
+	_, _ = w.WriteString(elNam + ".textContent='\\u21a9\\ufe0e';\n")
+	return r.appendToParent(w, node, elNam)
+}
@@ -1,15 +1,18 @@
 package md2jsV2
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 	"time"
 
 	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
 	"github.com/yuin/goldmark/renderer"
 	"github.com/yuin/goldmark/util"
 
@@ -64,6 +67,22 @@ type Config struct {
 	EastAsianLineBreaks EastAsianLineBreaks
 	XHTML               bool
 	Unsafe              bool
+	// Emitters render the node kinds of extensions.
+	Emitters []Emitter
+	// Figures renders images alone in a paragraph as figures.
+	Figures bool
+	// FigureLabel numbers the figure captions if set.
+	FigureLabel string
+	// LazyImages loads images lazily.
+	LazyImages bool
+	// ImageVariants sets the srcset of images from variant files.
+	ImageVariants bool
+	// Links resolves the destinations of links and autolinks if set.
+	Links *Links
+	// URLPolicy decides which urls links and images keep; nil is DefaultURLPolicy.
+	URLPolicy *URLPolicy
+	// ExternalLinks opens the links to other hosts in a new tab if set.
+	ExternalLinks *ExternalLinks
 }
 
 // NewConfig returns a new Config with defaults.
@@ -90,6 +109,25 @@ func (c *Config) SetOption(name renderer.OptionName, value interface{}) {
 		c.Unsafe = value.(bool)
 	case optTextWriter:
 		c.Writer = value.(Writer)
+	case optEmitters:
+		c.Emitters = append(c.Emitters, value.([]Emitter)...)
+	case optFigures:
+		c.Figures = value.(bool)
+	case optFigureLabel:
+		c.FigureLabel = value.(string)
+	case optLazyImages:
+		c.LazyImages = value.(bool)
+	case optImageVariants:
+		c.ImageVariants = value.(bool)
+	case optLinks:
+		links := value.(Links)
+		c.Links = &links
+	case optURLPolicy:
+		policy := value.(URLPolicy)
+		c.URLPolicy = &policy
+	case optExternalLinks:
+		external := value.(ExternalLinks)
+		c.ExternalLinks = &external
 	}
 }
 
@@ -285,14 +323,63 @@ func WithUnsafe() interface {
 }
 
 // A Renderer struct is an implementation of renderer.NodeRenderer that renders
-// nodes as (X)HTML.
+// nodes as js statements that build the DOM.
+// A Renderer holds no per-document state, so one instance can render
+// several documents concurrently.
 type Renderer struct {
-	count int
 	dbg bool
 	name string
+	// kinds are the node kinds with a render function
+	kinds map[ast.NodeKind]bool
+	// emitters are the emitters of Config.Emitters by kind
+	emitters map[ast.NodeKind]Emitter
 	Config
 }
 
+// A renderContext holds the state of rendering one document.
+// It is attached to the root node of the document as the ctxAttr attribute.
+type renderContext struct {
+	// count numbers the js element variables el<n>
+	count int
+	// source is the markdown source, for the positions of the diagnostics
+	source []byte
+	diags []Diagnostic
+	// dir is the directory of the markdown file (SetSourceDir)
+	dir string
+	// figures counts the numbered figures
+	figures int
+	// unsafe and policy are the url policy of the renderer for the url attributes
+	unsafe bool
+	policy *URLPolicy
+}
+
+var ctxAttr = []byte("md2jsCtx")
+
+// ctx returns the render context of the document that contains node.
+func (r *Renderer) ctx(node ast.Node) *renderContext {
+	return docContext(node)
+}
+
+// docContext returns the render context of the document that contains node.
+// A context is created for a tree that has none yet.
+func docContext(node ast.Node) *renderContext {
+	root := node
+	for root.Parent() != nil {root = root.Parent()}
+	if v, ok := root.Attribute(ctxAttr); ok {
+		if rc, ok := v.(*renderContext); ok {return rc}
+	}
+	rc := &renderContext{count: 1}
+	root.SetAttribute(ctxAttr, rc)
+	return rc
+}
+
+// newElNam returns the next js element variable name of the document that contains node.
+func (r *Renderer) newElNam(node ast.Node) string {
+	rc := r.ctx(node)
+	rc.count++
+	return fmt.Sprintf("el%d", rc.count)
+}
+
 // NewRenderer returns a new Renderer with given options.
 func NewRenderer(nam string, dbg bool, opts ...Option) renderer.NodeRenderer {
 //fmt.Println("dbg -- new renderer")
@@ -310,6 +397,8 @@ func NewRenderer(nam string, dbg bool, opts ...Option) renderer.NodeRenderer {
 
 // RegisterFuncs implements NodeRenderer.RegisterFuncs .
 func (r *Renderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	r.kinds = make(map[ast.NodeKind]bool)
+	reg = kindRegisterer{reg, r.kinds}
 	// blocks
 //fmt.Println("dbg -- reg funcs")
 
@@ -335,6 +424,19 @@ func (r *Renderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 	reg.Register(ast.KindRawHTML, r.renderRawHTML)
 	reg.Register(ast.KindText, r.renderText)
 	reg.Register(ast.KindString, r.renderString)
+
+	// extensions
+
+	reg.Register(east.KindTable, r.renderTable)
+	reg.Register(east.KindTableHeader, r.renderTableHeader)
+	reg.Register(east.KindTableRow, r.renderTableRow)
+	reg.Register(east.KindTableCell, r.renderTableCell)
+	reg.Register(east.KindFootnoteList, r.renderFootnoteList)
+	reg.Register(east.KindFootnote, r.renderFootnote)
+	reg.Register(east.KindFootnoteLink, r.renderFootnoteLink)
+	reg.Register(east.KindFootnoteBacklink, r.renderFootnoteBacklink)
+
+	r.registerEmitters(reg)
 }
 
 func (r *Renderer) writeLines(w util.BufWriter, source []byte, n ast.Node) {
@@ -381,7 +483,11 @@ func (r *Renderer) renderDocument(w util.BufWriter, source []byte, node ast.Node
 	node.SetAttributeString("el","mdDiv")
 	if entering {
 //fmt.Println("dbg -- start render Doc")
-		r.count = 1
+		// a new render of the document starts with a fresh context
+		rc := &renderContext{count: 1, source: source, unsafe: r.Unsafe, policy: r.URLPolicy}
+		if dir, ok := node.AttributeString(string(dirAttr)); ok {rc.dir, _ = dir.(string)}
+		node.SetAttribute(ctxAttr, rc)
+		r.checkKinds(node)
 		docStr := `let mdDivObj = {
 	typ:'div',
 	id: 'mdDiv',
@@ -410,8 +516,7 @@ var HeadingAttributeFilter = GlobalAttributeFilter
 func (r *Renderer) renderHeading(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	n := node.(*ast.Heading)
 	if entering {
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		n.SetAttributeString("el",elNam)
 		hdTyp := fmt.Sprintf("h%d",n.Level)
 		hdStr := "let " + elNam + "= document.createElement('" + hdTyp + "');\n"
@@ -422,11 +527,11 @@ func (r *Renderer) renderHeading(w util.BufWriter, source []byte, node ast.Node,
 		if n.Attributes() != nil {RenderElAttributes(w, n, HeadingAttributeFilter, elNam)}
 	} else {
 		pnode := n.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := n.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Heading: no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Heading: no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -446,8 +551,7 @@ var BlockquoteAttributeFilter = GlobalAttributeFilter.Extend(
 func (r *Renderer) renderBlockquote(
 	w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 		pStr := "let " + elNam + "= document.createElement('blockquote');\n"
 		_, _ = w.WriteString(pStr)
@@ -459,11 +563,11 @@ func (r *Renderer) renderBlockquote(
 		}
 	} else {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("no parent el name!")}
+		if !res {return r.fail(node, "parent has no element")}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -477,13 +581,11 @@ func (r *Renderer) renderBlockquote(
 
 func (r *Renderer) renderCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 		elStr := "let " + elNam + "= document.createElement('pre');\n"
 		_, _ = w.WriteString(elStr)
-		r.count++
-		el2Nam := fmt.Sprintf("el%d",r.count)
+		el2Nam := r.newElNam(node)
 		el2Str := "let " + el2Nam + "= document.createElement('code');\n"
 		_, _ = w.WriteString(el2Str)
 
@@ -494,11 +596,8 @@ func (r *Renderer) renderCodeBlock(w util.BufWriter, source []byte, node ast.Nod
 //		r.Writer.RawWrite(w, line.Value(source))
 			data += string(line.Value(source))
 		}
-		el5Str := "const codeStr=`" + data + "`\n;"
-		_, _ = w.WriteString(el5Str)
-		r.count++
-		el3Nam := fmt.Sprintf("el%d",r.count)
-		el4Str := "const " + el3Nam + "= document.createTextNode(codeStr);\n"
+		el3Nam := r.newElNam(node)
+		el4Str := "const " + el3Nam + "= document.createTextNode(`" + jsTemplate([]byte(data)) + "`);\n"
 		_, _ = w.WriteString(el4Str)
 		el6Str := el2Nam + ".appendChild(" + el3Nam + ");\n";
 		_, _ = w.WriteString(el6Str)
@@ -507,11 +606,11 @@ func (r *Renderer) renderCodeBlock(w util.BufWriter, source []byte, node ast.Nod
 //		r.writeLines(w, source, node)
 	} else {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Code: no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Code no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -523,25 +622,27 @@ func (r *Renderer) renderCodeBlock(w util.BufWriter, source []byte, node ast.Nod
 	return ast.WalkContinue, nil
 }
 
+// FencedCodeAttributeFilter defines attribute names which the pre elements of fenced code can have.
+var FencedCodeAttributeFilter = GlobalAttributeFilter
+
 //yyy
 func (r *Renderer) renderFencedCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	n := node.(*ast.FencedCodeBlock)
 	if entering {
 //		_, _ = w.WriteString("<pre><code")
 
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 		elStr := "let " + elNam + "= document.createElement('pre');\n"
 		_, _ = w.WriteString(elStr)
-		r.count++
-		el2Nam := fmt.Sprintf("el%d",r.count)
+		if n.Attributes() != nil {RenderElAttributes(w, n, FencedCodeAttributeFilter, elNam)}
+		el2Nam := r.newElNam(node)
 		el2Str := "let " + el2Nam + "= document.createElement('code');\n"
 		_, _ = w.WriteString(el2Str)
 
 		language := n.Language(source)
 		if language != nil {
-			classStr := el2Nam + ".class=\"language-" + string(language) + "\";\n"
+			classStr := el2Nam + ".className=" + jsQuote(append([]byte("language-"), language...)) + ";\n"
 			_, _ = w.WriteString(classStr)
 		}
 /*
@@ -556,11 +657,8 @@ func (r *Renderer) renderFencedCodeBlock(w util.BufWriter, source []byte, node a
 			line := node.Lines().At(i)
 			data += ">" + string(line.Value(source))
 		}
-		el5Str := "const codeStr=`" + data + "`\n;"
-		_, _ = w.WriteString(el5Str)
-		r.count++
-		el3Nam := fmt.Sprintf("el%d",r.count)
-		el4Str := "const " + el3Nam + "= document.createTextNode(codeStr);\n"
+		el3Nam := r.newElNam(node)
+		el4Str := "const " + el3Nam + "= document.createTextNode(`" + jsTemplate([]byte(data)) + "`);\n"
 		_, _ = w.WriteString(el4Str)
 		el6Str := el2Nam + ".appendChild(" + el3Nam + ");\n";
 		_, _ = w.WriteString(el6Str)
@@ -570,11 +668,11 @@ func (r *Renderer) renderFencedCodeBlock(w util.BufWriter, source []byte, node a
 
 	} else {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Fenced Code -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Fenced Code -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
 		_, _ = w.WriteString(dbgStr)
@@ -588,7 +686,7 @@ func (r *Renderer) renderHTMLBlock(
 	w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	n := node.(*ast.HTMLBlock)
 	if entering {
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 		elStr := "let " + elNam + "= document.createElement('div');\n"
 		_, _ = w.WriteString(elStr)
@@ -600,29 +698,30 @@ func (r *Renderer) renderHTMLBlock(
 //				r.Writer.SecureWrite(w, line.Value(source))
 				dataStr += string(line.Value(source))
 			}
-			closure := n.ClosureLine
-			dataStr += string(closure.Value(source))
-			dat2Str := "let data='" + dataStr +"'"
-			el2Str := elNam + ".innerhtml=" + dat2Str
+			if n.HasClosure() {
+				closure := n.ClosureLine
+				dataStr += string(closure.Value(source))
+			}
+			el2Str := elNam + ".innerHTML=`" + jsTemplate([]byte(dataStr)) + "`;\n"
 			_, _ = w.WriteString(el2Str)
 		} else {
+			addDiag(node, SevInfo, "raw html omitted")
 			_, _ = w.WriteString("//<!-- raw HTML omitted -->\n")
 		}
 	} else {
-		if n.HasClosure() {
-			pnode := node.Parent()
-			if pnode == nil {return ast.WalkStop, fmt.Errorf("heml Block -- no pnode")}
-			elNam, res := node.AttributeString("el")
-			if !res {return ast.WalkStop, fmt.Errorf("html block -- no el name!")}
-			parElNam, res := pnode.AttributeString("el")
-			if !res {return ast.WalkStop, fmt.Errorf("html block -- no parent el name: %s!", elNam)}
-			if r.dbg {
-				dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
-				_, _ = w.WriteString(dbgStr)
-			}
-			hdStr := parElNam.(string) + ".appendChild(" + elNam.(string) + ");\n"
-			_, _ = w.WriteString(hdStr)
+		// the element holds the closure line as well, so it is appended with or without one
+		pnode := node.Parent()
+		if pnode == nil {return r.fail(node, "no parent node")}
+		elNam, res := node.AttributeString("el")
+		if !res {return r.fail(node, "no element name")}
+		parElNam, res := pnode.AttributeString("el")
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
+		if r.dbg {
+			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
+			_, _ = w.WriteString(dbgStr)
 		}
+		hdStr := parElNam.(string) + ".appendChild(" + elNam.(string) + ");\n"
+		_, _ = w.WriteString(hdStr)
 	}
 	return ast.WalkContinue, nil
 }
@@ -641,8 +740,7 @@ func (r *Renderer) renderList(w util.BufWriter, source []byte, node ast.Node, en
 		tag = "ol"
 	}
 	if entering {
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 		elStr := "let " + elNam + "= document.createElement('" + tag + "');\n"
 		_, _ = w.WriteString(elStr)
@@ -663,11 +761,11 @@ func (r *Renderer) renderList(w util.BufWriter, source []byte, node ast.Node, en
 		}
 	} else {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("List -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("List -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("List -- no parent el name %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -686,8 +784,7 @@ var ListItemAttributeFilter = GlobalAttributeFilter.Extend(
 
 func (r *Renderer) renderListItem(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 		elStr := "let " + elNam + "= document.createElement('li');\n"
 		_,_ = w.WriteString(elStr)
@@ -701,11 +798,11 @@ func (r *Renderer) renderListItem(w util.BufWriter, source []byte, node ast.Node
 	} else {
 
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("List Item -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("List Item -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("List Item -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
 			_, _ = w.WriteString(dbgStr)
@@ -744,9 +841,9 @@ func (r *Renderer) renderListItem(w util.BufWriter, source []byte, node ast.Node
 			r.renderTextChildren(w,source,fc, true)
 
 			pnode := node.Parent()
-			if pnode == nil {return ast.WalkStop, fmt.Errorf("Li Item -- no pnode")}
+			if pnode == nil {return r.fail(node, "no parent node")}
 			parElNam, res := pnode.AttributeString("el")
-			if !res {return ast.WalkStop, fmt.Errorf("Li Item -- no parent el name: %s!", elNam)}
+			if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 			apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
 			_, _ = w.WriteString(apStr)
 			return ast.WalkSkipChildren, nil
@@ -764,8 +861,18 @@ var ParagraphAttributeFilter = GlobalAttributeFilter
 
 func (r *Renderer) renderParagraph(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		if r.Figures && figureParagraph(node) {
+			// the figure of the image takes the place of the paragraph
+			pnode := node.Parent()
+			if pnode == nil {return r.fail(node, "no parent node")}
+			parElNam, res := pnode.AttributeString("el")
+			if !res {return r.fail(node, "parent has no element, figure not appended")}
+			node.SetAttributeString("el", parElNam.(string))
+			if _, err := r.renderImage(w, source, node.FirstChild(), true); err != nil {return ast.WalkStop, err}
+			if _, err := r.renderImage(w, source, node.FirstChild(), false); err != nil {return ast.WalkStop, err}
+			return ast.WalkSkipChildren, nil
+		}
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 
 		pStr:= "let " + elNam + "=document.createElement('p');\n"
@@ -780,9 +887,9 @@ func (r *Renderer) renderParagraph(w util.BufWriter, source []byte, node ast.Nod
 		r.renderTextChildren(w,source,node, true)
 
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("Par -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Par -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- par el: %s kind: %s parent:%s kind:%s\n", elNam, node.Kind().String(), parElNam, pnode.Kind().String())
 			_, _ = w.WriteString(dbgStr)
@@ -805,7 +912,7 @@ func (r *Renderer) renderTextChildren(w util.BufWriter, source []byte, node ast.
 
 	var text []byte
 	parElNam, res := node.AttributeString("el")
-	if !res {return ast.WalkStop, fmt.Errorf("txt -- no el name!")}
+	if !res {return r.fail(node, "no element name")}
 
 	if r.dbg {
 		dbgStr := fmt.Sprintf("// dbg -- pelNam: %s children: %d\n", parElNam, node.ChildCount())
@@ -820,10 +927,9 @@ func (r *Renderer) renderTextChildren(w util.BufWriter, source []byte, node ast.
 			return ast.WalkSkipChildren, nil
 		}
 		if _,ok :=fc.(*ast.Text); ok {
-        	segment := fc.(*ast.Text).Segment
-        	value := segment.Value(source)
+        	value := textValue(fc.(*ast.Text), source)
 
-			elTxtStr := parElNam.(string) + ".textContent=`" + string(value) + "`;\n"
+			elTxtStr := parElNam.(string) + ".textContent=`" + jsTemplate(value) + "`;\n"
 			_, _ = w.WriteString(elTxtStr)
 			return ast.WalkSkipChildren, nil
 		}
@@ -839,11 +945,26 @@ func (r *Renderer) renderTextChildren(w util.BufWriter, source []byte, node ast.
 				_, _ = w.WriteString(dbgStr)
 			}
 */
+		// emitters take precedence over the cases below
+		if e, ok := r.emitters[c.Kind()]; ok {
+			if istate == 1 {
+				istate = 0
+				elNam := r.newElNam(node)
+				txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
+				_, _ = w.WriteString(txtEl)
+				apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
+				_, _ = w.WriteString(apStr)
+				text = nil
+			}
+			r.emit(e, w, source, c, true)
+			r.emit(e, w, source, c, false)
+			continue
+		}
+
 		switch c.(type) {
 
 		case *ast.Text:
-        	segment := c.(*ast.Text).Segment
-        	value := segment.Value(source)
+        	value := textValue(c.(*ast.Text), source)
 
 //			if r.dbg {
 //				dbgStr := fmt.Sprintf("//dbg -- text (state: %d) val: %s\n", istate, value)
@@ -865,9 +986,6 @@ func (r *Renderer) renderTextChildren(w util.BufWriter, source []byte, node ast.
 
        case *ast.Emphasis:
 			en :=c.(*ast.Emphasis)
-			chn := en.FirstChild()
-        	segment := chn.(*ast.Text).Segment
-        	value := segment.Value(source)
 			tag := "em"
 			if en.Level == 2 {tag = "strong"}
 //			if r.dbg {
@@ -880,23 +998,23 @@ func (r *Renderer) renderTextChildren(w util.BufWriter, source []byte, node ast.
 			// end text
 			case 1:
 				istate = 0
-				r.count++
-				elNam := fmt.Sprintf("el%d",r.count)
+				elNam := r.newElNam(node)
 				c.SetAttributeString("el",elNam)
 
-				txtEl := "const " + elNam + "=document.createTextNode(`"+string(text)+"`);\n"
+				txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
 				_, _ = w.WriteString(txtEl)
 				apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
 				_, _ = w.WriteString(apStr)
 				text = nil
 				fallthrough
 			default:
-				r.count++
-				elNam := fmt.Sprintf("el%d",r.count)
+				elNam := r.newElNam(node)
 				elStr := "let " + elNam + "=document.createElement('" + tag + "');\n"
 				_, _ = w.WriteString(elStr)
-				eltxt := elNam + ".textContent=`" + string(value) + "`;\n"
-				_, _ = w.WriteString(eltxt)
+				if en.Attributes() != nil {RenderElAttributes(w, en, EmphasisAttributeFilter, elNam)}
+				// the children may be nested emphasis, code spans or links
+				en.SetAttributeString("el",elNam)
+				r.renderTextChildren(w,source,en, true)
 				apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
 				_, _ = w.WriteString(apStr)
 			}
@@ -905,9 +1023,8 @@ func (r *Renderer) renderTextChildren(w util.BufWriter, source []byte, node ast.
 
 			if istate == 1 {
 				istate = 0
-				r.count++
-				elNam := fmt.Sprintf("el%d",r.count)
-				txtEl := "const " + elNam + "=document.createTextNode(`"+string(text)+"`);\n"
+				elNam := r.newElNam(node)
+				txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
 				_, _ = w.WriteString(txtEl)
 				apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
 				_, _ = w.WriteString(apStr)
@@ -919,25 +1036,24 @@ func (r *Renderer) renderTextChildren(w util.BufWriter, source []byte, node ast.
 		case *ast.Image:
 			if istate == 1 {
 				istate = 0
-				r.count++
-				elNam := fmt.Sprintf("el%d",r.count)
-				txtEl := "const " + elNam + "=document.createTextNode(`"+string(text)+"`);\n"
+				elNam := r.newElNam(node)
+				txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
 				_, _ = w.WriteString(txtEl)
 				apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
 				_, _ = w.WriteString(apStr)
 				text = nil
 			}
 
-			r.renderImage(w,source,c.(*ast.CodeSpan), true)
+			r.renderImage(w,source,c, true)
+			r.renderImage(w,source,c, false)
 
 
 		case *ast.Link:
 
 			if istate == 1 {
 				istate = 0
-				r.count++
-				elNam := fmt.Sprintf("el%d",r.count)
-				txtEl := "const " + elNam + "=document.createTextNode(`"+string(text)+"`);\n"
+				elNam := r.newElNam(node)
+				txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
 				_, _ = w.WriteString(txtEl)
 				apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
 				_, _ = w.WriteString(apStr)
@@ -946,26 +1062,39 @@ func (r *Renderer) renderTextChildren(w util.BufWriter, source []byte, node ast.
 
 			r.renderLink(w,source,c.(*ast.Link), true)
 
+		case *ast.AutoLink:
+			if istate == 1 {
+				istate = 0
+				elNam := r.newElNam(node)
+				txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
+				_, _ = w.WriteString(txtEl)
+				apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
+				_, _ = w.WriteString(apStr)
+				text = nil
+			}
+
+			r.renderAutoLink(w,source,c, true)
+			r.renderAutoLink(w,source,c, false)
+
 		case *ast.RawHTML:
 			if istate == 1 {
 				istate = 0
-				r.count++
-				elNam := fmt.Sprintf("el%d",r.count)
-				txtEl := "const " + elNam + "=document.createTextNode(`"+string(text)+"`);\n"
+				elNam := r.newElNam(node)
+				txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
 				_, _ = w.WriteString(txtEl)
 				apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
 				_, _ = w.WriteString(apStr)
 				text = nil
 			}
 
-			r.renderRawHTML(w,source,c.(*ast.RawHTML), true)
+			r.renderRawHTML(w,source,c, true)
+			r.renderRawHTML(w,source,c, false)
 
 		case *ast.String:
 			if istate == 1 {
 				istate = 0
-				r.count++
-				elNam := fmt.Sprintf("el%d",r.count)
-				txtEl := "const " + elNam + "=document.createTextNode(`"+string(text)+"`);\n"
+				elNam := r.newElNam(node)
+				txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
 				_, _ = w.WriteString(txtEl)
 				apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
 				_, _ = w.WriteString(apStr)
@@ -974,7 +1103,26 @@ func (r *Renderer) renderTextChildren(w util.BufWriter, source []byte, node ast.
 
 			r.renderString(w,source,c.(*ast.String), true)
 
+		case *east.FootnoteLink, *east.FootnoteBacklink:
+			if istate == 1 {
+				istate = 0
+				elNam := r.newElNam(node)
+				txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
+				_, _ = w.WriteString(txtEl)
+				apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
+				_, _ = w.WriteString(apStr)
+				text = nil
+			}
+
+			if c.Kind() == east.KindFootnoteLink {
+				r.renderFootnoteLink(w,source,c, true)
+			} else {
+				r.renderFootnoteBacklink(w,source,c, true)
+			}
+
 		default:
+			// unregistered kinds are reported by checkKinds
+			if r.kinds[c.Kind()] {addDiag(c, SevWarning, "node not rendered in text")}
 			if r.dbg {
 				dbgStr := fmt.Sprintf("//dbg -- other type: %s\n", c.Kind().String())
 				_, _ = w.WriteString(dbgStr)
@@ -985,11 +1133,10 @@ func (r *Renderer) renderTextChildren(w util.BufWriter, source []byte, node ast.
 	}
 
 	if text != nil {
-			r.count++
-			elNam := fmt.Sprintf("el%d",r.count)
-			node.SetAttributeString("el",elNam)
+			// the text node: the element name of the node is kept for emitters
+			elNam := r.newElNam(node)
 
-			txtEl := "const " + elNam + "=document.createTextNode(`"+string(text)+"`);\n"
+			txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
 			_, _ = w.WriteString(txtEl)
 			apStr := parElNam.(string) + ".appendChild(" + elNam + ");\n"
 			_, _ = w.WriteString(apStr)
@@ -1005,8 +1152,7 @@ func (r *Renderer) renderTextBlock1(w util.BufWriter, source []byte, node ast.No
 
 	if entering {
 		text := make([]byte,0,1024)
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 
 		for c := node.FirstChild(); c != nil; c = c.NextSibling() {
@@ -1016,7 +1162,7 @@ func (r *Renderer) renderTextBlock1(w util.BufWriter, source []byte, node ast.No
 			segment := c.(*ast.Text).Segment
 			value := segment.Value(source)
 			if r.dbg {
-				valStr := fmt.Sprintf("//dbg -- child[%d]: %s\n", len(value),string(value))
+				valStr := fmt.Sprintf("//dbg -- child[%d]: %q\n", len(value),string(value))
 				_, _ = w.WriteString(valStr)
 			}
 // ! softline break
@@ -1029,16 +1175,16 @@ func (r *Renderer) renderTextBlock1(w util.BufWriter, source []byte, node ast.No
 
 		text[len(text) -1] = '\n'
 
-		txtEl := "const " + elNam + "=document.createTextNode(`"+string(text)+"`);\n"
+		txtEl := "const " + elNam + "=document.createTextNode(`" + jsTemplate(text) + "`);\n"
 			_, _ = w.WriteString(txtEl)
 
 	} else {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("txt -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("txt -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("txt -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -1055,7 +1201,7 @@ func (r *Renderer) renderTextBlock(w util.BufWriter, source []byte, node ast.Nod
 	if entering {
 		pnode := node.Parent()
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("txtBlock -- no parent el name: %s!", parElNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", parElNam)}
 		node.SetAttributeString("el",parElNam)
 		// render child nodes
 		r.renderTextChildren(w,source,node, true)
@@ -1068,8 +1214,7 @@ func (r *Renderer) renderTextBlock2(w util.BufWriter, source []byte, node ast.No
 	// temp
 	if entering {
 //fmt.Printf("dbg -- textBlock entering \n")
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 		elStr:= "let " + elNam + "=document.createElement('div');\n"
 		node.SetAttributeString("el",elNam)
@@ -1078,8 +1223,7 @@ func (r *Renderer) renderTextBlock2(w util.BufWriter, source []byte, node ast.No
 		if node.NextSibling() != nil && node.FirstChild() != nil {
 //fmt.Printf("dbg -- need to add newline \n")
 //add textnode	_ = w.WriteByte('\n')
-			r.count++
-			elNamtxt := fmt.Sprintf("el%d",r.count)
+			elNamtxt := r.newElNam(node)
 			node.SetAttributeString("el",elNam)
 			txtStr := "const "+elNamtxt+ "=document.createTextNode('\n');\n"
 			_, _ = w.WriteString(txtStr)
@@ -1090,11 +1234,11 @@ func (r *Renderer) renderTextBlock2(w util.BufWriter, source []byte, node ast.No
 	if !entering {
 //fmt.Printf("dbg -- textBlock exiting \n")
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("Par -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Par -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Par -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -1120,11 +1264,11 @@ func (r *Renderer) renderThematicBreak(w util.BufWriter, source []byte, node ast
 	//<hr>
 	if !entering {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("HR -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("HR -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("HR -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
 			_, _ = w.WriteString(dbgStr)
@@ -1134,8 +1278,7 @@ func (r *Renderer) renderThematicBreak(w util.BufWriter, source []byte, node ast
 		return ast.WalkContinue, nil
 	}
 	// entering
-	r.count++
-	elNam := fmt.Sprintf("el%d",r.count)
+	elNam := r.newElNam(node)
 	elStr:= "let " + elNam + "=document.createElement('hr');\n"
 	node.SetAttributeString("el",elNam)
 	_, _ = w.WriteString(elStr)
@@ -1149,6 +1292,7 @@ func (r *Renderer) renderThematicBreak(w util.BufWriter, source []byte, node ast
 // LinkAttributeFilter defines attribute names which link elements can have.
 var LinkAttributeFilter = GlobalAttributeFilter.Extend(
 	[]byte("download"),
+	[]byte("external"),
 	// []byte("href"),
 	[]byte("hreflang"),
 	[]byte("media"),
@@ -1164,11 +1308,11 @@ func (r *Renderer) renderAutoLink(
 	n := node.(*ast.AutoLink)
 	if !entering {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("Auto Link -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Auto Link -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Auto Link -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -1180,21 +1324,26 @@ func (r *Renderer) renderAutoLink(
 	}
 
 // <a href="
-	r.count++
-	elNam := fmt.Sprintf("el%d",r.count)
+	elNam := r.newElNam(node)
 	elStr:= "let " + elNam + "=document.createElement('a');\n"
 	node.SetAttributeString("el",elNam)
 	_, _ = w.WriteString(elStr)
-	el2Str:= elNam + ".href=\""
-	_, _ = w.WriteString(el2Str)
-
 	url := n.URL(source)
-//	label := n.Label(source)
+	var href []byte
 	if n.AutoLinkType == ast.AutoLinkEmail && !bytes.HasPrefix(bytes.ToLower(url), []byte("mailto:")) {
-		_, _ = w.WriteString("mailto:")
+		href = append(href, "mailto:"...)
+	}
+	if n.AutoLinkType == ast.AutoLinkURL {url = r.resolveLink(w, url, elNam)}
+	href = append(href, url...)
+	external := false
+	if r.keepURL(node, href) {
+		el2Str:= elNam + ".href=" + jsQuote(util.URLEscape(href, false)) + ";\n"
+		_, _ = w.WriteString(el2Str)
+		external = r.externalLink(w, node, href, elNam)
 	}
-	_, _ = w.Write(util.EscapeHTML(util.URLEscape(url, false)))
-	_, _ = w.WriteString("\"\n;")
+	_, _ = w.WriteString(elNam + ".textContent=" + jsQuote(n.Label(source)) + ";\n")
+	_, _ = w.WriteString("Object.assign(" + elNam + ".style, mdStyle.a);\n")
+	if external {r.renderMarker(w, node, elNam)}
 
 	if n.Attributes() != nil {
 		RenderElAttributes(w, n, LinkAttributeFilter, elNam)
@@ -1208,8 +1357,7 @@ var CodeAttributeFilter = GlobalAttributeFilter
 func (r *Renderer) renderCodeSpan(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 // needs rework
 	if entering {
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 		pStr:= "let " + elNam + "=document.createElement(\"code\");\n"
 		_, _ = w.WriteString(pStr)
@@ -1228,7 +1376,7 @@ func (r *Renderer) renderCodeSpan(w util.BufWriter, source []byte, node ast.Node
 			value := segment.Value(source)
 			txtStr := string(value)
 			if r.dbg {
-				valStr := fmt.Sprintf("//dbg -- child[%d]: %s\n", len(value),string(value))
+				valStr := fmt.Sprintf("//dbg -- child[%d]: %q\n", len(value),string(value))
 				_, _ = w.WriteString(valStr)
 			}
 			if bytes.HasSuffix(value, []byte("\n")) {
@@ -1237,16 +1385,16 @@ func (r *Renderer) renderCodeSpan(w util.BufWriter, source []byte, node ast.Node
 				txtStr = string(value[:len(value)-1]) + " "
 			}
 			spanTxtEl := fmt.Sprintf("%sSpan%d",elNam, spanCount)
-			txtEl := "const " + spanTxtEl + "=document.createTextNode('"+txtStr+"');\n"
+			txtEl := "const " + spanTxtEl + "=document.createTextNode(" + jsQuote([]byte(txtStr)) + ");\n"
 			_, _ = w.WriteString(txtEl)
 			elStr := elNam + ".appendChild("+spanTxtEl +");\n"
 			_, _ = w.WriteString(elStr)
 		}
 
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("Par -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Par -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- codespan el: %s kind: %s parent:%s kind:%s\n", elNam, node.Kind().String(), parElNam, pnode.Kind().String())
 			_, _ = w.WriteString(dbgStr)
@@ -1268,8 +1416,7 @@ func (r *Renderer) renderEmphasis(w util.BufWriter, source []byte, node ast.Node
 		tag = "strong"
 	}
 	if entering {
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		node.SetAttributeString("el",elNam)
 		elStr:= "let " + elNam + "=document.createElement('"+tag+"');\n"
 		node.SetAttributeString("el",elNam)
@@ -1278,19 +1425,18 @@ func (r *Renderer) renderEmphasis(w util.BufWriter, source []byte, node ast.Node
 		// child
 		chn := n.FirstChild()
 		if _, ok := chn.(*ast.Text); ok {
-	        segment := chn.(*ast.Text).Segment
-    	    value := segment.Value(source)
-			chStr := elNam + ".textContent=`" + string(value) + "`\n";
+    	    value := textValue(chn.(*ast.Text), source)
+			chStr := elNam + ".textContent=`" + jsTemplate(value) + "`\n";
 			_, _ = w.WriteString(chStr)
 		}
 		return ast.WalkSkipChildren, nil
 	}
 	pnode := node.Parent()
-	if pnode == nil {return ast.WalkStop, fmt.Errorf("Emphasis -- no pnode")}
+	if pnode == nil {return r.fail(node, "no parent node")}
 	elNam, res := node.AttributeString("el")
-	if !res {return ast.WalkStop, fmt.Errorf("Emphasis --no el name!")}
+	if !res {return r.fail(node, "no element name")}
 	parElNam, res := pnode.AttributeString("el")
-	if !res {return ast.WalkStop, fmt.Errorf("Emphasis -- no parent el name: %s!", elNam)}
+	if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 	if r.dbg {
 		dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
 		_, _ = w.WriteString(dbgStr)
@@ -1304,18 +1450,20 @@ func (r *Renderer) renderEmphasis(w util.BufWriter, source []byte, node ast.Node
 func (r *Renderer) renderLink(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	n := node.(*ast.Link)
 	if entering {
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		elStr:= "let " + elNam + "=document.createElement(\"a\");\n"
 		node.SetAttributeString("el",elNam)
 		_, _ = w.WriteString(elStr)
-		if r.Unsafe || !IsDangerousURL(n.Destination) {
+		dest := r.resolveLink(w, n.Destination, elNam)
+		external := false
+		if r.keepURL(node, dest) {
 //			_, _ = w.Write(util.EscapeHTML(util.URLEscape(n.Destination, true)))
-			el2Str:= elNam + ".href='" + string(util.EscapeHTML(util.URLEscape(n.Destination, true))) +"';\n"
+			el2Str:= elNam + ".href=" + jsQuote(util.URLEscape(dest, true)) + ";\n"
 			_, _ = w.WriteString(el2Str)
+			external = r.externalLink(w, node, dest, elNam)
 		}
 		if n.Title != nil {
-			el4Str := elNam + ".title='" + string(n.Title) + "';\n"
+			el4Str := elNam + ".title=" + jsQuote(n.Title) + ";\n"
 			_,_ = w.WriteString(el4Str)
 		}
 		if n.Attributes() != nil {
@@ -1329,18 +1477,18 @@ func (r *Renderer) renderLink(w util.BufWriter, source []byte, node ast.Node, en
 		fc := n.FirstChild()
 		if fc != nil {
 			if _, ok := fc.(*ast.Text); ok {
-        		segment := fc.(*ast.Text).Segment
-        		value := segment.Value(source)
-				elTxtStr := elNam + ".textContent=`" + string(value) + "`;\n"
+        		value := textValue(fc.(*ast.Text), source)
+				elTxtStr := elNam + ".textContent=`" + jsTemplate(value) + "`;\n"
 				_, _ = w.WriteString(elTxtStr)
 //				_,_ = w.WriteString(elNam + ".textContent='\n';\n")
 			}
 		}
+		if external {r.renderMarker(w, node, elNam)}
 //		_, _ = w.WriteString("</a>")
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("Link -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Link -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
 			_, _ = w.WriteString(dbgStr)
@@ -1358,6 +1506,7 @@ var ImageAttributeFilter = GlobalAttributeFilter.Extend(
 	[]byte("border"),
 	[]byte("crossorigin"),
 	[]byte("decoding"),
+	[]byte("float"),
 	[]byte("height"),
 	[]byte("importance"),
 	[]byte("intrinsicsize"),
@@ -1373,11 +1522,11 @@ var ImageAttributeFilter = GlobalAttributeFilter.Extend(
 func (r *Renderer) renderImage(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if !entering {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("Img -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Img -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Img -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -1389,27 +1538,27 @@ func (r *Renderer) renderImage(w util.BufWriter, source []byte, node ast.Node, e
 		return ast.WalkContinue, nil
 	}
 	n := node.(*ast.Image)
-	r.count++
-	elNam := fmt.Sprintf("el%d",r.count)
+	figNam := ""
+	if r.isFigure(n) {figNam = r.renderFigure(w, n)}
+	elNam := r.newElNam(node)
 	elStr:= "let " + elNam + "=document.createElement('img');\n"
 	node.SetAttributeString("el",elNam)
 	_, _ = w.WriteString(elStr)
 	// need to add source
 //	_, _ = w.WriteString("<img src=\"")
-	if r.Unsafe || !IsDangerousURL(n.Destination) {
-		el2Str:= elNam + ".src=" + string(util.EscapeHTML(util.URLEscape(n.Destination, true)))
+	if r.keepURL(node, n.Destination) {
+		el2Str:= elNam + ".src=" + jsQuote(util.URLEscape(n.Destination, true)) + ";\n"
 //		_, _ = w.Write(util.EscapeHTML(util.URLEscape(n.Destination, true)))
 		_, _ = w.WriteString(el2Str)
 	}
-	el3Str := elNam + ".alt=\""
+	el3Str := elNam + ".alt=" + jsQuote(nodeTexts(source, n)) + ";\n"
 	_, _ = w.WriteString(el3Str)
-	r.renderTexts(w, source, n)
-	_, _ = w.WriteString("\"\n")
 
 
-	if n.Title != nil {
+	// the title of a figure is its caption
+	if n.Title != nil && len(figNam) == 0 {
 //		_, _ = w.WriteString(` title="`)
-		el4Str := elNam + ".title='" + string(n.Title) + "'\n"
+		el4Str := elNam + ".title=" + jsQuote(n.Title) + ";\n"
 //		r.Writer.Write(w, n.Title)
 		_,_ = w.WriteString(el4Str)
 	}
@@ -1417,6 +1566,11 @@ func (r *Renderer) renderImage(w util.BufWriter, source []byte, node ast.Node, e
 	if n.Attributes() != nil {
 		RenderElAttributes(w, n, ImageAttributeFilter, elNam)
 	}
+	r.renderImageDefaults(w, n, elNam)
+	if len(figNam) > 0 {
+		r.renderCaption(w, source, n, figNam, elNam)
+		node.SetAttributeString("el", figNam)
+	}
 	return ast.WalkSkipChildren, nil
 }
 
@@ -1424,11 +1578,11 @@ func (r *Renderer) renderRawHTML(
 	w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if !entering {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("RawHtml -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("RawHtml -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("RawHtml -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -1438,8 +1592,7 @@ func (r *Renderer) renderRawHTML(
 		_, _ = w.WriteString(elStr)
 		return ast.WalkSkipChildren, nil
 	}
-		r.count++
-		elNam := fmt.Sprintf("el%d",r.count)
+		elNam := r.newElNam(node)
 		elStr:= "let " + elNam + "=document.createElement('div');\n"
 		node.SetAttributeString("el",elNam)
 		_, _ = w.WriteString(elStr)
@@ -1447,30 +1600,30 @@ func (r *Renderer) renderRawHTML(
 	if r.Unsafe {
 		n := node.(*ast.RawHTML)
 		l := n.Segments.Len()
-		//elnam.innerhtml =
-		el2Str := elNam + ".innerhtml = `\n"
-		_, _ = w.WriteString(el2Str)
+		var data []byte
 		for i := 0; i < l; i++ {
 			segment := n.Segments.At(i)
-			_, _ = w.Write(segment.Value(source))
+			data = append(data, segment.Value(source)...)
 		}
-		_, _ = w.WriteString("`\n")
+		el2Str := elNam + ".innerHTML=`" + jsTemplate(data) + "`;\n"
+		_, _ = w.WriteString(el2Str)
 
 		return ast.WalkSkipChildren, nil
 	}
-	_, _ = w.WriteString("<!-- raw HTML omitted -->")
+	addDiag(node, SevInfo, "raw html omitted")
+	_, _ = w.WriteString("//<!-- raw HTML omitted -->\n")
 	return ast.WalkSkipChildren, nil
 }
 
 func (r *Renderer) renderText(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if !entering {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("Text -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
 
-		if !res {return ast.WalkStop, fmt.Errorf("Text -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("Text -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -1483,12 +1636,11 @@ func (r *Renderer) renderText(w util.BufWriter, source []byte, node ast.Node, en
 	}
 	n := node.(*ast.Text)
 	segment := n.Segment
-	r.count++
-	elNam := fmt.Sprintf("el%d",r.count)
+	elNam := r.newElNam(node)
 	n.SetAttributeString("el",elNam)
 
 	value := segment.Value(source)
-	valStr := string(segment.Value(source))
+	valStr := string(textValue(n, source))
 
 	if n.IsRaw() {
 //		_, _ = w.WriteString(segment.Value(source))
@@ -1521,7 +1673,7 @@ func (r *Renderer) renderText(w util.BufWriter, source []byte, node ast.Node, en
 	}
 // fmt.Printf("text el %s: %s\n",elNam, valStr)
 	DatEl := elNam + "Txt"
-	datStr := "const " + DatEl + "= `" + valStr + "`;\n"
+	datStr := "const " + DatEl + "= `" + jsTemplate([]byte(valStr)) + "`;\n"
 	_, _ = w.WriteString(datStr)
 	txtStr := "const "+elNam+ "=document.createTextNode(" + DatEl + ");\n"
 	_, _ = w.WriteString(txtStr)
@@ -1532,11 +1684,11 @@ func (r *Renderer) renderText(w util.BufWriter, source []byte, node ast.Node, en
 func (r *Renderer) renderString(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if !entering {
 		pnode := node.Parent()
-		if pnode == nil {return ast.WalkStop, fmt.Errorf("String -- no pnode")}
+		if pnode == nil {return r.fail(node, "no parent node")}
 		elNam, res := node.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("String -- no el name!")}
+		if !res {return r.fail(node, "no element name")}
 		parElNam, res := pnode.AttributeString("el")
-		if !res {return ast.WalkStop, fmt.Errorf("String -- no parent el name: %s!", elNam)}
+		if !res {return r.fail(node, "parent has no element, %s not appended", elNam)}
 
 		if r.dbg {
 			dbgStr := fmt.Sprintf("// dbg -- el: %s parent:%s kind:%s\n", elNam, parElNam, pnode.Kind().String())
@@ -1550,8 +1702,7 @@ func (r *Renderer) renderString(w util.BufWriter, source []byte, node ast.Node,
 	if r.dbg {fmt.Println("dbg -- string")}
 
 	valStr :=""
-	r.count++
-	elNam := fmt.Sprintf("el%d",r.count)
+	elNam := r.newElNam(node)
 	node.SetAttributeString("el",elNam)
 
 	n := node.(*ast.String)
@@ -1567,7 +1718,7 @@ func (r *Renderer) renderString(w util.BufWriter, source []byte, node ast.Node,
 		}
 	}
 	datEl := elNam+"txt"
-	datStr := "const " + datEl + "= `" + valStr + "`;\n"
+	datStr := "const " + datEl + "= `" + jsTemplate([]byte(valStr)) + "`;\n"
 	_, _ = w.WriteString(datStr)
 	txtStr := "let "+elNam+ "=document.createTextNode(" + datEl + ");\n"
 	_, _ = w.WriteString(txtStr)
@@ -1575,49 +1726,78 @@ func (r *Renderer) renderString(w util.BufWriter, source []byte, node ast.Node,
 	return ast.WalkContinue, nil
 }
 
-func (r *Renderer) renderTexts(w util.BufWriter, source []byte, n ast.Node) {
+// nodeTexts returns the plain text of the descendants of n, as used for the alt text of an image.
+func nodeTexts(source []byte, n ast.Node) []byte {
+	var text []byte
 	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
 		if s, ok := c.(*ast.String); ok {
-			_, _ = r.renderString(w, source, s, true)
+			text = append(text, s.Value...)
 		} else if t, ok := c.(*ast.Text); ok {
-			_, _ = r.renderText(w, source, t, true)
+			text = append(text, t.Segment.Value(source)...)
+			if t.SoftLineBreak() || t.HardLineBreak() {text = append(text, ' ')}
 		} else {
-			r.renderTexts(w, source, c)
+			text = append(text, nodeTexts(source, c)...)
 		}
 	}
-}
-
-var dataPrefix = []byte("data-")
-
-// RenderAttributes renders given node's attributes.
-// You can specify attribute names to render by the filter.
-// If filter is nil, RenderAttributes renders all attributes.
-
-func RenderElAttributes(w util.BufWriter, node ast.Node, filter util.BytesFilter, elNam string) {
-	for _, attr := range node.Attributes() {
-		if filter != nil && !filter.Contains(attr.Name) {
-			if !bytes.HasPrefix(attr.Name, dataPrefix) {
-				continue
-			}
+	return text
+}
+
+// jsTemplate escapes text for the body of a js template literal.
+// Backslashes, backticks and the '${' of substitutions are escaped, so that
+// the text can neither end the literal nor run code. Invalid utf-8 becomes U+FFFD.
+func jsTemplate(text []byte) string {
+	var buf bytes.Buffer
+	str := string(text)
+	for i, c := range str {
+		switch {
+		case c == '\\' || c == '`':
+			buf.WriteByte('\\')
+		case c == '$' && strings.HasPrefix(str[i+1:], "{"):
+			buf.WriteByte('\\')
 		}
-//		_, _ = w.Write(attr.Name)
-//		_, _ = w.WriteString(`="`)
-		// TODO: convert numeric values to strings
-		var value string
-		switch typed := attr.Value.(type) {
-		case []byte:
-			value = string(typed)
-		case string:
-			value = typed
-		case int:
-			value = fmt.Sprintf("%d",typed)
-		//case float32
+		buf.WriteRune(c)
+	}
+	return buf.String()
+}
+
+// jsQuote returns text as a single quoted js string literal.
+func jsQuote(text []byte) string {
+	var buf bytes.Buffer
+	buf.WriteByte('\'')
+	for _, c := range string(text) {
+		switch c {
+		case '\\', '\'':
+			buf.WriteByte('\\')
+			buf.WriteRune(c)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\u2028':
+			buf.WriteString(`\u2028`)
+		case '\u2029':
+			buf.WriteString(`\u2029`)
+		default:
+			buf.WriteRune(c)
 		}
-		_, _ = w.WriteString(elNam + "." + string(attr.Name) + "='" + value + "';\n")
 	}
+	buf.WriteByte('\'')
+	return buf.String()
 }
 
-
+// isJSIdent reports whether an attribute name can be written as a js property name.
+func isJSIdent(nam []byte) bool {
+	if len(nam) == 0 {return false}
+	for i, c := range nam {
+		switch {
+		case c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		case i > 0 && c >= '0' && c <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
 
 // A Writer interface writes textual contents to a writer.
 type Writer interface {
@@ -1653,6 +1833,8 @@ func WithEscapedSpace() WriterOption {
 
 type defaultWriter struct {
 	WriterConfig
+	// plain writers do not escape html characters; they write the text of dom text nodes
+	plain bool
 }
 
 // NewWriter returns a new Writer.
@@ -1695,6 +1877,10 @@ func (d *defaultWriter) SecureWrite(writer util.BufWriter, source []byte) {
 }
 
 func (d *defaultWriter) RawWrite(writer util.BufWriter, source []byte) {
+	if d.plain {
+		_, _ = writer.Write(source)
+		return
+	}
 	n := 0
 	l := len(source)
 	for i := 0; i < l; i++ {
@@ -1801,40 +1987,31 @@ func (d *defaultWriter) Write(writer util.BufWriter, source []byte) {
 // DefaultWriter is a default instance of the Writer.
 var DefaultWriter = NewWriter()
 
-var bDataImage = []byte("data:image/")
-var bPng = []byte("png;")
-var bGif = []byte("gif;")
-var bJpeg = []byte("jpeg;")
-var bWebp = []byte("webp;")
-var bSvg = []byte("svg+xml;")
-var bJs = []byte("javascript:")
-var bVb = []byte("vbscript:")
-var bFile = []byte("file:")
-var bData = []byte("data:")
+// plainWriter resolves backslash escapes and character references without escaping html characters.
+var plainWriter = &defaultWriter{plain: true}
 
-func hasPrefix(s, prefix []byte) bool {
-	return len(s) >= len(prefix) && bytes.Equal(bytes.ToLower(s[0:len(prefix)]), bytes.ToLower(prefix))
+// textValue returns the text of a text node with the backslash escapes and the character
+// references resolved, as the html renderer writes it. Raw texts are returned as they are.
+func textValue(t *ast.Text, source []byte) []byte {
+	value := t.Segment.Value(source)
+	if t.IsRaw() {return value}
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	plainWriter.Write(bw, value)
+	_ = bw.Flush()
+	return buf.Bytes()
 }
 
 // IsDangerousURL returns true if the given url seems a potentially dangerous url,
-// otherwise false.
+// otherwise false. It is the link rule of DefaultURLPolicy.
 func IsDangerousURL(url []byte) bool {
-	if hasPrefix(url, bDataImage) && len(url) >= 11 {
-		v := url[11:]
-		if hasPrefix(v, bPng) || hasPrefix(v, bGif) ||
-			hasPrefix(v, bJpeg) || hasPrefix(v, bWebp) ||
-			hasPrefix(v, bSvg) {
-			return false
-		}
-		return true
-	}
-	return hasPrefix(url, bJs) || hasPrefix(url, bVb) ||
-		hasPrefix(url, bFile) || hasPrefix(url, bData)
+	return !defaultURLPolicy.Links.Allowed(url)
 }
 
-func GetRenderer(nam string, dbg bool) (r renderer.Renderer) {
+// GetRenderer returns a renderer.Renderer with the md2js node renderer and the given options.
+func GetRenderer(nam string, dbg bool, opts ...Option) (r renderer.Renderer) {
 	if dbg {log.Println("*** debugging ***")}
-	r = renderer.NewRenderer(renderer.WithNodeRenderers(util.Prioritized(NewRenderer(nam, dbg), 1000)))
+	r = renderer.NewRenderer(renderer.WithNodeRenderers(util.Prioritized(NewRenderer(nam, dbg, opts...), 1000)))
 	return r
 }
 
@@ -0,0 +1,1033 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h1');
+Object.assign(el2.style, mdStyle.h1);
+el2.id='markdown-syntax';
+const el3Txt= `Markdown: Syntax`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4= document.createElement('ul');
+Object.assign(el4.style, mdStyle.ul);
+let el5= document.createElement('li');
+Object.assign(el5.style, mdStyle.li);
+let el6=document.createElement("a");
+el6.href='#overview';
+Object.assign(el6.style, mdStyle.a);
+el6.textContent=`Overview`;
+el5.appendChild(el6);
+let el7= document.createElement('ul');
+Object.assign(el7.style, mdStyle.ul);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+let el9=document.createElement("a");
+el9.href='#philosophy';
+Object.assign(el9.style, mdStyle.a);
+el9.textContent=`Philosophy`;
+el8.appendChild(el9);
+el7.appendChild(el8);
+let el10= document.createElement('li');
+Object.assign(el10.style, mdStyle.li);
+let el11=document.createElement("a");
+el11.href='#html';
+Object.assign(el11.style, mdStyle.a);
+el11.textContent=`Inline HTML`;
+el10.appendChild(el11);
+el7.appendChild(el10);
+let el12= document.createElement('li');
+Object.assign(el12.style, mdStyle.li);
+let el13=document.createElement("a");
+el13.href='#autoescape';
+Object.assign(el13.style, mdStyle.a);
+el13.textContent=`Automatic Escaping for Special Characters`;
+el12.appendChild(el13);
+el7.appendChild(el12);
+el5.appendChild(el7);
+el4.appendChild(el5);
+let el14= document.createElement('li');
+Object.assign(el14.style, mdStyle.li);
+let el15=document.createElement("a");
+el15.href='#block';
+Object.assign(el15.style, mdStyle.a);
+el15.textContent=`Block Elements`;
+el14.appendChild(el15);
+let el16= document.createElement('ul');
+Object.assign(el16.style, mdStyle.ul);
+let el17= document.createElement('li');
+Object.assign(el17.style, mdStyle.li);
+let el18=document.createElement("a");
+el18.href='#p';
+Object.assign(el18.style, mdStyle.a);
+el18.textContent=`Paragraphs and Line Breaks`;
+el17.appendChild(el18);
+el16.appendChild(el17);
+let el19= document.createElement('li');
+Object.assign(el19.style, mdStyle.li);
+let el20=document.createElement("a");
+el20.href='#header';
+Object.assign(el20.style, mdStyle.a);
+el20.textContent=`Headers`;
+el19.appendChild(el20);
+el16.appendChild(el19);
+let el21= document.createElement('li');
+Object.assign(el21.style, mdStyle.li);
+let el22=document.createElement("a");
+el22.href='#blockquote';
+Object.assign(el22.style, mdStyle.a);
+el22.textContent=`Blockquotes`;
+el21.appendChild(el22);
+el16.appendChild(el21);
+let el23= document.createElement('li');
+Object.assign(el23.style, mdStyle.li);
+let el24=document.createElement("a");
+el24.href='#list';
+Object.assign(el24.style, mdStyle.a);
+el24.textContent=`Lists`;
+el23.appendChild(el24);
+el16.appendChild(el23);
+let el25= document.createElement('li');
+Object.assign(el25.style, mdStyle.li);
+let el26=document.createElement("a");
+el26.href='#precode';
+Object.assign(el26.style, mdStyle.a);
+el26.textContent=`Code Blocks`;
+el25.appendChild(el26);
+el16.appendChild(el25);
+let el27= document.createElement('li');
+Object.assign(el27.style, mdStyle.li);
+let el28=document.createElement("a");
+el28.href='#hr';
+Object.assign(el28.style, mdStyle.a);
+el28.textContent=`Horizontal Rules`;
+el27.appendChild(el28);
+el16.appendChild(el27);
+el14.appendChild(el16);
+el4.appendChild(el14);
+let el29= document.createElement('li');
+Object.assign(el29.style, mdStyle.li);
+let el30=document.createElement("a");
+el30.href='#span';
+Object.assign(el30.style, mdStyle.a);
+el30.textContent=`Span Elements`;
+el29.appendChild(el30);
+let el31= document.createElement('ul');
+Object.assign(el31.style, mdStyle.ul);
+let el32= document.createElement('li');
+Object.assign(el32.style, mdStyle.li);
+let el33=document.createElement("a");
+el33.href='#link';
+Object.assign(el33.style, mdStyle.a);
+el33.textContent=`Links`;
+el32.appendChild(el33);
+el31.appendChild(el32);
+let el34= document.createElement('li');
+Object.assign(el34.style, mdStyle.li);
+let el35=document.createElement("a");
+el35.href='#em';
+Object.assign(el35.style, mdStyle.a);
+el35.textContent=`Emphasis`;
+el34.appendChild(el35);
+el31.appendChild(el34);
+let el36= document.createElement('li');
+Object.assign(el36.style, mdStyle.li);
+let el37=document.createElement("a");
+el37.href='#code';
+Object.assign(el37.style, mdStyle.a);
+el37.textContent=`Code`;
+el36.appendChild(el37);
+el31.appendChild(el36);
+let el38= document.createElement('li');
+Object.assign(el38.style, mdStyle.li);
+let el39=document.createElement("a");
+el39.href='#img';
+Object.assign(el39.style, mdStyle.a);
+el39.textContent=`Images`;
+el38.appendChild(el39);
+el31.appendChild(el38);
+el29.appendChild(el31);
+el4.appendChild(el29);
+let el40= document.createElement('li');
+Object.assign(el40.style, mdStyle.li);
+let el41=document.createElement("a");
+el41.href='#misc';
+Object.assign(el41.style, mdStyle.a);
+el41.textContent=`Miscellaneous`;
+el40.appendChild(el41);
+let el42= document.createElement('ul');
+Object.assign(el42.style, mdStyle.ul);
+let el43= document.createElement('li');
+Object.assign(el43.style, mdStyle.li);
+let el44=document.createElement("a");
+el44.href='#backslash';
+Object.assign(el44.style, mdStyle.a);
+el44.textContent=`Backslash Escapes`;
+el43.appendChild(el44);
+el42.appendChild(el43);
+let el45= document.createElement('li');
+Object.assign(el45.style, mdStyle.li);
+let el46=document.createElement("a");
+el46.href='#autolink';
+Object.assign(el46.style, mdStyle.a);
+el46.textContent=`Automatic Links`;
+el45.appendChild(el46);
+el42.appendChild(el45);
+el40.appendChild(el42);
+el4.appendChild(el40);
+mdDiv.appendChild(el4);
+let el47=document.createElement('p');
+Object.assign(el47.style, mdStyle.p);
+let el48=document.createElement('strong');
+el48.textContent=`Note:`;
+el47.appendChild(el48);
+const el49=document.createTextNode(` This document is itself written using Markdown; youcan `);
+el47.appendChild(el49);
+let el50=document.createElement("a");
+el50.href='/projects/markdown/syntax.text';
+Object.assign(el50.style, mdStyle.a);
+el50.textContent=`see the source for it by adding '.text' to the URL`;
+el47.appendChild(el50);
+const el51=document.createTextNode(`.`);
+el47.appendChild(el51);
+mdDiv.appendChild(el47);
+let el52=document.createElement('hr');
+mdDiv.appendChild(el52);
+let el53= document.createElement('h2');
+Object.assign(el53.style, mdStyle.h2);
+el53.id='overview';
+const el54Txt= `Overview`;
+const el54=document.createTextNode(el54Txt);
+el53.appendChild(el54);
+mdDiv.appendChild(el53);
+let el55= document.createElement('h3');
+Object.assign(el55.style, mdStyle.h3);
+el55.id='philosophy';
+const el56Txt= `Philosophy`;
+const el56=document.createTextNode(el56Txt);
+el55.appendChild(el56);
+mdDiv.appendChild(el55);
+let el57=document.createElement('p');
+Object.assign(el57.style, mdStyle.p);
+el57.textContent=`Markdown is intended to be as easy-to-read and easy-to-write as is feasible.`;
+mdDiv.appendChild(el57);
+let el58=document.createElement('p');
+Object.assign(el58.style, mdStyle.p);
+const el59=document.createTextNode(`Readability, however, is emphasized above all else. A Markdown-formatteddocument should be publishable as-is, as plain text, without lookinglike it's been marked up with tags or formatting instructions. WhileMarkdown's syntax has been influenced by several existing text-to-HTMLfilters -- including `);
+el58.appendChild(el59);
+let el60=document.createElement("a");
+el60.href='http://docutils.sourceforge.net/mirror/setext.html';
+Object.assign(el60.style, mdStyle.a);
+el60.textContent=`Setext`;
+el58.appendChild(el60);
+const el61=document.createTextNode(`, `);
+el58.appendChild(el61);
+let el62=document.createElement("a");
+el62.href='http://www.aaronsw.com/2002/atx/';
+Object.assign(el62.style, mdStyle.a);
+el62.textContent=`atx`;
+el58.appendChild(el62);
+const el63=document.createTextNode(`, `);
+el58.appendChild(el63);
+let el64=document.createElement("a");
+el64.href='http://textism.com/tools/textile/';
+Object.assign(el64.style, mdStyle.a);
+el64.textContent=`Textile`;
+el58.appendChild(el64);
+const el65=document.createTextNode(`, `);
+el58.appendChild(el65);
+let el66=document.createElement("a");
+el66.href='http://docutils.sourceforge.net/rst.html';
+Object.assign(el66.style, mdStyle.a);
+el66.textContent=`reStructuredText`;
+el58.appendChild(el66);
+const el67=document.createTextNode(`,`);
+el58.appendChild(el67);
+let el68=document.createElement("a");
+el68.href='http://www.triptico.com/software/grutatxt.html';
+Object.assign(el68.style, mdStyle.a);
+el68.textContent=`Grutatext`;
+el58.appendChild(el68);
+const el69=document.createTextNode(`, and `);
+el58.appendChild(el69);
+let el70=document.createElement("a");
+el70.href='http://ettext.taint.org/doc/';
+Object.assign(el70.style, mdStyle.a);
+el70.textContent=`EtText`;
+el58.appendChild(el70);
+const el71=document.createTextNode(` -- the single biggest source ofinspiration for Markdown's syntax is the format of plain text email.`);
+el58.appendChild(el71);
+mdDiv.appendChild(el58);
+let el72= document.createElement('h2');
+Object.assign(el72.style, mdStyle.h2);
+el72.id='block-elements';
+const el73Txt= `Block Elements`;
+const el73=document.createTextNode(el73Txt);
+el72.appendChild(el73);
+mdDiv.appendChild(el72);
+let el74= document.createElement('h3');
+Object.assign(el74.style, mdStyle.h3);
+el74.id='paragraphs-and-line-breaks';
+const el75Txt= `Paragraphs and Line Breaks`;
+const el75=document.createTextNode(el75Txt);
+el74.appendChild(el75);
+mdDiv.appendChild(el74);
+let el76=document.createElement('p');
+Object.assign(el76.style, mdStyle.p);
+const el77=document.createTextNode(`A paragraph is simply one or more consecutive lines of text, separatedby one or more blank lines. (A blank line is any line that looks like ablank line -- a line containing nothing but spaces or tabs is consideredblank.) Normal paragraphs should not be indented with spaces or tabs.`);
+el76.appendChild(el77);
+mdDiv.appendChild(el76);
+let el78=document.createElement('p');
+Object.assign(el78.style, mdStyle.p);
+const el79=document.createTextNode(`The implication of the "one or more consecutive lines of text" rule isthat Markdown supports "hard-wrapped" text paragraphs. This differssignificantly from most other text-to-HTML formatters (including MovableType's "Convert Line Breaks" option) which translate every line breakcharacter in a paragraph into a `);
+el78.appendChild(el79);
+let el80=document.createElement("code");
+const el80Span1=document.createTextNode('<br />');
+el80.appendChild(el80Span1);
+el78.appendChild(el80);
+const el81=document.createTextNode(` tag.`);
+el78.appendChild(el81);
+mdDiv.appendChild(el78);
+let el82=document.createElement('p');
+Object.assign(el82.style, mdStyle.p);
+const el83=document.createTextNode(`When you `);
+el82.appendChild(el83);
+let el84=document.createElement('em');
+el84.textContent=`do`;
+el82.appendChild(el84);
+const el85=document.createTextNode(` want to insert a `);
+el82.appendChild(el85);
+let el86=document.createElement("code");
+const el86Span1=document.createTextNode('<br />');
+el86.appendChild(el86Span1);
+el82.appendChild(el86);
+const el87=document.createTextNode(` break tag using Markdown, youend a line with two or more spaces, then type return.`);
+el82.appendChild(el87);
+mdDiv.appendChild(el82);
+let el88= document.createElement('h3');
+Object.assign(el88.style, mdStyle.h3);
+el88.id='headers';
+const el89Txt= `Headers`;
+const el89=document.createTextNode(el89Txt);
+el88.appendChild(el89);
+mdDiv.appendChild(el88);
+let el90=document.createElement('p');
+Object.assign(el90.style, mdStyle.p);
+const el91=document.createTextNode(`Markdown supports two styles of headers, [Setext] [1] and [atx] [2].`);
+el90.appendChild(el91);
+mdDiv.appendChild(el90);
+let el92=document.createElement('p');
+Object.assign(el92.style, mdStyle.p);
+const el93=document.createTextNode(`Optionally, you may "close" atx-style headers. This is purelycosmetic -- you can use this if you think it looks better. Theclosing hashes don't even need to match the number of hashesused to open the header. (The number of opening hashesdetermines the header level.)`);
+el92.appendChild(el93);
+mdDiv.appendChild(el92);
+let el94= document.createElement('h3');
+Object.assign(el94.style, mdStyle.h3);
+el94.id='blockquotes';
+const el95Txt= `Blockquotes`;
+const el95=document.createTextNode(el95Txt);
+el94.appendChild(el95);
+mdDiv.appendChild(el94);
+let el96=document.createElement('p');
+Object.assign(el96.style, mdStyle.p);
+const el97=document.createTextNode(`Markdown uses email-style `);
+el96.appendChild(el97);
+let el98=document.createElement("code");
+const el98Span1=document.createTextNode('>');
+el98.appendChild(el98Span1);
+el96.appendChild(el98);
+const el99=document.createTextNode(` characters for blockquoting. If you'refamiliar with quoting passages of text in an email message, then youknow how to create a blockquote in Markdown. It looks best if you hardwrap the text and put a `);
+el96.appendChild(el99);
+let el100=document.createElement("code");
+const el100Span1=document.createTextNode('>');
+el100.appendChild(el100Span1);
+el96.appendChild(el100);
+const el101=document.createTextNode(` before every line:`);
+el96.appendChild(el101);
+mdDiv.appendChild(el96);
+let el102= document.createElement('blockquote');
+Object.assign(el102.style, mdStyle.block);
+let el103=document.createElement('p');
+Object.assign(el103.style, mdStyle.p);
+const el104=document.createTextNode(`This is a blockquote with two paragraphs. Lorem ipsum dolor sit amet,consectetuer adipiscing elit. Aliquam hendrerit mi posuere lectus.Vestibulum enim wisi, viverra nec, fringilla in, laoreet vitae, risus.`);
+el103.appendChild(el104);
+el102.appendChild(el103);
+let el105=document.createElement('p');
+Object.assign(el105.style, mdStyle.p);
+const el106=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit. Suspendisseid sem consectetuer libero luctus adipiscing.`);
+el105.appendChild(el106);
+el102.appendChild(el105);
+mdDiv.appendChild(el102);
+let el107=document.createElement('p');
+Object.assign(el107.style, mdStyle.p);
+const el108=document.createTextNode(`Markdown allows you to be lazy and only put the `);
+el107.appendChild(el108);
+let el109=document.createElement("code");
+const el109Span1=document.createTextNode('>');
+el109.appendChild(el109Span1);
+el107.appendChild(el109);
+const el110=document.createTextNode(` before the firstline of a hard-wrapped paragraph:`);
+el107.appendChild(el110);
+mdDiv.appendChild(el107);
+let el111= document.createElement('blockquote');
+Object.assign(el111.style, mdStyle.block);
+let el112=document.createElement('p');
+Object.assign(el112.style, mdStyle.p);
+const el113=document.createTextNode(`This is a blockquote with two paragraphs. Lorem ipsum dolor sit amet,consectetuer adipiscing elit. Aliquam hendrerit mi posuere lectus.Vestibulum enim wisi, viverra nec, fringilla in, laoreet vitae, risus.`);
+el112.appendChild(el113);
+el111.appendChild(el112);
+mdDiv.appendChild(el111);
+let el114= document.createElement('blockquote');
+Object.assign(el114.style, mdStyle.block);
+let el115=document.createElement('p');
+Object.assign(el115.style, mdStyle.p);
+const el116=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit. Suspendisseid sem consectetuer libero luctus adipiscing.`);
+el115.appendChild(el116);
+el114.appendChild(el115);
+mdDiv.appendChild(el114);
+let el117=document.createElement('p');
+Object.assign(el117.style, mdStyle.p);
+const el118=document.createTextNode(`Blockquotes can be nested (i.e. a blockquote-in-a-blockquote) byadding additional levels of `);
+el117.appendChild(el118);
+let el119=document.createElement("code");
+const el119Span1=document.createTextNode('>');
+el119.appendChild(el119Span1);
+el117.appendChild(el119);
+const el120=document.createTextNode(`:`);
+el117.appendChild(el120);
+mdDiv.appendChild(el117);
+let el121= document.createElement('blockquote');
+Object.assign(el121.style, mdStyle.block);
+let el122=document.createElement('p');
+Object.assign(el122.style, mdStyle.p);
+el122.textContent=`This is the first level of quoting.`;
+el121.appendChild(el122);
+let el123= document.createElement('blockquote');
+Object.assign(el123.style, mdStyle.block);
+let el124=document.createElement('p');
+Object.assign(el124.style, mdStyle.p);
+el124.textContent=`This is nested blockquote.`;
+el123.appendChild(el124);
+el121.appendChild(el123);
+let el125=document.createElement('p');
+Object.assign(el125.style, mdStyle.p);
+el125.textContent=`Back to the first level.`;
+el121.appendChild(el125);
+mdDiv.appendChild(el121);
+let el126=document.createElement('p');
+Object.assign(el126.style, mdStyle.p);
+const el127=document.createTextNode(`Blockquotes can contain other Markdown elements, including headers, lists,and code blocks:`);
+el126.appendChild(el127);
+mdDiv.appendChild(el126);
+let el128= document.createElement('blockquote');
+Object.assign(el128.style, mdStyle.block);
+let el129= document.createElement('h2');
+Object.assign(el129.style, mdStyle.h2);
+el129.id='this-is-a-header';
+const el130Txt= `This is a header.`;
+const el130=document.createTextNode(el130Txt);
+el129.appendChild(el130);
+el128.appendChild(el129);
+let el131= document.createElement('ol');
+Object.assign(el131.style, mdStyle.ol);
+let el132= document.createElement('li');
+Object.assign(el132.style, mdStyle.li);
+el132.textContent=`This is the first list item.`;
+el131.appendChild(el132);
+let el133= document.createElement('li');
+Object.assign(el133.style, mdStyle.li);
+el133.textContent=`This is the second list item.`;
+el131.appendChild(el133);
+el128.appendChild(el131);
+let el134=document.createElement('p');
+Object.assign(el134.style, mdStyle.p);
+el134.textContent=`Here's some example code:`;
+el128.appendChild(el134);
+let el135= document.createElement('pre');
+let el136= document.createElement('code');
+const el137= document.createTextNode(`return shell_exec("echo $input | $markdown_script");
+`);
+el136.appendChild(el137);
+el135.appendChild(el136);
+el128.appendChild(el135);
+mdDiv.appendChild(el128);
+let el138=document.createElement('p');
+Object.assign(el138.style, mdStyle.p);
+const el139=document.createTextNode(`Any decent text editor should make email-style quoting easy. Forexample, with BBEdit, you can make a selection and choose IncreaseQuote Level from the Text menu.`);
+el138.appendChild(el139);
+mdDiv.appendChild(el138);
+let el140= document.createElement('h3');
+Object.assign(el140.style, mdStyle.h3);
+el140.id='lists';
+const el141Txt= `Lists`;
+const el141=document.createTextNode(el141Txt);
+el140.appendChild(el141);
+mdDiv.appendChild(el140);
+let el142=document.createElement('p');
+Object.assign(el142.style, mdStyle.p);
+el142.textContent=`Markdown supports ordered (numbered) and unordered (bulleted) lists.`;
+mdDiv.appendChild(el142);
+let el143=document.createElement('p');
+Object.assign(el143.style, mdStyle.p);
+const el144=document.createTextNode(`Unordered lists use asterisks, pluses, and hyphens -- interchangably-- as list markers:`);
+el143.appendChild(el144);
+mdDiv.appendChild(el143);
+let el145= document.createElement('ul');
+Object.assign(el145.style, mdStyle.ul);
+let el146= document.createElement('li');
+Object.assign(el146.style, mdStyle.li);
+el146.textContent=`Red`;
+el145.appendChild(el146);
+let el147= document.createElement('li');
+Object.assign(el147.style, mdStyle.li);
+el147.textContent=`Green`;
+el145.appendChild(el147);
+let el148= document.createElement('li');
+Object.assign(el148.style, mdStyle.li);
+el148.textContent=`Blue`;
+el145.appendChild(el148);
+mdDiv.appendChild(el145);
+let el149=document.createElement('p');
+Object.assign(el149.style, mdStyle.p);
+el149.textContent=`is equivalent to:`;
+mdDiv.appendChild(el149);
+let el150= document.createElement('ul');
+Object.assign(el150.style, mdStyle.ul);
+let el151= document.createElement('li');
+Object.assign(el151.style, mdStyle.li);
+el151.textContent=`Red`;
+el150.appendChild(el151);
+let el152= document.createElement('li');
+Object.assign(el152.style, mdStyle.li);
+el152.textContent=`Green`;
+el150.appendChild(el152);
+let el153= document.createElement('li');
+Object.assign(el153.style, mdStyle.li);
+el153.textContent=`Blue`;
+el150.appendChild(el153);
+mdDiv.appendChild(el150);
+let el154=document.createElement('p');
+Object.assign(el154.style, mdStyle.p);
+el154.textContent=`and:`;
+mdDiv.appendChild(el154);
+let el155= document.createElement('ul');
+Object.assign(el155.style, mdStyle.ul);
+let el156= document.createElement('li');
+Object.assign(el156.style, mdStyle.li);
+el156.textContent=`Red`;
+el155.appendChild(el156);
+let el157= document.createElement('li');
+Object.assign(el157.style, mdStyle.li);
+el157.textContent=`Green`;
+el155.appendChild(el157);
+let el158= document.createElement('li');
+Object.assign(el158.style, mdStyle.li);
+el158.textContent=`Blue`;
+el155.appendChild(el158);
+mdDiv.appendChild(el155);
+let el159=document.createElement('p');
+Object.assign(el159.style, mdStyle.p);
+el159.textContent=`Ordered lists use numbers followed by periods:`;
+mdDiv.appendChild(el159);
+let el160= document.createElement('ol');
+Object.assign(el160.style, mdStyle.ol);
+let el161= document.createElement('li');
+Object.assign(el161.style, mdStyle.li);
+el161.textContent=`Bird`;
+el160.appendChild(el161);
+let el162= document.createElement('li');
+Object.assign(el162.style, mdStyle.li);
+el162.textContent=`McHale`;
+el160.appendChild(el162);
+let el163= document.createElement('li');
+Object.assign(el163.style, mdStyle.li);
+el163.textContent=`Parish`;
+el160.appendChild(el163);
+mdDiv.appendChild(el160);
+let el164=document.createElement('p');
+Object.assign(el164.style, mdStyle.p);
+const el165=document.createTextNode(`It's important to note that the actual numbers you use to mark thelist have no effect on the HTML output Markdown produces. The HTMLMarkdown produces from the above list is:`);
+el164.appendChild(el165);
+mdDiv.appendChild(el164);
+let el166=document.createElement('p');
+Object.assign(el166.style, mdStyle.p);
+el166.textContent=`If you instead wrote the list in Markdown like this:`;
+mdDiv.appendChild(el166);
+let el167= document.createElement('ol');
+Object.assign(el167.style, mdStyle.ol);
+let el168= document.createElement('li');
+Object.assign(el168.style, mdStyle.li);
+el168.textContent=`Bird`;
+el167.appendChild(el168);
+let el169= document.createElement('li');
+Object.assign(el169.style, mdStyle.li);
+el169.textContent=`McHale`;
+el167.appendChild(el169);
+let el170= document.createElement('li');
+Object.assign(el170.style, mdStyle.li);
+el170.textContent=`Parish`;
+el167.appendChild(el170);
+mdDiv.appendChild(el167);
+let el171=document.createElement('p');
+Object.assign(el171.style, mdStyle.p);
+el171.textContent=`or even:`;
+mdDiv.appendChild(el171);
+let el172= document.createElement('ol');
+el172.start='3';
+Object.assign(el172.style, mdStyle.ol);
+let el173= document.createElement('li');
+Object.assign(el173.style, mdStyle.li);
+el173.textContent=`Bird`;
+el172.appendChild(el173);
+let el174= document.createElement('li');
+Object.assign(el174.style, mdStyle.li);
+el174.textContent=`McHale`;
+el172.appendChild(el174);
+let el175= document.createElement('li');
+Object.assign(el175.style, mdStyle.li);
+el175.textContent=`Parish`;
+el172.appendChild(el175);
+mdDiv.appendChild(el172);
+let el176=document.createElement('p');
+Object.assign(el176.style, mdStyle.p);
+const el177=document.createTextNode(`you'd get the exact same HTML output. The point is, if you want to,you can use ordinal numbers in your ordered Markdown lists, so thatthe numbers in your source match the numbers in your published HTML.But if you want to be lazy, you don't have to.`);
+el176.appendChild(el177);
+mdDiv.appendChild(el176);
+let el178=document.createElement('p');
+Object.assign(el178.style, mdStyle.p);
+el178.textContent=`To make lists look nice, you can wrap items with hanging indents:`;
+mdDiv.appendChild(el178);
+let el179= document.createElement('ul');
+Object.assign(el179.style, mdStyle.ul);
+let el180= document.createElement('li');
+Object.assign(el180.style, mdStyle.li);
+const el181=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el180.appendChild(el181);
+el179.appendChild(el180);
+let el182= document.createElement('li');
+Object.assign(el182.style, mdStyle.li);
+const el183=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el182.appendChild(el183);
+el179.appendChild(el182);
+mdDiv.appendChild(el179);
+let el184=document.createElement('p');
+Object.assign(el184.style, mdStyle.p);
+el184.textContent=`But if you want to be lazy, you don't have to:`;
+mdDiv.appendChild(el184);
+let el185= document.createElement('ul');
+Object.assign(el185.style, mdStyle.ul);
+let el186= document.createElement('li');
+Object.assign(el186.style, mdStyle.li);
+const el187=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el186.appendChild(el187);
+el185.appendChild(el186);
+let el188= document.createElement('li');
+Object.assign(el188.style, mdStyle.li);
+const el189=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el188.appendChild(el189);
+el185.appendChild(el188);
+mdDiv.appendChild(el185);
+let el190=document.createElement('p');
+Object.assign(el190.style, mdStyle.p);
+const el191=document.createTextNode(`List items may consist of multiple paragraphs. Each subsequentparagraph in a list item must be indented by either 4 spacesor one tab:`);
+el190.appendChild(el191);
+mdDiv.appendChild(el190);
+let el192= document.createElement('ol');
+Object.assign(el192.style, mdStyle.ol);
+let el193= document.createElement('li');
+Object.assign(el193.style, mdStyle.li);
+let el194=document.createElement('p');
+Object.assign(el194.style, mdStyle.p);
+const el195=document.createTextNode(`This is a list item with two paragraphs. Lorem ipsum dolorsit amet, consectetuer adipiscing elit. Aliquam hendreritmi posuere lectus.`);
+el194.appendChild(el195);
+el193.appendChild(el194);
+let el196=document.createElement('p');
+Object.assign(el196.style, mdStyle.p);
+const el197=document.createTextNode(`Vestibulum enim wisi, viverra nec, fringilla in, laoreetvitae, risus. Donec sit amet nisl. Aliquam semper ipsumsit amet velit.`);
+el196.appendChild(el197);
+el193.appendChild(el196);
+el192.appendChild(el193);
+let el198= document.createElement('li');
+Object.assign(el198.style, mdStyle.li);
+let el199=document.createElement('p');
+Object.assign(el199.style, mdStyle.p);
+el199.textContent=`Suspendisse id sem consectetuer libero luctus adipiscing.`;
+el198.appendChild(el199);
+el192.appendChild(el198);
+mdDiv.appendChild(el192);
+let el200=document.createElement('p');
+Object.assign(el200.style, mdStyle.p);
+const el201=document.createTextNode(`It looks nice if you indent every line of the subsequentparagraphs, but here again, Markdown will allow you to belazy:`);
+el200.appendChild(el201);
+mdDiv.appendChild(el200);
+let el202= document.createElement('ul');
+Object.assign(el202.style, mdStyle.ul);
+let el203= document.createElement('li');
+Object.assign(el203.style, mdStyle.li);
+let el204=document.createElement('p');
+Object.assign(el204.style, mdStyle.p);
+el204.textContent=`This is a list item with two paragraphs.`;
+el203.appendChild(el204);
+let el205=document.createElement('p');
+Object.assign(el205.style, mdStyle.p);
+const el206=document.createTextNode(`This is the second paragraph in the list item. You'reonly required to indent the first line. Lorem ipsum dolorsit amet, consectetuer adipiscing elit.`);
+el205.appendChild(el206);
+el203.appendChild(el205);
+el202.appendChild(el203);
+let el207= document.createElement('li');
+Object.assign(el207.style, mdStyle.li);
+let el208=document.createElement('p');
+Object.assign(el208.style, mdStyle.p);
+el208.textContent=`Another item in the same list.`;
+el207.appendChild(el208);
+el202.appendChild(el207);
+mdDiv.appendChild(el202);
+let el209=document.createElement('p');
+Object.assign(el209.style, mdStyle.p);
+const el210=document.createTextNode(`To put a blockquote within a list item, the blockquote's `);
+el209.appendChild(el210);
+let el211=document.createElement("code");
+const el211Span1=document.createTextNode('>');
+el211.appendChild(el211Span1);
+el209.appendChild(el211);
+const el212=document.createTextNode(`delimiters need to be indented:`);
+el209.appendChild(el212);
+mdDiv.appendChild(el209);
+let el213= document.createElement('ul');
+Object.assign(el213.style, mdStyle.ul);
+let el214= document.createElement('li');
+Object.assign(el214.style, mdStyle.li);
+let el215=document.createElement('p');
+Object.assign(el215.style, mdStyle.p);
+el215.textContent=`A list item with a blockquote:`;
+el214.appendChild(el215);
+let el216= document.createElement('blockquote');
+Object.assign(el216.style, mdStyle.block);
+let el217=document.createElement('p');
+Object.assign(el217.style, mdStyle.p);
+const el218=document.createTextNode(`This is a blockquoteinside a list item.`);
+el217.appendChild(el218);
+el216.appendChild(el217);
+el214.appendChild(el216);
+el213.appendChild(el214);
+mdDiv.appendChild(el213);
+let el219=document.createElement('p');
+Object.assign(el219.style, mdStyle.p);
+const el220=document.createTextNode(`To put a code block within a list item, the code block needsto be indented `);
+el219.appendChild(el220);
+let el221=document.createElement('em');
+el221.textContent=`twice`;
+el219.appendChild(el221);
+const el222=document.createTextNode(` -- 8 spaces or two tabs:`);
+el219.appendChild(el222);
+mdDiv.appendChild(el219);
+let el223= document.createElement('ul');
+Object.assign(el223.style, mdStyle.ul);
+let el224= document.createElement('li');
+Object.assign(el224.style, mdStyle.li);
+let el225=document.createElement('p');
+Object.assign(el225.style, mdStyle.p);
+el225.textContent=`A list item with a code block:`;
+el224.appendChild(el225);
+let el226= document.createElement('pre');
+let el227= document.createElement('code');
+const el228= document.createTextNode(`<code goes here>
+`);
+el227.appendChild(el228);
+el226.appendChild(el227);
+el224.appendChild(el226);
+el223.appendChild(el224);
+mdDiv.appendChild(el223);
+let el229= document.createElement('h3');
+Object.assign(el229.style, mdStyle.h3);
+el229.id='code-blocks';
+const el230Txt= `Code Blocks`;
+const el230=document.createTextNode(el230Txt);
+el229.appendChild(el230);
+mdDiv.appendChild(el229);
+let el231=document.createElement('p');
+Object.assign(el231.style, mdStyle.p);
+const el232=document.createTextNode(`Pre-formatted code blocks are used for writing about programming ormarkup source code. Rather than forming normal paragraphs, the linesof a code block are interpreted literally. Markdown wraps a code blockin both `);
+el231.appendChild(el232);
+let el233=document.createElement("code");
+const el233Span1=document.createTextNode('<pre>');
+el233.appendChild(el233Span1);
+el231.appendChild(el233);
+const el234=document.createTextNode(` and `);
+el231.appendChild(el234);
+let el235=document.createElement("code");
+const el235Span1=document.createTextNode('<code>');
+el235.appendChild(el235Span1);
+el231.appendChild(el235);
+const el236=document.createTextNode(` tags.`);
+el231.appendChild(el236);
+mdDiv.appendChild(el231);
+let el237=document.createElement('p');
+Object.assign(el237.style, mdStyle.p);
+const el238=document.createTextNode(`To produce a code block in Markdown, simply indent every line of theblock by at least 4 spaces or 1 tab.`);
+el237.appendChild(el238);
+mdDiv.appendChild(el237);
+let el239=document.createElement('p');
+Object.assign(el239.style, mdStyle.p);
+el239.textContent=`This is a normal paragraph:`;
+mdDiv.appendChild(el239);
+let el240= document.createElement('pre');
+let el241= document.createElement('code');
+const el242= document.createTextNode(`This is a code block.
+`);
+el241.appendChild(el242);
+el240.appendChild(el241);
+mdDiv.appendChild(el240);
+let el243=document.createElement('p');
+Object.assign(el243.style, mdStyle.p);
+el243.textContent=`Here is an example of AppleScript:`;
+mdDiv.appendChild(el243);
+let el244= document.createElement('pre');
+let el245= document.createElement('code');
+const el246= document.createTextNode(`tell application "Foo"
+    beep
+end tell
+`);
+el245.appendChild(el246);
+el244.appendChild(el245);
+mdDiv.appendChild(el244);
+let el247=document.createElement('p');
+Object.assign(el247.style, mdStyle.p);
+const el248=document.createTextNode(`A code block continues until it reaches a line that is not indented(or the end of the article).`);
+el247.appendChild(el248);
+mdDiv.appendChild(el247);
+let el249=document.createElement('p');
+Object.assign(el249.style, mdStyle.p);
+const el250=document.createTextNode(`Within a code block, ampersands (`);
+el249.appendChild(el250);
+let el251=document.createElement("code");
+const el251Span1=document.createTextNode('&');
+el251.appendChild(el251Span1);
+el249.appendChild(el251);
+const el252=document.createTextNode(`) and angle brackets (`);
+el249.appendChild(el252);
+let el253=document.createElement("code");
+const el253Span1=document.createTextNode('<');
+el253.appendChild(el253Span1);
+el249.appendChild(el253);
+const el254=document.createTextNode(` and `);
+el249.appendChild(el254);
+let el255=document.createElement("code");
+const el255Span1=document.createTextNode('>');
+el255.appendChild(el255Span1);
+el249.appendChild(el255);
+const el256=document.createTextNode(`)are automatically converted into HTML entities. This makes it veryeasy to include example HTML source code using Markdown -- just pasteit and indent it, and Markdown will handle the hassle of encoding theampersands and angle brackets. For example, this:`);
+el249.appendChild(el256);
+mdDiv.appendChild(el249);
+let el257= document.createElement('pre');
+let el258= document.createElement('code');
+const el259= document.createTextNode(`<div class="footer">
+    &copy; 2004 Foo Corporation
+</div>
+`);
+el258.appendChild(el259);
+el257.appendChild(el258);
+mdDiv.appendChild(el257);
+let el260=document.createElement('p');
+Object.assign(el260.style, mdStyle.p);
+const el261=document.createTextNode(`Regular Markdown syntax is not processed within code blocks. E.g.,asterisks are just literal asterisks within a code block. This meansit's also easy to use Markdown to write about Markdown's own syntax.`);
+el260.appendChild(el261);
+mdDiv.appendChild(el260);
+let el262= document.createElement('pre');
+let el263= document.createElement('code');
+const el264= document.createTextNode(`>tell application "Foo"
+>    beep
+>end tell
+`);
+el263.appendChild(el264);
+el262.appendChild(el263);
+// dbg -- el: el262 parent:mdDiv kind:Document
+mdDiv.appendChild(el262);
+let el265= document.createElement('h2');
+Object.assign(el265.style, mdStyle.h2);
+el265.id='span-elements';
+const el266Txt= `Span Elements`;
+const el266=document.createTextNode(el266Txt);
+el265.appendChild(el266);
+mdDiv.appendChild(el265);
+let el267= document.createElement('h3');
+Object.assign(el267.style, mdStyle.h3);
+el267.id='links';
+const el268Txt= `Links`;
+const el268=document.createTextNode(el268Txt);
+el267.appendChild(el268);
+mdDiv.appendChild(el267);
+let el269=document.createElement('p');
+Object.assign(el269.style, mdStyle.p);
+const el270=document.createTextNode(`Markdown supports two style of links: `);
+el269.appendChild(el270);
+let el271=document.createElement('em');
+el271.textContent=`inline`;
+el269.appendChild(el271);
+const el272=document.createTextNode(` and `);
+el269.appendChild(el272);
+let el273=document.createElement('em');
+el273.textContent=`reference`;
+el269.appendChild(el273);
+const el274=document.createTextNode(`.`);
+el269.appendChild(el274);
+mdDiv.appendChild(el269);
+let el275=document.createElement('p');
+Object.assign(el275.style, mdStyle.p);
+const el276=document.createTextNode(`In both styles, the link text is delimited by [square brackets].`);
+el275.appendChild(el276);
+mdDiv.appendChild(el275);
+let el277=document.createElement('p');
+Object.assign(el277.style, mdStyle.p);
+const el278=document.createTextNode(`To create an inline link, use a set of regular parentheses immediatelyafter the link text's closing square bracket. Inside the parentheses,put the URL where you want the link to point, along with an `);
+el277.appendChild(el278);
+let el279=document.createElement('em');
+el279.textContent=`optional`;
+el277.appendChild(el279);
+const el280=document.createTextNode(`title for the link, surrounded in quotes. For example:`);
+el277.appendChild(el280);
+mdDiv.appendChild(el277);
+let el281=document.createElement('p');
+Object.assign(el281.style, mdStyle.p);
+const el282=document.createTextNode(`This is `);
+el281.appendChild(el282);
+let el283=document.createElement("a");
+el283.href='http://example.com/';
+Object.assign(el283.style, mdStyle.a);
+el283.textContent=`an example`;
+el281.appendChild(el283);
+const el284=document.createTextNode(` inline link.`);
+el281.appendChild(el284);
+mdDiv.appendChild(el281);
+let el285=document.createElement('p');
+Object.assign(el285.style, mdStyle.p);
+let el286=document.createElement("a");
+el286.href='http://example.net/';
+Object.assign(el286.style, mdStyle.a);
+el286.textContent=`This link`;
+el285.appendChild(el286);
+const el287=document.createTextNode(` has no title attribute.`);
+el285.appendChild(el287);
+mdDiv.appendChild(el285);
+let el288= document.createElement('h3');
+Object.assign(el288.style, mdStyle.h3);
+el288.id='emphasis';
+const el289Txt= `Emphasis`;
+const el289=document.createTextNode(el289Txt);
+el288.appendChild(el289);
+mdDiv.appendChild(el288);
+let el290=document.createElement('p');
+Object.assign(el290.style, mdStyle.p);
+const el291=document.createTextNode(`Markdown treats asterisks (`);
+el290.appendChild(el291);
+let el292=document.createElement("code");
+const el292Span1=document.createTextNode('*');
+el292.appendChild(el292Span1);
+el290.appendChild(el292);
+const el293=document.createTextNode(`) and underscores (`);
+el290.appendChild(el293);
+let el294=document.createElement("code");
+const el294Span1=document.createTextNode('_');
+el294.appendChild(el294Span1);
+el290.appendChild(el294);
+const el295=document.createTextNode(`) as indicators ofemphasis. Text wrapped with one `);
+el290.appendChild(el295);
+let el296=document.createElement("code");
+const el296Span1=document.createTextNode('*');
+el296.appendChild(el296Span1);
+el290.appendChild(el296);
+const el297=document.createTextNode(` or `);
+el290.appendChild(el297);
+let el298=document.createElement("code");
+const el298Span1=document.createTextNode('_');
+el298.appendChild(el298Span1);
+el290.appendChild(el298);
+const el299=document.createTextNode(` will be wrapped with anHTML `);
+el290.appendChild(el299);
+let el300=document.createElement("code");
+const el300Span1=document.createTextNode('<em>');
+el300.appendChild(el300Span1);
+el290.appendChild(el300);
+const el301=document.createTextNode(` tag; double `);
+el290.appendChild(el301);
+let el302=document.createElement("code");
+const el302Span1=document.createTextNode('*');
+el302.appendChild(el302Span1);
+el290.appendChild(el302);
+const el303=document.createTextNode(`'s or `);
+el290.appendChild(el303);
+let el304=document.createElement("code");
+const el304Span1=document.createTextNode('_');
+el304.appendChild(el304Span1);
+el290.appendChild(el304);
+const el305=document.createTextNode(`'s will be wrapped with an HTML`);
+el290.appendChild(el305);
+let el306=document.createElement("code");
+const el306Span1=document.createTextNode('<strong>');
+el306.appendChild(el306Span1);
+el290.appendChild(el306);
+const el307=document.createTextNode(` tag. E.g., this input:`);
+el290.appendChild(el307);
+mdDiv.appendChild(el290);
+let el308=document.createElement('p');
+Object.assign(el308.style, mdStyle.p);
+let el309=document.createElement('em');
+el309.textContent=`single asterisks`;
+el308.appendChild(el309);
+mdDiv.appendChild(el308);
+let el310=document.createElement('p');
+Object.assign(el310.style, mdStyle.p);
+let el311=document.createElement('em');
+el311.textContent=`single underscores`;
+el310.appendChild(el311);
+mdDiv.appendChild(el310);
+let el312=document.createElement('p');
+Object.assign(el312.style, mdStyle.p);
+let el313=document.createElement('strong');
+el313.textContent=`double asterisks`;
+el312.appendChild(el313);
+mdDiv.appendChild(el312);
+let el314=document.createElement('p');
+Object.assign(el314.style, mdStyle.p);
+let el315=document.createElement('strong');
+el315.textContent=`double underscores`;
+el314.appendChild(el315);
+mdDiv.appendChild(el314);
+let el316= document.createElement('h3');
+Object.assign(el316.style, mdStyle.h3);
+el316.id='code';
+const el317Txt= `Code`;
+const el317=document.createTextNode(el317Txt);
+el316.appendChild(el317);
+mdDiv.appendChild(el316);
+let el318=document.createElement('p');
+Object.assign(el318.style, mdStyle.p);
+const el319=document.createTextNode(`To indicate a span of code, wrap it with backtick quotes (`);
+el318.appendChild(el319);
+let el320=document.createElement("code");
+const el320Span1=document.createTextNode('`');
+el320.appendChild(el320Span1);
+el318.appendChild(el320);
+const el321=document.createTextNode(`).Unlike a pre-formatted code block, a code span indicates code within anormal paragraph. For example:`);
+el318.appendChild(el321);
+mdDiv.appendChild(el318);
+let el322=document.createElement('p');
+Object.assign(el322.style, mdStyle.p);
+const el323=document.createTextNode(`Use the `);
+el322.appendChild(el323);
+let el324=document.createElement("code");
+const el324Span1=document.createTextNode('printf()');
+el324.appendChild(el324Span1);
+el322.appendChild(el324);
+const el325=document.createTextNode(` function.`);
+el322.appendChild(el325);
+mdDiv.appendChild(el322);
+return mdDiv;
+};
@@ -0,0 +1,428 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h1');
+Object.assign(el2.style, mdStyle.h1);
+el2.id='markdown-syntax';
+const el3Txt= `Markdown: Syntax`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4= document.createElement('h2');
+Object.assign(el4.style, mdStyle.h2);
+el4.id='lists';
+const el5Txt= `Lists`;
+const el5=document.createTextNode(el5Txt);
+// dbg -- el: el5 parent:el4 kind:Heading
+el4.appendChild(el5);
+// dbg -- el: el4 parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el6=document.createElement('p');
+Object.assign(el6.style, mdStyle.p);
+// dbg -- pelNam: el6 children: 1
+el6.textContent=`Markdown supports ordered (numbered) and unordered (bulleted) lists.`;
+// dbg -- par el: el6 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el6);
+let el7=document.createElement('p');
+Object.assign(el7.style, mdStyle.p);
+// dbg -- pelNam: el7 children: 2
+const el8=document.createTextNode(`Unordered lists use asterisks, pluses, and hyphens -- interchangably-- as list markers:`);
+el7.appendChild(el8);
+// dbg -- par el: el7 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el7);
+let el9= document.createElement('ul');
+Object.assign(el9.style, mdStyle.ul);
+let el10= document.createElement('li');
+Object.assign(el10.style, mdStyle.li);
+// dbg -- pelNam: el10 children: 1
+el10.textContent=`Red`;
+// dbg -- el: el10 parent:el9 kind:List
+el9.appendChild(el10);
+let el11= document.createElement('li');
+Object.assign(el11.style, mdStyle.li);
+// dbg -- pelNam: el11 children: 1
+el11.textContent=`Green`;
+// dbg -- el: el11 parent:el9 kind:List
+el9.appendChild(el11);
+let el12= document.createElement('li');
+Object.assign(el12.style, mdStyle.li);
+// dbg -- pelNam: el12 children: 1
+el12.textContent=`Blue`;
+// dbg -- el: el12 parent:el9 kind:List
+el9.appendChild(el12);
+// dbg -- el: el9 parent:mdDiv kind:Document
+mdDiv.appendChild(el9);
+let el13=document.createElement('p');
+Object.assign(el13.style, mdStyle.p);
+// dbg -- pelNam: el13 children: 1
+el13.textContent=`is equivalent to:`;
+// dbg -- par el: el13 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el13);
+let el14= document.createElement('ul');
+Object.assign(el14.style, mdStyle.ul);
+let el15= document.createElement('li');
+Object.assign(el15.style, mdStyle.li);
+// dbg -- pelNam: el15 children: 1
+el15.textContent=`Red`;
+// dbg -- el: el15 parent:el14 kind:List
+el14.appendChild(el15);
+let el16= document.createElement('li');
+Object.assign(el16.style, mdStyle.li);
+// dbg -- pelNam: el16 children: 1
+el16.textContent=`Green`;
+// dbg -- el: el16 parent:el14 kind:List
+el14.appendChild(el16);
+let el17= document.createElement('li');
+Object.assign(el17.style, mdStyle.li);
+// dbg -- pelNam: el17 children: 1
+el17.textContent=`Blue`;
+// dbg -- el: el17 parent:el14 kind:List
+el14.appendChild(el17);
+// dbg -- el: el14 parent:mdDiv kind:Document
+mdDiv.appendChild(el14);
+let el18=document.createElement('p');
+Object.assign(el18.style, mdStyle.p);
+// dbg -- pelNam: el18 children: 1
+el18.textContent=`and:`;
+// dbg -- par el: el18 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el18);
+let el19= document.createElement('ul');
+Object.assign(el19.style, mdStyle.ul);
+let el20= document.createElement('li');
+Object.assign(el20.style, mdStyle.li);
+// dbg -- pelNam: el20 children: 1
+el20.textContent=`Red`;
+// dbg -- el: el20 parent:el19 kind:List
+el19.appendChild(el20);
+let el21= document.createElement('li');
+Object.assign(el21.style, mdStyle.li);
+// dbg -- pelNam: el21 children: 1
+el21.textContent=`Green`;
+// dbg -- el: el21 parent:el19 kind:List
+el19.appendChild(el21);
+let el22= document.createElement('li');
+Object.assign(el22.style, mdStyle.li);
+// dbg -- pelNam: el22 children: 1
+el22.textContent=`Blue`;
+// dbg -- el: el22 parent:el19 kind:List
+el19.appendChild(el22);
+// dbg -- el: el19 parent:mdDiv kind:Document
+mdDiv.appendChild(el19);
+let el23=document.createElement('p');
+Object.assign(el23.style, mdStyle.p);
+// dbg -- pelNam: el23 children: 1
+el23.textContent=`Ordered lists use numbers followed by periods:`;
+// dbg -- par el: el23 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el23);
+let el24= document.createElement('ol');
+Object.assign(el24.style, mdStyle.ol);
+let el25= document.createElement('li');
+Object.assign(el25.style, mdStyle.li);
+// dbg -- pelNam: el25 children: 1
+el25.textContent=`Bird`;
+// dbg -- el: el25 parent:el24 kind:List
+el24.appendChild(el25);
+let el26= document.createElement('li');
+Object.assign(el26.style, mdStyle.li);
+// dbg -- pelNam: el26 children: 1
+el26.textContent=`McHale`;
+// dbg -- el: el26 parent:el24 kind:List
+el24.appendChild(el26);
+let el27= document.createElement('li');
+Object.assign(el27.style, mdStyle.li);
+// dbg -- pelNam: el27 children: 1
+el27.textContent=`Parish`;
+// dbg -- el: el27 parent:el24 kind:List
+el24.appendChild(el27);
+// dbg -- el: el24 parent:mdDiv kind:Document
+mdDiv.appendChild(el24);
+let el28=document.createElement('p');
+Object.assign(el28.style, mdStyle.p);
+// dbg -- pelNam: el28 children: 3
+const el29=document.createTextNode(`It's important to note that the actual numbers you use to mark thelist have no effect on the HTML output Markdown produces. The HTMLMarkdown produces from the above list is:`);
+el28.appendChild(el29);
+// dbg -- par el: el28 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el28);
+let el30=document.createElement('p');
+Object.assign(el30.style, mdStyle.p);
+// dbg -- pelNam: el30 children: 1
+el30.textContent=`If you instead wrote the list in Markdown like this:`;
+// dbg -- par el: el30 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el30);
+let el31= document.createElement('ol');
+Object.assign(el31.style, mdStyle.ol);
+let el32= document.createElement('li');
+Object.assign(el32.style, mdStyle.li);
+// dbg -- pelNam: el32 children: 1
+el32.textContent=`Bird`;
+// dbg -- el: el32 parent:el31 kind:List
+el31.appendChild(el32);
+let el33= document.createElement('li');
+Object.assign(el33.style, mdStyle.li);
+// dbg -- pelNam: el33 children: 1
+el33.textContent=`McHale`;
+// dbg -- el: el33 parent:el31 kind:List
+el31.appendChild(el33);
+let el34= document.createElement('li');
+Object.assign(el34.style, mdStyle.li);
+// dbg -- pelNam: el34 children: 1
+el34.textContent=`Parish`;
+// dbg -- el: el34 parent:el31 kind:List
+el31.appendChild(el34);
+// dbg -- el: el31 parent:mdDiv kind:Document
+mdDiv.appendChild(el31);
+let el35=document.createElement('p');
+Object.assign(el35.style, mdStyle.p);
+// dbg -- pelNam: el35 children: 1
+el35.textContent=`or even:`;
+// dbg -- par el: el35 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el35);
+let el36= document.createElement('ol');
+el36.start='3';
+Object.assign(el36.style, mdStyle.ol);
+let el37= document.createElement('li');
+Object.assign(el37.style, mdStyle.li);
+// dbg -- pelNam: el37 children: 1
+el37.textContent=`Bird`;
+// dbg -- el: el37 parent:el36 kind:List
+el36.appendChild(el37);
+let el38= document.createElement('li');
+Object.assign(el38.style, mdStyle.li);
+// dbg -- pelNam: el38 children: 1
+el38.textContent=`McHale`;
+// dbg -- el: el38 parent:el36 kind:List
+el36.appendChild(el38);
+let el39= document.createElement('li');
+Object.assign(el39.style, mdStyle.li);
+// dbg -- pelNam: el39 children: 1
+el39.textContent=`Parish`;
+// dbg -- el: el39 parent:el36 kind:List
+el36.appendChild(el39);
+// dbg -- el: el36 parent:mdDiv kind:Document
+mdDiv.appendChild(el36);
+let el40=document.createElement('p');
+Object.assign(el40.style, mdStyle.p);
+// dbg -- pelNam: el40 children: 4
+const el41=document.createTextNode(`you'd get the exact same HTML output. The point is, if you want to,you can use ordinal numbers in your ordered Markdown lists, so thatthe numbers in your source match the numbers in your published HTML.But if you want to be lazy, you don't have to.`);
+el40.appendChild(el41);
+// dbg -- par el: el40 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el40);
+let el42=document.createElement('p');
+Object.assign(el42.style, mdStyle.p);
+// dbg -- pelNam: el42 children: 1
+el42.textContent=`To make lists look nice, you can wrap items with hanging indents:`;
+// dbg -- par el: el42 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el42);
+let el43= document.createElement('ul');
+Object.assign(el43.style, mdStyle.ul);
+let el44= document.createElement('li');
+Object.assign(el44.style, mdStyle.li);
+// dbg -- pelNam: el44 children: 3
+const el45=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el44.appendChild(el45);
+// dbg -- el: el44 parent:el43 kind:List
+el43.appendChild(el44);
+let el46= document.createElement('li');
+Object.assign(el46.style, mdStyle.li);
+// dbg -- pelNam: el46 children: 2
+const el47=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el46.appendChild(el47);
+// dbg -- el: el46 parent:el43 kind:List
+el43.appendChild(el46);
+// dbg -- el: el43 parent:mdDiv kind:Document
+mdDiv.appendChild(el43);
+let el48=document.createElement('p');
+Object.assign(el48.style, mdStyle.p);
+// dbg -- pelNam: el48 children: 1
+el48.textContent=`But if you want to be lazy, you don't have to:`;
+// dbg -- par el: el48 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el48);
+let el49= document.createElement('ul');
+Object.assign(el49.style, mdStyle.ul);
+let el50= document.createElement('li');
+Object.assign(el50.style, mdStyle.li);
+// dbg -- pelNam: el50 children: 3
+const el51=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el50.appendChild(el51);
+// dbg -- el: el50 parent:el49 kind:List
+el49.appendChild(el50);
+let el52= document.createElement('li');
+Object.assign(el52.style, mdStyle.li);
+// dbg -- pelNam: el52 children: 2
+const el53=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el52.appendChild(el53);
+// dbg -- el: el52 parent:el49 kind:List
+el49.appendChild(el52);
+// dbg -- el: el49 parent:mdDiv kind:Document
+mdDiv.appendChild(el49);
+let el54=document.createElement('p');
+Object.assign(el54.style, mdStyle.p);
+// dbg -- pelNam: el54 children: 3
+const el55=document.createTextNode(`List items may consist of multiple paragraphs. Each subsequentparagraph in a list item must be indented by either 4 spacesor one tab:`);
+el54.appendChild(el55);
+// dbg -- par el: el54 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el54);
+let el56= document.createElement('ol');
+Object.assign(el56.style, mdStyle.ol);
+let el57= document.createElement('li');
+Object.assign(el57.style, mdStyle.li);
+let el58=document.createElement('p');
+Object.assign(el58.style, mdStyle.p);
+// dbg -- pelNam: el58 children: 3
+const el59=document.createTextNode(`This is a list item with two paragraphs. Lorem ipsum dolorsit amet, consectetuer adipiscing elit. Aliquam hendreritmi posuere lectus.`);
+el58.appendChild(el59);
+// dbg -- par el: el58 kind: Paragraph parent:el57 kind:ListItem
+el57.appendChild(el58);
+let el60=document.createElement('p');
+Object.assign(el60.style, mdStyle.p);
+// dbg -- pelNam: el60 children: 3
+const el61=document.createTextNode(`Vestibulum enim wisi, viverra nec, fringilla in, laoreetvitae, risus. Donec sit amet nisl. Aliquam semper ipsumsit amet velit.`);
+el60.appendChild(el61);
+// dbg -- par el: el60 kind: Paragraph parent:el57 kind:ListItem
+el57.appendChild(el60);
+// dbg -- el: el57 parent:el56 kind:List
+el56.appendChild(el57);
+let el62= document.createElement('li');
+Object.assign(el62.style, mdStyle.li);
+let el63=document.createElement('p');
+Object.assign(el63.style, mdStyle.p);
+// dbg -- pelNam: el63 children: 1
+el63.textContent=`Suspendisse id sem consectetuer libero luctus adipiscing.`;
+// dbg -- par el: el63 kind: Paragraph parent:el62 kind:ListItem
+el62.appendChild(el63);
+// dbg -- el: el62 parent:el56 kind:List
+el56.appendChild(el62);
+// dbg -- el: el56 parent:mdDiv kind:Document
+mdDiv.appendChild(el56);
+let el64=document.createElement('p');
+Object.assign(el64.style, mdStyle.p);
+// dbg -- pelNam: el64 children: 3
+const el65=document.createTextNode(`It looks nice if you indent every line of the subsequentparagraphs, but here again, Markdown will allow you to belazy:`);
+el64.appendChild(el65);
+// dbg -- par el: el64 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el64);
+let el66= document.createElement('ul');
+Object.assign(el66.style, mdStyle.ul);
+let el67= document.createElement('li');
+Object.assign(el67.style, mdStyle.li);
+let el68=document.createElement('p');
+Object.assign(el68.style, mdStyle.p);
+// dbg -- pelNam: el68 children: 1
+el68.textContent=`This is a list item with two paragraphs.`;
+// dbg -- par el: el68 kind: Paragraph parent:el67 kind:ListItem
+el67.appendChild(el68);
+let el69=document.createElement('p');
+Object.assign(el69.style, mdStyle.p);
+// dbg -- pelNam: el69 children: 3
+const el70=document.createTextNode(`This is the second paragraph in the list item. You'reonly required to indent the first line. Lorem ipsum dolorsit amet, consectetuer adipiscing elit.`);
+el69.appendChild(el70);
+// dbg -- par el: el69 kind: Paragraph parent:el67 kind:ListItem
+el67.appendChild(el69);
+// dbg -- el: el67 parent:el66 kind:List
+el66.appendChild(el67);
+let el71= document.createElement('li');
+Object.assign(el71.style, mdStyle.li);
+let el72=document.createElement('p');
+Object.assign(el72.style, mdStyle.p);
+// dbg -- pelNam: el72 children: 1
+el72.textContent=`Another item in the same list.`;
+// dbg -- par el: el72 kind: Paragraph parent:el71 kind:ListItem
+el71.appendChild(el72);
+// dbg -- el: el71 parent:el66 kind:List
+el66.appendChild(el71);
+// dbg -- el: el66 parent:mdDiv kind:Document
+mdDiv.appendChild(el66);
+let el73=document.createElement('p');
+Object.assign(el73.style, mdStyle.p);
+// dbg -- pelNam: el73 children: 4
+const el74=document.createTextNode(`To put a blockquote within a list item, the blockquote's `);
+el73.appendChild(el74);
+let el75=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el75Span1=document.createTextNode('>');
+el75.appendChild(el75Span1);
+// dbg -- codespan el: el75 kind: CodeSpan parent:el73 kind:Paragraph
+el73.appendChild(el75);
+const el76=document.createTextNode(`delimiters need to be indented:`);
+el73.appendChild(el76);
+// dbg -- par el: el73 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el73);
+let el77= document.createElement('ul');
+Object.assign(el77.style, mdStyle.ul);
+let el78= document.createElement('li');
+Object.assign(el78.style, mdStyle.li);
+let el79=document.createElement('p');
+Object.assign(el79.style, mdStyle.p);
+// dbg -- pelNam: el79 children: 1
+el79.textContent=`A list item with a blockquote:`;
+// dbg -- par el: el79 kind: Paragraph parent:el78 kind:ListItem
+el78.appendChild(el79);
+let el80= document.createElement('blockquote');
+Object.assign(el80.style, mdStyle.block);
+let el81=document.createElement('p');
+Object.assign(el81.style, mdStyle.p);
+// dbg -- pelNam: el81 children: 2
+const el82=document.createTextNode(`This is a blockquoteinside a list item.`);
+el81.appendChild(el82);
+// dbg -- par el: el81 kind: Paragraph parent:el80 kind:Blockquote
+el80.appendChild(el81);
+// dbg -- el: el80 parent:el78 kind:ListItem
+el78.appendChild(el80);
+// dbg -- el: el78 parent:el77 kind:List
+el77.appendChild(el78);
+// dbg -- el: el77 parent:mdDiv kind:Document
+mdDiv.appendChild(el77);
+let el83=document.createElement('p');
+Object.assign(el83.style, mdStyle.p);
+// dbg -- pelNam: el83 children: 4
+const el84=document.createTextNode(`To put a code block within a list item, the code block needsto be indented `);
+el83.appendChild(el84);
+let el85=document.createElement('em');
+// dbg -- pelNam: el85 children: 1
+el85.textContent=`twice`;
+el83.appendChild(el85);
+const el86=document.createTextNode(` -- 8 spaces or two tabs:`);
+el83.appendChild(el86);
+// dbg -- par el: el83 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el83);
+let el87= document.createElement('ul');
+Object.assign(el87.style, mdStyle.ul);
+let el88= document.createElement('li');
+Object.assign(el88.style, mdStyle.li);
+let el89=document.createElement('p');
+Object.assign(el89.style, mdStyle.p);
+// dbg -- pelNam: el89 children: 1
+el89.textContent=`A list item with a code block:`;
+// dbg -- par el: el89 kind: Paragraph parent:el88 kind:ListItem
+el88.appendChild(el89);
+let el90= document.createElement('pre');
+let el91= document.createElement('code');
+const el92= document.createTextNode(`<code goes here>
+`);
+el91.appendChild(el92);
+el90.appendChild(el91);
+// dbg -- el: el90 parent:el88 kind:ListItem
+el88.appendChild(el90);
+// dbg -- el: el88 parent:el87 kind:List
+el87.appendChild(el88);
+// dbg -- el: el87 parent:mdDiv kind:Document
+mdDiv.appendChild(el87);
+let el93=document.createElement('p');
+Object.assign(el93.style, mdStyle.p);
+// dbg -- pelNam: el93 children: 1
+el93.textContent=`End of List Test`;
+// dbg -- par el: el93 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el93);
+return mdDiv;
+};
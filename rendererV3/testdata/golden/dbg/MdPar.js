@@ -0,0 +1,141 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h2');
+Object.assign(el2.style, mdStyle.h2);
+el2.id='block-element-test';
+const el3Txt= `Block Element Test`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4= document.createElement('h3');
+Object.assign(el4.style, mdStyle.h3);
+el4.id='paragraphs-with-links';
+const el5Txt= `Paragraphs with links`;
+const el5=document.createTextNode(el5Txt);
+// dbg -- el: el5 parent:el4 kind:Heading
+el4.appendChild(el5);
+// dbg -- el: el4 parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el6=document.createElement('p');
+Object.assign(el6.style, mdStyle.p);
+// dbg -- pelNam: el6 children: 4
+const el7=document.createTextNode(`A paragraph is simply one or more consecutive lines of text, separatedby one or more blank lines. (A blank line is any line that looks like ablank line -- a line containing nothing but spaces or tabs is consideredblank.) Normal paragraphs should not be indented with spaces or tabs.`);
+el6.appendChild(el7);
+// dbg -- par el: el6 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el6);
+let el8=document.createElement('p');
+Object.assign(el8.style, mdStyle.p);
+// dbg -- pelNam: el8 children: 7
+const el9=document.createTextNode(`The implication of the "one or more consecutive lines of text" rule isthat Markdown supports "hard-wrapped" text paragraphs. This differssignificantly from most other text-to-HTML formatters (including MovableType's "Convert Line Breaks" option) which translate every line breakcharacter in a paragraph into a `);
+el8.appendChild(el9);
+let el10=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[6]: "<br />"
+const el10Span1=document.createTextNode('<br />');
+el10.appendChild(el10Span1);
+// dbg -- codespan el: el10 kind: CodeSpan parent:el8 kind:Paragraph
+el8.appendChild(el10);
+const el11=document.createTextNode(` tag.`);
+el8.appendChild(el11);
+// dbg -- par el: el8 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el8);
+let el12=document.createElement('p');
+Object.assign(el12.style, mdStyle.p);
+// dbg -- pelNam: el12 children: 6
+const el13=document.createTextNode(`When you `);
+el12.appendChild(el13);
+let el14=document.createElement('em');
+// dbg -- pelNam: el14 children: 1
+el14.textContent=`do`;
+el12.appendChild(el14);
+const el15=document.createTextNode(` want to insert a `);
+el12.appendChild(el15);
+let el16=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[6]: "<br />"
+const el16Span1=document.createTextNode('<br />');
+el16.appendChild(el16Span1);
+// dbg -- codespan el: el16 kind: CodeSpan parent:el12 kind:Paragraph
+el12.appendChild(el16);
+const el17=document.createTextNode(` break tag using Markdown, youend a line with two or more spaces, then type return.`);
+el12.appendChild(el17);
+// dbg -- par el: el12 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el12);
+let el18= document.createElement('h3');
+Object.assign(el18.style, mdStyle.h3);
+el18.id='hardcoded-ends';
+const el19Txt= `hardcoded ends`;
+const el19=document.createTextNode(el19Txt);
+// dbg -- el: el19 parent:el18 kind:Heading
+el18.appendChild(el19);
+// dbg -- el: el18 parent:mdDiv kind:Document
+mdDiv.appendChild(el18);
+let el20=document.createElement('p');
+Object.assign(el20.style, mdStyle.p);
+// dbg -- pelNam: el20 children: 4
+const el21=document.createTextNode(`A paragraph is simply one or more consecutive lines of text, separatedby one or more blank lines. (A blank line is any line that looks like ablank line -- a line containing nothing but spaces or tabs is consideredblank.) Normal paragraphs should not be indented with spaces or tabs.`);
+el20.appendChild(el21);
+// dbg -- par el: el20 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el20);
+let el22= document.createElement('h3');
+Object.assign(el22.style, mdStyle.h3);
+el22.id='emphasis';
+const el23Txt= `emphasis`;
+const el23=document.createTextNode(el23Txt);
+// dbg -- el: el23 parent:el22 kind:Heading
+el22.appendChild(el23);
+// dbg -- el: el22 parent:mdDiv kind:Document
+mdDiv.appendChild(el22);
+let el24=document.createElement('p');
+Object.assign(el24.style, mdStyle.p);
+// dbg -- pelNam: el24 children: 12
+const el25=document.createTextNode(`This example demonstrates how to `);
+el24.appendChild(el25);
+let el26=document.createElement('em');
+// dbg -- pelNam: el26 children: 1
+el26.textContent=`emphasize`;
+el24.appendChild(el26);
+const el27=document.createTextNode(` a word in a text. Emphsias can befurther increased by makea a word `);
+el24.appendChild(el27);
+let el28=document.createElement('strong');
+// dbg -- pelNam: el28 children: 1
+el28.textContent=`bold`;
+el24.appendChild(el28);
+const el29=document.createTextNode(`.There are other methods of `);
+el24.appendChild(el29);
+let el30=document.createElement('em');
+// dbg -- pelNam: el30 children: 1
+el30.textContent=`emphasizing`;
+el24.appendChild(el30);
+const el31=document.createTextNode(` a word in a text. In this example the underscorecharacter is used to `);
+el24.appendChild(el31);
+let el32=document.createElement('strong');
+// dbg -- pelNam: el32 children: 1
+el32.textContent=`bolden`;
+el24.appendChild(el32);
+const el33=document.createTextNode(` a word.`);
+el24.appendChild(el33);
+// dbg -- par el: el24 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el24);
+let el34=document.createElement('p');
+Object.assign(el34.style, mdStyle.p);
+// dbg -- pelNam: el34 children: 1
+let el35=document.createElement('strong');
+// dbg -- pelNam: el35 children: 1
+el35.textContent=`End of Link Element Test`;
+el34.appendChild(el35);
+// dbg -- par el: el34 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el34);
+return mdDiv;
+};
@@ -0,0 +1,229 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h2');
+Object.assign(el2.style, mdStyle.h2);
+el2.id='more-lists';
+const el3Txt= `More Lists`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+// dbg -- pelNam: el4 children: 1
+el4.textContent=`To make lists look nice, you can wrap items with hanging indents:`;
+// dbg -- par el: el4 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+// dbg -- pelNam: el6 children: 3
+const el7=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el6.appendChild(el7);
+// dbg -- el: el6 parent:el5 kind:List
+el5.appendChild(el6);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+// dbg -- pelNam: el8 children: 2
+const el9=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el8.appendChild(el9);
+// dbg -- el: el8 parent:el5 kind:List
+el5.appendChild(el8);
+// dbg -- el: el5 parent:mdDiv kind:Document
+mdDiv.appendChild(el5);
+let el10=document.createElement('p');
+Object.assign(el10.style, mdStyle.p);
+// dbg -- pelNam: el10 children: 1
+el10.textContent=`But if you want to be lazy, you don't have to:`;
+// dbg -- par el: el10 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el10);
+let el11= document.createElement('ul');
+Object.assign(el11.style, mdStyle.ul);
+let el12= document.createElement('li');
+Object.assign(el12.style, mdStyle.li);
+// dbg -- pelNam: el12 children: 3
+const el13=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el12.appendChild(el13);
+// dbg -- el: el12 parent:el11 kind:List
+el11.appendChild(el12);
+let el14= document.createElement('li');
+Object.assign(el14.style, mdStyle.li);
+// dbg -- pelNam: el14 children: 2
+const el15=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el14.appendChild(el15);
+// dbg -- el: el14 parent:el11 kind:List
+el11.appendChild(el14);
+// dbg -- el: el11 parent:mdDiv kind:Document
+mdDiv.appendChild(el11);
+let el16=document.createElement('p');
+Object.assign(el16.style, mdStyle.p);
+// dbg -- pelNam: el16 children: 3
+const el17=document.createTextNode(`List items may consist of multiple paragraphs. Each subsequentparagraph in a list item must be indented by either 4 spacesor one tab:`);
+el16.appendChild(el17);
+// dbg -- par el: el16 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el16);
+let el18= document.createElement('ol');
+Object.assign(el18.style, mdStyle.ol);
+let el19= document.createElement('li');
+Object.assign(el19.style, mdStyle.li);
+let el20=document.createElement('p');
+Object.assign(el20.style, mdStyle.p);
+// dbg -- pelNam: el20 children: 3
+const el21=document.createTextNode(`This is a list item with two paragraphs. Lorem ipsum dolorsit amet, consectetuer adipiscing elit. Aliquam hendreritmi posuere lectus.`);
+el20.appendChild(el21);
+// dbg -- par el: el20 kind: Paragraph parent:el19 kind:ListItem
+el19.appendChild(el20);
+let el22=document.createElement('p');
+Object.assign(el22.style, mdStyle.p);
+// dbg -- pelNam: el22 children: 3
+const el23=document.createTextNode(`Vestibulum enim wisi, viverra nec, fringilla in, laoreetvitae, risus. Donec sit amet nisl. Aliquam semper ipsumsit amet velit.`);
+el22.appendChild(el23);
+// dbg -- par el: el22 kind: Paragraph parent:el19 kind:ListItem
+el19.appendChild(el22);
+// dbg -- el: el19 parent:el18 kind:List
+el18.appendChild(el19);
+let el24= document.createElement('li');
+Object.assign(el24.style, mdStyle.li);
+let el25=document.createElement('p');
+Object.assign(el25.style, mdStyle.p);
+// dbg -- pelNam: el25 children: 1
+el25.textContent=`Suspendisse id sem consectetuer libero luctus adipiscing.`;
+// dbg -- par el: el25 kind: Paragraph parent:el24 kind:ListItem
+el24.appendChild(el25);
+// dbg -- el: el24 parent:el18 kind:List
+el18.appendChild(el24);
+// dbg -- el: el18 parent:mdDiv kind:Document
+mdDiv.appendChild(el18);
+let el26=document.createElement('p');
+Object.assign(el26.style, mdStyle.p);
+// dbg -- pelNam: el26 children: 3
+const el27=document.createTextNode(`It looks nice if you indent every line of the subsequentparagraphs, but here again, Markdown will allow you to belazy:`);
+el26.appendChild(el27);
+// dbg -- par el: el26 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el26);
+let el28= document.createElement('ul');
+Object.assign(el28.style, mdStyle.ul);
+let el29= document.createElement('li');
+Object.assign(el29.style, mdStyle.li);
+let el30=document.createElement('p');
+Object.assign(el30.style, mdStyle.p);
+// dbg -- pelNam: el30 children: 1
+el30.textContent=`This is a list item with two paragraphs.`;
+// dbg -- par el: el30 kind: Paragraph parent:el29 kind:ListItem
+el29.appendChild(el30);
+let el31=document.createElement('p');
+Object.assign(el31.style, mdStyle.p);
+// dbg -- pelNam: el31 children: 3
+const el32=document.createTextNode(`This is the second paragraph in the list item. You'reonly required to indent the first line. Lorem ipsum dolorsit amet, consectetuer adipiscing elit.`);
+el31.appendChild(el32);
+// dbg -- par el: el31 kind: Paragraph parent:el29 kind:ListItem
+el29.appendChild(el31);
+// dbg -- el: el29 parent:el28 kind:List
+el28.appendChild(el29);
+let el33= document.createElement('li');
+Object.assign(el33.style, mdStyle.li);
+let el34=document.createElement('p');
+Object.assign(el34.style, mdStyle.p);
+// dbg -- pelNam: el34 children: 1
+el34.textContent=`Another item in the same list.`;
+// dbg -- par el: el34 kind: Paragraph parent:el33 kind:ListItem
+el33.appendChild(el34);
+// dbg -- el: el33 parent:el28 kind:List
+el28.appendChild(el33);
+// dbg -- el: el28 parent:mdDiv kind:Document
+mdDiv.appendChild(el28);
+let el35=document.createElement('p');
+Object.assign(el35.style, mdStyle.p);
+// dbg -- pelNam: el35 children: 4
+const el36=document.createTextNode(`To put a blockquote within a list item, the blockquote's `);
+el35.appendChild(el36);
+let el37=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el37Span1=document.createTextNode('>');
+el37.appendChild(el37Span1);
+// dbg -- codespan el: el37 kind: CodeSpan parent:el35 kind:Paragraph
+el35.appendChild(el37);
+const el38=document.createTextNode(`delimiters need to be indented:`);
+el35.appendChild(el38);
+// dbg -- par el: el35 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el35);
+let el39= document.createElement('ul');
+Object.assign(el39.style, mdStyle.ul);
+let el40= document.createElement('li');
+Object.assign(el40.style, mdStyle.li);
+let el41=document.createElement('p');
+Object.assign(el41.style, mdStyle.p);
+// dbg -- pelNam: el41 children: 1
+el41.textContent=`A list item with a blockquote:`;
+// dbg -- par el: el41 kind: Paragraph parent:el40 kind:ListItem
+el40.appendChild(el41);
+let el42= document.createElement('blockquote');
+Object.assign(el42.style, mdStyle.block);
+let el43=document.createElement('p');
+Object.assign(el43.style, mdStyle.p);
+// dbg -- pelNam: el43 children: 2
+const el44=document.createTextNode(`This is a blockquoteinside a list item.`);
+el43.appendChild(el44);
+// dbg -- par el: el43 kind: Paragraph parent:el42 kind:Blockquote
+el42.appendChild(el43);
+// dbg -- el: el42 parent:el40 kind:ListItem
+el40.appendChild(el42);
+// dbg -- el: el40 parent:el39 kind:List
+el39.appendChild(el40);
+// dbg -- el: el39 parent:mdDiv kind:Document
+mdDiv.appendChild(el39);
+let el45=document.createElement('p');
+Object.assign(el45.style, mdStyle.p);
+// dbg -- pelNam: el45 children: 4
+const el46=document.createTextNode(`To put a code block within a list item, the code block needsto be indented `);
+el45.appendChild(el46);
+let el47=document.createElement('em');
+// dbg -- pelNam: el47 children: 1
+el47.textContent=`twice`;
+el45.appendChild(el47);
+const el48=document.createTextNode(` -- 8 spaces or two tabs:`);
+el45.appendChild(el48);
+// dbg -- par el: el45 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el45);
+let el49= document.createElement('ul');
+Object.assign(el49.style, mdStyle.ul);
+let el50= document.createElement('li');
+Object.assign(el50.style, mdStyle.li);
+let el51=document.createElement('p');
+Object.assign(el51.style, mdStyle.p);
+// dbg -- pelNam: el51 children: 1
+el51.textContent=`A list item with a code block:`;
+// dbg -- par el: el51 kind: Paragraph parent:el50 kind:ListItem
+el50.appendChild(el51);
+let el52= document.createElement('pre');
+let el53= document.createElement('code');
+const el54= document.createTextNode(`<code goes here>
+`);
+el53.appendChild(el54);
+el52.appendChild(el53);
+// dbg -- el: el52 parent:el50 kind:ListItem
+el50.appendChild(el52);
+// dbg -- el: el50 parent:el49 kind:List
+el49.appendChild(el50);
+// dbg -- el: el49 parent:mdDiv kind:Document
+mdDiv.appendChild(el49);
+let el55=document.createElement('p');
+Object.assign(el55.style, mdStyle.p);
+// dbg -- pelNam: el55 children: 1
+el55.textContent=`end of more lists`;
+// dbg -- par el: el55 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el55);
+return mdDiv;
+};
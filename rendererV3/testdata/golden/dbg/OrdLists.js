@@ -0,0 +1,121 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h2');
+Object.assign(el2.style, mdStyle.h2);
+el2.id='ordered-lists';
+const el3Txt= `Ordered Lists`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+// dbg -- pelNam: el4 children: 1
+el4.textContent=`Ordered lists use numbers followed by periods:`;
+// dbg -- par el: el4 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el5= document.createElement('ol');
+Object.assign(el5.style, mdStyle.ol);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+// dbg -- pelNam: el6 children: 1
+el6.textContent=`Bird`;
+// dbg -- el: el6 parent:el5 kind:List
+el5.appendChild(el6);
+let el7= document.createElement('li');
+Object.assign(el7.style, mdStyle.li);
+// dbg -- pelNam: el7 children: 1
+el7.textContent=`McHale`;
+// dbg -- el: el7 parent:el5 kind:List
+el5.appendChild(el7);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+// dbg -- pelNam: el8 children: 1
+el8.textContent=`Parish`;
+// dbg -- el: el8 parent:el5 kind:List
+el5.appendChild(el8);
+// dbg -- el: el5 parent:mdDiv kind:Document
+mdDiv.appendChild(el5);
+let el9=document.createElement('p');
+Object.assign(el9.style, mdStyle.p);
+// dbg -- pelNam: el9 children: 3
+const el10=document.createTextNode(`It's important to note that the actual numbers you use to mark thelist have no effect on the HTML output Markdown produces. The HTMLMarkdown produces from the above list is:`);
+el9.appendChild(el10);
+// dbg -- par el: el9 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el9);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+// dbg -- pelNam: el11 children: 1
+el11.textContent=`If you instead wrote the list in Markdown like this:`;
+// dbg -- par el: el11 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el11);
+let el12= document.createElement('ol');
+Object.assign(el12.style, mdStyle.ol);
+let el13= document.createElement('li');
+Object.assign(el13.style, mdStyle.li);
+// dbg -- pelNam: el13 children: 1
+el13.textContent=`Bird`;
+// dbg -- el: el13 parent:el12 kind:List
+el12.appendChild(el13);
+let el14= document.createElement('li');
+Object.assign(el14.style, mdStyle.li);
+// dbg -- pelNam: el14 children: 1
+el14.textContent=`McHale`;
+// dbg -- el: el14 parent:el12 kind:List
+el12.appendChild(el14);
+let el15= document.createElement('li');
+Object.assign(el15.style, mdStyle.li);
+// dbg -- pelNam: el15 children: 1
+el15.textContent=`Parish`;
+// dbg -- el: el15 parent:el12 kind:List
+el12.appendChild(el15);
+// dbg -- el: el12 parent:mdDiv kind:Document
+mdDiv.appendChild(el12);
+let el16=document.createElement('p');
+Object.assign(el16.style, mdStyle.p);
+// dbg -- pelNam: el16 children: 1
+el16.textContent=`or even:`;
+// dbg -- par el: el16 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el16);
+let el17= document.createElement('ol');
+el17.start='3';
+Object.assign(el17.style, mdStyle.ol);
+let el18= document.createElement('li');
+Object.assign(el18.style, mdStyle.li);
+// dbg -- pelNam: el18 children: 1
+el18.textContent=`Bird`;
+// dbg -- el: el18 parent:el17 kind:List
+el17.appendChild(el18);
+let el19= document.createElement('li');
+Object.assign(el19.style, mdStyle.li);
+// dbg -- pelNam: el19 children: 1
+el19.textContent=`McHale`;
+// dbg -- el: el19 parent:el17 kind:List
+el17.appendChild(el19);
+let el20= document.createElement('li');
+Object.assign(el20.style, mdStyle.li);
+// dbg -- pelNam: el20 children: 1
+el20.textContent=`Parish`;
+// dbg -- el: el20 parent:el17 kind:List
+el17.appendChild(el20);
+// dbg -- el: el17 parent:mdDiv kind:Document
+mdDiv.appendChild(el17);
+let el21=document.createElement('p');
+Object.assign(el21.style, mdStyle.p);
+// dbg -- pelNam: el21 children: 4
+const el22=document.createTextNode(`you'd get the exact same HTML output. The point is, if you want to,you can use ordinal numbers in your ordered Markdown lists, so thatthe numbers in your source match the numbers in your published HTML.But if you want to be lazy, you don't have to.`);
+el21.appendChild(el22);
+// dbg -- par el: el21 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el21);
+return mdDiv;
+};
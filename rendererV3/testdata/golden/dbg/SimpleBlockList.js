@@ -0,0 +1,68 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2=document.createElement('p');
+Object.assign(el2.style, mdStyle.p);
+// dbg -- pelNam: el2 children: 4
+const el3=document.createTextNode(`you'd get the exact same HTML output. The point is, if you want to,you can use ordinal numbers in your ordered Markdown lists, so thatthe numbers in your source match the numbers in your published HTML.But if you want to be lazy, you don't have to.`);
+el2.appendChild(el3);
+// dbg -- par el: el2 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+// dbg -- pelNam: el4 children: 1
+el4.textContent=`To make lists look nice, you can wrap items with hanging indents:`;
+// dbg -- par el: el4 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+// dbg -- pelNam: el6 children: 3
+const el7=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el6.appendChild(el7);
+// dbg -- el: el6 parent:el5 kind:List
+el5.appendChild(el6);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+// dbg -- pelNam: el8 children: 2
+const el9=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el8.appendChild(el9);
+// dbg -- el: el8 parent:el5 kind:List
+el5.appendChild(el8);
+// dbg -- el: el5 parent:mdDiv kind:Document
+mdDiv.appendChild(el5);
+let el10=document.createElement('p');
+Object.assign(el10.style, mdStyle.p);
+// dbg -- pelNam: el10 children: 1
+el10.textContent=`But if you want to be lazy, you don't have to:`;
+// dbg -- par el: el10 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el10);
+let el11= document.createElement('ul');
+Object.assign(el11.style, mdStyle.ul);
+let el12= document.createElement('li');
+Object.assign(el12.style, mdStyle.li);
+// dbg -- pelNam: el12 children: 3
+const el13=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el12.appendChild(el13);
+// dbg -- el: el12 parent:el11 kind:List
+el11.appendChild(el12);
+let el14= document.createElement('li');
+Object.assign(el14.style, mdStyle.li);
+// dbg -- pelNam: el14 children: 2
+const el15=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el14.appendChild(el15);
+// dbg -- el: el14 parent:el11 kind:List
+el11.appendChild(el14);
+// dbg -- el: el11 parent:mdDiv kind:Document
+mdDiv.appendChild(el11);
+return mdDiv;
+};
@@ -0,0 +1,1416 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h1');
+Object.assign(el2.style, mdStyle.h1);
+el2.id='markdown-syntax';
+const el3Txt= `Markdown: Syntax`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4= document.createElement('ul');
+Object.assign(el4.style, mdStyle.ul);
+let el5= document.createElement('li');
+Object.assign(el5.style, mdStyle.li);
+// dbg -- pelNam: el5 children: 1
+let el6=document.createElement("a");
+el6.href='#overview';
+Object.assign(el6.style, mdStyle.a);
+el6.textContent=`Overview`;
+// dbg -- el: el6 parent:el5 kind:TextBlock
+el5.appendChild(el6);
+let el7= document.createElement('ul');
+Object.assign(el7.style, mdStyle.ul);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+// dbg -- pelNam: el8 children: 1
+let el9=document.createElement("a");
+el9.href='#philosophy';
+Object.assign(el9.style, mdStyle.a);
+el9.textContent=`Philosophy`;
+// dbg -- el: el9 parent:el8 kind:TextBlock
+el8.appendChild(el9);
+// dbg -- el: el8 parent:el7 kind:List
+el7.appendChild(el8);
+let el10= document.createElement('li');
+Object.assign(el10.style, mdStyle.li);
+// dbg -- pelNam: el10 children: 1
+let el11=document.createElement("a");
+el11.href='#html';
+Object.assign(el11.style, mdStyle.a);
+el11.textContent=`Inline HTML`;
+// dbg -- el: el11 parent:el10 kind:TextBlock
+el10.appendChild(el11);
+// dbg -- el: el10 parent:el7 kind:List
+el7.appendChild(el10);
+let el12= document.createElement('li');
+Object.assign(el12.style, mdStyle.li);
+// dbg -- pelNam: el12 children: 1
+let el13=document.createElement("a");
+el13.href='#autoescape';
+Object.assign(el13.style, mdStyle.a);
+el13.textContent=`Automatic Escaping for Special Characters`;
+// dbg -- el: el13 parent:el12 kind:TextBlock
+el12.appendChild(el13);
+// dbg -- el: el12 parent:el7 kind:List
+el7.appendChild(el12);
+// dbg -- el: el7 parent:el5 kind:ListItem
+el5.appendChild(el7);
+// dbg -- el: el5 parent:el4 kind:List
+el4.appendChild(el5);
+let el14= document.createElement('li');
+Object.assign(el14.style, mdStyle.li);
+// dbg -- pelNam: el14 children: 1
+let el15=document.createElement("a");
+el15.href='#block';
+Object.assign(el15.style, mdStyle.a);
+el15.textContent=`Block Elements`;
+// dbg -- el: el15 parent:el14 kind:TextBlock
+el14.appendChild(el15);
+let el16= document.createElement('ul');
+Object.assign(el16.style, mdStyle.ul);
+let el17= document.createElement('li');
+Object.assign(el17.style, mdStyle.li);
+// dbg -- pelNam: el17 children: 1
+let el18=document.createElement("a");
+el18.href='#p';
+Object.assign(el18.style, mdStyle.a);
+el18.textContent=`Paragraphs and Line Breaks`;
+// dbg -- el: el18 parent:el17 kind:TextBlock
+el17.appendChild(el18);
+// dbg -- el: el17 parent:el16 kind:List
+el16.appendChild(el17);
+let el19= document.createElement('li');
+Object.assign(el19.style, mdStyle.li);
+// dbg -- pelNam: el19 children: 1
+let el20=document.createElement("a");
+el20.href='#header';
+Object.assign(el20.style, mdStyle.a);
+el20.textContent=`Headers`;
+// dbg -- el: el20 parent:el19 kind:TextBlock
+el19.appendChild(el20);
+// dbg -- el: el19 parent:el16 kind:List
+el16.appendChild(el19);
+let el21= document.createElement('li');
+Object.assign(el21.style, mdStyle.li);
+// dbg -- pelNam: el21 children: 1
+let el22=document.createElement("a");
+el22.href='#blockquote';
+Object.assign(el22.style, mdStyle.a);
+el22.textContent=`Blockquotes`;
+// dbg -- el: el22 parent:el21 kind:TextBlock
+el21.appendChild(el22);
+// dbg -- el: el21 parent:el16 kind:List
+el16.appendChild(el21);
+let el23= document.createElement('li');
+Object.assign(el23.style, mdStyle.li);
+// dbg -- pelNam: el23 children: 1
+let el24=document.createElement("a");
+el24.href='#list';
+Object.assign(el24.style, mdStyle.a);
+el24.textContent=`Lists`;
+// dbg -- el: el24 parent:el23 kind:TextBlock
+el23.appendChild(el24);
+// dbg -- el: el23 parent:el16 kind:List
+el16.appendChild(el23);
+let el25= document.createElement('li');
+Object.assign(el25.style, mdStyle.li);
+// dbg -- pelNam: el25 children: 1
+let el26=document.createElement("a");
+el26.href='#precode';
+Object.assign(el26.style, mdStyle.a);
+el26.textContent=`Code Blocks`;
+// dbg -- el: el26 parent:el25 kind:TextBlock
+el25.appendChild(el26);
+// dbg -- el: el25 parent:el16 kind:List
+el16.appendChild(el25);
+let el27= document.createElement('li');
+Object.assign(el27.style, mdStyle.li);
+// dbg -- pelNam: el27 children: 1
+let el28=document.createElement("a");
+el28.href='#hr';
+Object.assign(el28.style, mdStyle.a);
+el28.textContent=`Horizontal Rules`;
+// dbg -- el: el28 parent:el27 kind:TextBlock
+el27.appendChild(el28);
+// dbg -- el: el27 parent:el16 kind:List
+el16.appendChild(el27);
+// dbg -- el: el16 parent:el14 kind:ListItem
+el14.appendChild(el16);
+// dbg -- el: el14 parent:el4 kind:List
+el4.appendChild(el14);
+let el29= document.createElement('li');
+Object.assign(el29.style, mdStyle.li);
+// dbg -- pelNam: el29 children: 1
+let el30=document.createElement("a");
+el30.href='#span';
+Object.assign(el30.style, mdStyle.a);
+el30.textContent=`Span Elements`;
+// dbg -- el: el30 parent:el29 kind:TextBlock
+el29.appendChild(el30);
+let el31= document.createElement('ul');
+Object.assign(el31.style, mdStyle.ul);
+let el32= document.createElement('li');
+Object.assign(el32.style, mdStyle.li);
+// dbg -- pelNam: el32 children: 1
+let el33=document.createElement("a");
+el33.href='#link';
+Object.assign(el33.style, mdStyle.a);
+el33.textContent=`Links`;
+// dbg -- el: el33 parent:el32 kind:TextBlock
+el32.appendChild(el33);
+// dbg -- el: el32 parent:el31 kind:List
+el31.appendChild(el32);
+let el34= document.createElement('li');
+Object.assign(el34.style, mdStyle.li);
+// dbg -- pelNam: el34 children: 1
+let el35=document.createElement("a");
+el35.href='#em';
+Object.assign(el35.style, mdStyle.a);
+el35.textContent=`Emphasis`;
+// dbg -- el: el35 parent:el34 kind:TextBlock
+el34.appendChild(el35);
+// dbg -- el: el34 parent:el31 kind:List
+el31.appendChild(el34);
+let el36= document.createElement('li');
+Object.assign(el36.style, mdStyle.li);
+// dbg -- pelNam: el36 children: 1
+let el37=document.createElement("a");
+el37.href='#code';
+Object.assign(el37.style, mdStyle.a);
+el37.textContent=`Code`;
+// dbg -- el: el37 parent:el36 kind:TextBlock
+el36.appendChild(el37);
+// dbg -- el: el36 parent:el31 kind:List
+el31.appendChild(el36);
+let el38= document.createElement('li');
+Object.assign(el38.style, mdStyle.li);
+// dbg -- pelNam: el38 children: 1
+let el39=document.createElement("a");
+el39.href='#img';
+Object.assign(el39.style, mdStyle.a);
+el39.textContent=`Images`;
+// dbg -- el: el39 parent:el38 kind:TextBlock
+el38.appendChild(el39);
+// dbg -- el: el38 parent:el31 kind:List
+el31.appendChild(el38);
+// dbg -- el: el31 parent:el29 kind:ListItem
+el29.appendChild(el31);
+// dbg -- el: el29 parent:el4 kind:List
+el4.appendChild(el29);
+let el40= document.createElement('li');
+Object.assign(el40.style, mdStyle.li);
+// dbg -- pelNam: el40 children: 1
+let el41=document.createElement("a");
+el41.href='#misc';
+Object.assign(el41.style, mdStyle.a);
+el41.textContent=`Miscellaneous`;
+// dbg -- el: el41 parent:el40 kind:TextBlock
+el40.appendChild(el41);
+let el42= document.createElement('ul');
+Object.assign(el42.style, mdStyle.ul);
+let el43= document.createElement('li');
+Object.assign(el43.style, mdStyle.li);
+// dbg -- pelNam: el43 children: 1
+let el44=document.createElement("a");
+el44.href='#backslash';
+Object.assign(el44.style, mdStyle.a);
+el44.textContent=`Backslash Escapes`;
+// dbg -- el: el44 parent:el43 kind:TextBlock
+el43.appendChild(el44);
+// dbg -- el: el43 parent:el42 kind:List
+el42.appendChild(el43);
+let el45= document.createElement('li');
+Object.assign(el45.style, mdStyle.li);
+// dbg -- pelNam: el45 children: 1
+let el46=document.createElement("a");
+el46.href='#autolink';
+Object.assign(el46.style, mdStyle.a);
+el46.textContent=`Automatic Links`;
+// dbg -- el: el46 parent:el45 kind:TextBlock
+el45.appendChild(el46);
+// dbg -- el: el45 parent:el42 kind:List
+el42.appendChild(el45);
+// dbg -- el: el42 parent:el40 kind:ListItem
+el40.appendChild(el42);
+// dbg -- el: el40 parent:el4 kind:List
+el4.appendChild(el40);
+// dbg -- el: el4 parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el47=document.createElement('p');
+Object.assign(el47.style, mdStyle.p);
+// dbg -- pelNam: el47 children: 5
+let el48=document.createElement('strong');
+// dbg -- pelNam: el48 children: 1
+el48.textContent=`Note:`;
+el47.appendChild(el48);
+const el49=document.createTextNode(` This document is itself written using Markdown; youcan `);
+el47.appendChild(el49);
+let el50=document.createElement("a");
+el50.href='/projects/markdown/syntax.text';
+Object.assign(el50.style, mdStyle.a);
+el50.textContent=`see the source for it by adding '.text' to the URL`;
+// dbg -- el: el50 parent:el47 kind:Paragraph
+el47.appendChild(el50);
+const el51=document.createTextNode(`.`);
+el47.appendChild(el51);
+// dbg -- par el: el47 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el47);
+let el52=document.createElement('hr');
+// dbg -- el: el52 parent:mdDiv kind:Document
+mdDiv.appendChild(el52);
+let el53= document.createElement('h2');
+Object.assign(el53.style, mdStyle.h2);
+el53.id='overview';
+const el54Txt= `Overview`;
+const el54=document.createTextNode(el54Txt);
+// dbg -- el: el54 parent:el53 kind:Heading
+el53.appendChild(el54);
+// dbg -- el: el53 parent:mdDiv kind:Document
+mdDiv.appendChild(el53);
+let el55= document.createElement('h3');
+Object.assign(el55.style, mdStyle.h3);
+el55.id='philosophy';
+const el56Txt= `Philosophy`;
+const el56=document.createTextNode(el56Txt);
+// dbg -- el: el56 parent:el55 kind:Heading
+el55.appendChild(el56);
+// dbg -- el: el55 parent:mdDiv kind:Document
+mdDiv.appendChild(el55);
+let el57=document.createElement('p');
+Object.assign(el57.style, mdStyle.p);
+// dbg -- pelNam: el57 children: 1
+el57.textContent=`Markdown is intended to be as easy-to-read and easy-to-write as is feasible.`;
+// dbg -- par el: el57 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el57);
+let el58=document.createElement('p');
+Object.assign(el58.style, mdStyle.p);
+// dbg -- pelNam: el58 children: 18
+const el59=document.createTextNode(`Readability, however, is emphasized above all else. A Markdown-formatteddocument should be publishable as-is, as plain text, without lookinglike it's been marked up with tags or formatting instructions. WhileMarkdown's syntax has been influenced by several existing text-to-HTMLfilters -- including `);
+el58.appendChild(el59);
+let el60=document.createElement("a");
+el60.href='http://docutils.sourceforge.net/mirror/setext.html';
+Object.assign(el60.style, mdStyle.a);
+el60.textContent=`Setext`;
+// dbg -- el: el60 parent:el58 kind:Paragraph
+el58.appendChild(el60);
+const el61=document.createTextNode(`, `);
+el58.appendChild(el61);
+let el62=document.createElement("a");
+el62.href='http://www.aaronsw.com/2002/atx/';
+Object.assign(el62.style, mdStyle.a);
+el62.textContent=`atx`;
+// dbg -- el: el62 parent:el58 kind:Paragraph
+el58.appendChild(el62);
+const el63=document.createTextNode(`, `);
+el58.appendChild(el63);
+let el64=document.createElement("a");
+el64.href='http://textism.com/tools/textile/';
+Object.assign(el64.style, mdStyle.a);
+el64.textContent=`Textile`;
+// dbg -- el: el64 parent:el58 kind:Paragraph
+el58.appendChild(el64);
+const el65=document.createTextNode(`, `);
+el58.appendChild(el65);
+let el66=document.createElement("a");
+el66.href='http://docutils.sourceforge.net/rst.html';
+Object.assign(el66.style, mdStyle.a);
+el66.textContent=`reStructuredText`;
+// dbg -- el: el66 parent:el58 kind:Paragraph
+el58.appendChild(el66);
+const el67=document.createTextNode(`,`);
+el58.appendChild(el67);
+let el68=document.createElement("a");
+el68.href='http://www.triptico.com/software/grutatxt.html';
+Object.assign(el68.style, mdStyle.a);
+el68.textContent=`Grutatext`;
+// dbg -- el: el68 parent:el58 kind:Paragraph
+el58.appendChild(el68);
+const el69=document.createTextNode(`, and `);
+el58.appendChild(el69);
+let el70=document.createElement("a");
+el70.href='http://ettext.taint.org/doc/';
+Object.assign(el70.style, mdStyle.a);
+el70.textContent=`EtText`;
+// dbg -- el: el70 parent:el58 kind:Paragraph
+el58.appendChild(el70);
+const el71=document.createTextNode(` -- the single biggest source ofinspiration for Markdown's syntax is the format of plain text email.`);
+el58.appendChild(el71);
+// dbg -- par el: el58 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el58);
+let el72= document.createElement('h2');
+Object.assign(el72.style, mdStyle.h2);
+el72.id='block-elements';
+const el73Txt= `Block Elements`;
+const el73=document.createTextNode(el73Txt);
+// dbg -- el: el73 parent:el72 kind:Heading
+el72.appendChild(el73);
+// dbg -- el: el72 parent:mdDiv kind:Document
+mdDiv.appendChild(el72);
+let el74= document.createElement('h3');
+Object.assign(el74.style, mdStyle.h3);
+el74.id='paragraphs-and-line-breaks';
+const el75Txt= `Paragraphs and Line Breaks`;
+const el75=document.createTextNode(el75Txt);
+// dbg -- el: el75 parent:el74 kind:Heading
+el74.appendChild(el75);
+// dbg -- el: el74 parent:mdDiv kind:Document
+mdDiv.appendChild(el74);
+let el76=document.createElement('p');
+Object.assign(el76.style, mdStyle.p);
+// dbg -- pelNam: el76 children: 4
+const el77=document.createTextNode(`A paragraph is simply one or more consecutive lines of text, separatedby one or more blank lines. (A blank line is any line that looks like ablank line -- a line containing nothing but spaces or tabs is consideredblank.) Normal paragraphs should not be indented with spaces or tabs.`);
+el76.appendChild(el77);
+// dbg -- par el: el76 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el76);
+let el78=document.createElement('p');
+Object.assign(el78.style, mdStyle.p);
+// dbg -- pelNam: el78 children: 7
+const el79=document.createTextNode(`The implication of the "one or more consecutive lines of text" rule isthat Markdown supports "hard-wrapped" text paragraphs. This differssignificantly from most other text-to-HTML formatters (including MovableType's "Convert Line Breaks" option) which translate every line breakcharacter in a paragraph into a `);
+el78.appendChild(el79);
+let el80=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[6]: "<br />"
+const el80Span1=document.createTextNode('<br />');
+el80.appendChild(el80Span1);
+// dbg -- codespan el: el80 kind: CodeSpan parent:el78 kind:Paragraph
+el78.appendChild(el80);
+const el81=document.createTextNode(` tag.`);
+el78.appendChild(el81);
+// dbg -- par el: el78 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el78);
+let el82=document.createElement('p');
+Object.assign(el82.style, mdStyle.p);
+// dbg -- pelNam: el82 children: 6
+const el83=document.createTextNode(`When you `);
+el82.appendChild(el83);
+let el84=document.createElement('em');
+// dbg -- pelNam: el84 children: 1
+el84.textContent=`do`;
+el82.appendChild(el84);
+const el85=document.createTextNode(` want to insert a `);
+el82.appendChild(el85);
+let el86=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[6]: "<br />"
+const el86Span1=document.createTextNode('<br />');
+el86.appendChild(el86Span1);
+// dbg -- codespan el: el86 kind: CodeSpan parent:el82 kind:Paragraph
+el82.appendChild(el86);
+const el87=document.createTextNode(` break tag using Markdown, youend a line with two or more spaces, then type return.`);
+el82.appendChild(el87);
+// dbg -- par el: el82 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el82);
+let el88= document.createElement('h3');
+Object.assign(el88.style, mdStyle.h3);
+el88.id='headers';
+const el89Txt= `Headers`;
+const el89=document.createTextNode(el89Txt);
+// dbg -- el: el89 parent:el88 kind:Heading
+el88.appendChild(el89);
+// dbg -- el: el88 parent:mdDiv kind:Document
+mdDiv.appendChild(el88);
+let el90=document.createElement('p');
+Object.assign(el90.style, mdStyle.p);
+// dbg -- pelNam: el90 children: 6
+const el91=document.createTextNode(`Markdown supports two styles of headers, [Setext] [1] and [atx] [2].`);
+el90.appendChild(el91);
+// dbg -- par el: el90 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el90);
+let el92=document.createElement('p');
+Object.assign(el92.style, mdStyle.p);
+// dbg -- pelNam: el92 children: 5
+const el93=document.createTextNode(`Optionally, you may "close" atx-style headers. This is purelycosmetic -- you can use this if you think it looks better. Theclosing hashes don't even need to match the number of hashesused to open the header. (The number of opening hashesdetermines the header level.)`);
+el92.appendChild(el93);
+// dbg -- par el: el92 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el92);
+let el94= document.createElement('h3');
+Object.assign(el94.style, mdStyle.h3);
+el94.id='blockquotes';
+const el95Txt= `Blockquotes`;
+const el95=document.createTextNode(el95Txt);
+// dbg -- el: el95 parent:el94 kind:Heading
+el94.appendChild(el95);
+// dbg -- el: el94 parent:mdDiv kind:Document
+mdDiv.appendChild(el94);
+let el96=document.createElement('p');
+Object.assign(el96.style, mdStyle.p);
+// dbg -- pelNam: el96 children: 8
+const el97=document.createTextNode(`Markdown uses email-style `);
+el96.appendChild(el97);
+let el98=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el98Span1=document.createTextNode('>');
+el98.appendChild(el98Span1);
+// dbg -- codespan el: el98 kind: CodeSpan parent:el96 kind:Paragraph
+el96.appendChild(el98);
+const el99=document.createTextNode(` characters for blockquoting. If you'refamiliar with quoting passages of text in an email message, then youknow how to create a blockquote in Markdown. It looks best if you hardwrap the text and put a `);
+el96.appendChild(el99);
+let el100=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el100Span1=document.createTextNode('>');
+el100.appendChild(el100Span1);
+// dbg -- codespan el: el100 kind: CodeSpan parent:el96 kind:Paragraph
+el96.appendChild(el100);
+const el101=document.createTextNode(` before every line:`);
+el96.appendChild(el101);
+// dbg -- par el: el96 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el96);
+let el102= document.createElement('blockquote');
+Object.assign(el102.style, mdStyle.block);
+let el103=document.createElement('p');
+Object.assign(el103.style, mdStyle.p);
+// dbg -- pelNam: el103 children: 3
+const el104=document.createTextNode(`This is a blockquote with two paragraphs. Lorem ipsum dolor sit amet,consectetuer adipiscing elit. Aliquam hendrerit mi posuere lectus.Vestibulum enim wisi, viverra nec, fringilla in, laoreet vitae, risus.`);
+el103.appendChild(el104);
+// dbg -- par el: el103 kind: Paragraph parent:el102 kind:Blockquote
+el102.appendChild(el103);
+let el105=document.createElement('p');
+Object.assign(el105.style, mdStyle.p);
+// dbg -- pelNam: el105 children: 2
+const el106=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit. Suspendisseid sem consectetuer libero luctus adipiscing.`);
+el105.appendChild(el106);
+// dbg -- par el: el105 kind: Paragraph parent:el102 kind:Blockquote
+el102.appendChild(el105);
+// dbg -- el: el102 parent:mdDiv kind:Document
+mdDiv.appendChild(el102);
+let el107=document.createElement('p');
+Object.assign(el107.style, mdStyle.p);
+// dbg -- pelNam: el107 children: 4
+const el108=document.createTextNode(`Markdown allows you to be lazy and only put the `);
+el107.appendChild(el108);
+let el109=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el109Span1=document.createTextNode('>');
+el109.appendChild(el109Span1);
+// dbg -- codespan el: el109 kind: CodeSpan parent:el107 kind:Paragraph
+el107.appendChild(el109);
+const el110=document.createTextNode(` before the firstline of a hard-wrapped paragraph:`);
+el107.appendChild(el110);
+// dbg -- par el: el107 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el107);
+let el111= document.createElement('blockquote');
+Object.assign(el111.style, mdStyle.block);
+let el112=document.createElement('p');
+Object.assign(el112.style, mdStyle.p);
+// dbg -- pelNam: el112 children: 3
+const el113=document.createTextNode(`This is a blockquote with two paragraphs. Lorem ipsum dolor sit amet,consectetuer adipiscing elit. Aliquam hendrerit mi posuere lectus.Vestibulum enim wisi, viverra nec, fringilla in, laoreet vitae, risus.`);
+el112.appendChild(el113);
+// dbg -- par el: el112 kind: Paragraph parent:el111 kind:Blockquote
+el111.appendChild(el112);
+// dbg -- el: el111 parent:mdDiv kind:Document
+mdDiv.appendChild(el111);
+let el114= document.createElement('blockquote');
+Object.assign(el114.style, mdStyle.block);
+let el115=document.createElement('p');
+Object.assign(el115.style, mdStyle.p);
+// dbg -- pelNam: el115 children: 2
+const el116=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit. Suspendisseid sem consectetuer libero luctus adipiscing.`);
+el115.appendChild(el116);
+// dbg -- par el: el115 kind: Paragraph parent:el114 kind:Blockquote
+el114.appendChild(el115);
+// dbg -- el: el114 parent:mdDiv kind:Document
+mdDiv.appendChild(el114);
+let el117=document.createElement('p');
+Object.assign(el117.style, mdStyle.p);
+// dbg -- pelNam: el117 children: 4
+const el118=document.createTextNode(`Blockquotes can be nested (i.e. a blockquote-in-a-blockquote) byadding additional levels of `);
+el117.appendChild(el118);
+let el119=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el119Span1=document.createTextNode('>');
+el119.appendChild(el119Span1);
+// dbg -- codespan el: el119 kind: CodeSpan parent:el117 kind:Paragraph
+el117.appendChild(el119);
+const el120=document.createTextNode(`:`);
+el117.appendChild(el120);
+// dbg -- par el: el117 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el117);
+let el121= document.createElement('blockquote');
+Object.assign(el121.style, mdStyle.block);
+let el122=document.createElement('p');
+Object.assign(el122.style, mdStyle.p);
+// dbg -- pelNam: el122 children: 1
+el122.textContent=`This is the first level of quoting.`;
+// dbg -- par el: el122 kind: Paragraph parent:el121 kind:Blockquote
+el121.appendChild(el122);
+let el123= document.createElement('blockquote');
+Object.assign(el123.style, mdStyle.block);
+let el124=document.createElement('p');
+Object.assign(el124.style, mdStyle.p);
+// dbg -- pelNam: el124 children: 1
+el124.textContent=`This is nested blockquote.`;
+// dbg -- par el: el124 kind: Paragraph parent:el123 kind:Blockquote
+el123.appendChild(el124);
+// dbg -- el: el123 parent:el121 kind:Blockquote
+el121.appendChild(el123);
+let el125=document.createElement('p');
+Object.assign(el125.style, mdStyle.p);
+// dbg -- pelNam: el125 children: 1
+el125.textContent=`Back to the first level.`;
+// dbg -- par el: el125 kind: Paragraph parent:el121 kind:Blockquote
+el121.appendChild(el125);
+// dbg -- el: el121 parent:mdDiv kind:Document
+mdDiv.appendChild(el121);
+let el126=document.createElement('p');
+Object.assign(el126.style, mdStyle.p);
+// dbg -- pelNam: el126 children: 2
+const el127=document.createTextNode(`Blockquotes can contain other Markdown elements, including headers, lists,and code blocks:`);
+el126.appendChild(el127);
+// dbg -- par el: el126 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el126);
+let el128= document.createElement('blockquote');
+Object.assign(el128.style, mdStyle.block);
+let el129= document.createElement('h2');
+Object.assign(el129.style, mdStyle.h2);
+el129.id='this-is-a-header';
+const el130Txt= `This is a header.`;
+const el130=document.createTextNode(el130Txt);
+// dbg -- el: el130 parent:el129 kind:Heading
+el129.appendChild(el130);
+// dbg -- el: el129 parent:el128 kind:Blockquote
+el128.appendChild(el129);
+let el131= document.createElement('ol');
+Object.assign(el131.style, mdStyle.ol);
+let el132= document.createElement('li');
+Object.assign(el132.style, mdStyle.li);
+// dbg -- pelNam: el132 children: 1
+el132.textContent=`This is the first list item.`;
+// dbg -- el: el132 parent:el131 kind:List
+el131.appendChild(el132);
+let el133= document.createElement('li');
+Object.assign(el133.style, mdStyle.li);
+// dbg -- pelNam: el133 children: 1
+el133.textContent=`This is the second list item.`;
+// dbg -- el: el133 parent:el131 kind:List
+el131.appendChild(el133);
+// dbg -- el: el131 parent:el128 kind:Blockquote
+el128.appendChild(el131);
+let el134=document.createElement('p');
+Object.assign(el134.style, mdStyle.p);
+// dbg -- pelNam: el134 children: 1
+el134.textContent=`Here's some example code:`;
+// dbg -- par el: el134 kind: Paragraph parent:el128 kind:Blockquote
+el128.appendChild(el134);
+let el135= document.createElement('pre');
+let el136= document.createElement('code');
+const el137= document.createTextNode(`return shell_exec("echo $input | $markdown_script");
+`);
+el136.appendChild(el137);
+el135.appendChild(el136);
+// dbg -- el: el135 parent:el128 kind:Blockquote
+el128.appendChild(el135);
+// dbg -- el: el128 parent:mdDiv kind:Document
+mdDiv.appendChild(el128);
+let el138=document.createElement('p');
+Object.assign(el138.style, mdStyle.p);
+// dbg -- pelNam: el138 children: 3
+const el139=document.createTextNode(`Any decent text editor should make email-style quoting easy. Forexample, with BBEdit, you can make a selection and choose IncreaseQuote Level from the Text menu.`);
+el138.appendChild(el139);
+// dbg -- par el: el138 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el138);
+let el140= document.createElement('h3');
+Object.assign(el140.style, mdStyle.h3);
+el140.id='lists';
+const el141Txt= `Lists`;
+const el141=document.createTextNode(el141Txt);
+// dbg -- el: el141 parent:el140 kind:Heading
+el140.appendChild(el141);
+// dbg -- el: el140 parent:mdDiv kind:Document
+mdDiv.appendChild(el140);
+let el142=document.createElement('p');
+Object.assign(el142.style, mdStyle.p);
+// dbg -- pelNam: el142 children: 1
+el142.textContent=`Markdown supports ordered (numbered) and unordered (bulleted) lists.`;
+// dbg -- par el: el142 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el142);
+let el143=document.createElement('p');
+Object.assign(el143.style, mdStyle.p);
+// dbg -- pelNam: el143 children: 2
+const el144=document.createTextNode(`Unordered lists use asterisks, pluses, and hyphens -- interchangably-- as list markers:`);
+el143.appendChild(el144);
+// dbg -- par el: el143 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el143);
+let el145= document.createElement('ul');
+Object.assign(el145.style, mdStyle.ul);
+let el146= document.createElement('li');
+Object.assign(el146.style, mdStyle.li);
+// dbg -- pelNam: el146 children: 1
+el146.textContent=`Red`;
+// dbg -- el: el146 parent:el145 kind:List
+el145.appendChild(el146);
+let el147= document.createElement('li');
+Object.assign(el147.style, mdStyle.li);
+// dbg -- pelNam: el147 children: 1
+el147.textContent=`Green`;
+// dbg -- el: el147 parent:el145 kind:List
+el145.appendChild(el147);
+let el148= document.createElement('li');
+Object.assign(el148.style, mdStyle.li);
+// dbg -- pelNam: el148 children: 1
+el148.textContent=`Blue`;
+// dbg -- el: el148 parent:el145 kind:List
+el145.appendChild(el148);
+// dbg -- el: el145 parent:mdDiv kind:Document
+mdDiv.appendChild(el145);
+let el149=document.createElement('p');
+Object.assign(el149.style, mdStyle.p);
+// dbg -- pelNam: el149 children: 1
+el149.textContent=`is equivalent to:`;
+// dbg -- par el: el149 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el149);
+let el150= document.createElement('ul');
+Object.assign(el150.style, mdStyle.ul);
+let el151= document.createElement('li');
+Object.assign(el151.style, mdStyle.li);
+// dbg -- pelNam: el151 children: 1
+el151.textContent=`Red`;
+// dbg -- el: el151 parent:el150 kind:List
+el150.appendChild(el151);
+let el152= document.createElement('li');
+Object.assign(el152.style, mdStyle.li);
+// dbg -- pelNam: el152 children: 1
+el152.textContent=`Green`;
+// dbg -- el: el152 parent:el150 kind:List
+el150.appendChild(el152);
+let el153= document.createElement('li');
+Object.assign(el153.style, mdStyle.li);
+// dbg -- pelNam: el153 children: 1
+el153.textContent=`Blue`;
+// dbg -- el: el153 parent:el150 kind:List
+el150.appendChild(el153);
+// dbg -- el: el150 parent:mdDiv kind:Document
+mdDiv.appendChild(el150);
+let el154=document.createElement('p');
+Object.assign(el154.style, mdStyle.p);
+// dbg -- pelNam: el154 children: 1
+el154.textContent=`and:`;
+// dbg -- par el: el154 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el154);
+let el155= document.createElement('ul');
+Object.assign(el155.style, mdStyle.ul);
+let el156= document.createElement('li');
+Object.assign(el156.style, mdStyle.li);
+// dbg -- pelNam: el156 children: 1
+el156.textContent=`Red`;
+// dbg -- el: el156 parent:el155 kind:List
+el155.appendChild(el156);
+let el157= document.createElement('li');
+Object.assign(el157.style, mdStyle.li);
+// dbg -- pelNam: el157 children: 1
+el157.textContent=`Green`;
+// dbg -- el: el157 parent:el155 kind:List
+el155.appendChild(el157);
+let el158= document.createElement('li');
+Object.assign(el158.style, mdStyle.li);
+// dbg -- pelNam: el158 children: 1
+el158.textContent=`Blue`;
+// dbg -- el: el158 parent:el155 kind:List
+el155.appendChild(el158);
+// dbg -- el: el155 parent:mdDiv kind:Document
+mdDiv.appendChild(el155);
+let el159=document.createElement('p');
+Object.assign(el159.style, mdStyle.p);
+// dbg -- pelNam: el159 children: 1
+el159.textContent=`Ordered lists use numbers followed by periods:`;
+// dbg -- par el: el159 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el159);
+let el160= document.createElement('ol');
+Object.assign(el160.style, mdStyle.ol);
+let el161= document.createElement('li');
+Object.assign(el161.style, mdStyle.li);
+// dbg -- pelNam: el161 children: 1
+el161.textContent=`Bird`;
+// dbg -- el: el161 parent:el160 kind:List
+el160.appendChild(el161);
+let el162= document.createElement('li');
+Object.assign(el162.style, mdStyle.li);
+// dbg -- pelNam: el162 children: 1
+el162.textContent=`McHale`;
+// dbg -- el: el162 parent:el160 kind:List
+el160.appendChild(el162);
+let el163= document.createElement('li');
+Object.assign(el163.style, mdStyle.li);
+// dbg -- pelNam: el163 children: 1
+el163.textContent=`Parish`;
+// dbg -- el: el163 parent:el160 kind:List
+el160.appendChild(el163);
+// dbg -- el: el160 parent:mdDiv kind:Document
+mdDiv.appendChild(el160);
+let el164=document.createElement('p');
+Object.assign(el164.style, mdStyle.p);
+// dbg -- pelNam: el164 children: 3
+const el165=document.createTextNode(`It's important to note that the actual numbers you use to mark thelist have no effect on the HTML output Markdown produces. The HTMLMarkdown produces from the above list is:`);
+el164.appendChild(el165);
+// dbg -- par el: el164 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el164);
+let el166=document.createElement('p');
+Object.assign(el166.style, mdStyle.p);
+// dbg -- pelNam: el166 children: 1
+el166.textContent=`If you instead wrote the list in Markdown like this:`;
+// dbg -- par el: el166 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el166);
+let el167= document.createElement('ol');
+Object.assign(el167.style, mdStyle.ol);
+let el168= document.createElement('li');
+Object.assign(el168.style, mdStyle.li);
+// dbg -- pelNam: el168 children: 1
+el168.textContent=`Bird`;
+// dbg -- el: el168 parent:el167 kind:List
+el167.appendChild(el168);
+let el169= document.createElement('li');
+Object.assign(el169.style, mdStyle.li);
+// dbg -- pelNam: el169 children: 1
+el169.textContent=`McHale`;
+// dbg -- el: el169 parent:el167 kind:List
+el167.appendChild(el169);
+let el170= document.createElement('li');
+Object.assign(el170.style, mdStyle.li);
+// dbg -- pelNam: el170 children: 1
+el170.textContent=`Parish`;
+// dbg -- el: el170 parent:el167 kind:List
+el167.appendChild(el170);
+// dbg -- el: el167 parent:mdDiv kind:Document
+mdDiv.appendChild(el167);
+let el171=document.createElement('p');
+Object.assign(el171.style, mdStyle.p);
+// dbg -- pelNam: el171 children: 1
+el171.textContent=`or even:`;
+// dbg -- par el: el171 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el171);
+let el172= document.createElement('ol');
+el172.start='3';
+Object.assign(el172.style, mdStyle.ol);
+let el173= document.createElement('li');
+Object.assign(el173.style, mdStyle.li);
+// dbg -- pelNam: el173 children: 1
+el173.textContent=`Bird`;
+// dbg -- el: el173 parent:el172 kind:List
+el172.appendChild(el173);
+let el174= document.createElement('li');
+Object.assign(el174.style, mdStyle.li);
+// dbg -- pelNam: el174 children: 1
+el174.textContent=`McHale`;
+// dbg -- el: el174 parent:el172 kind:List
+el172.appendChild(el174);
+let el175= document.createElement('li');
+Object.assign(el175.style, mdStyle.li);
+// dbg -- pelNam: el175 children: 1
+el175.textContent=`Parish`;
+// dbg -- el: el175 parent:el172 kind:List
+el172.appendChild(el175);
+// dbg -- el: el172 parent:mdDiv kind:Document
+mdDiv.appendChild(el172);
+let el176=document.createElement('p');
+Object.assign(el176.style, mdStyle.p);
+// dbg -- pelNam: el176 children: 4
+const el177=document.createTextNode(`you'd get the exact same HTML output. The point is, if you want to,you can use ordinal numbers in your ordered Markdown lists, so thatthe numbers in your source match the numbers in your published HTML.But if you want to be lazy, you don't have to.`);
+el176.appendChild(el177);
+// dbg -- par el: el176 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el176);
+let el178=document.createElement('p');
+Object.assign(el178.style, mdStyle.p);
+// dbg -- pelNam: el178 children: 1
+el178.textContent=`To make lists look nice, you can wrap items with hanging indents:`;
+// dbg -- par el: el178 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el178);
+let el179= document.createElement('ul');
+Object.assign(el179.style, mdStyle.ul);
+let el180= document.createElement('li');
+Object.assign(el180.style, mdStyle.li);
+// dbg -- pelNam: el180 children: 3
+const el181=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el180.appendChild(el181);
+// dbg -- el: el180 parent:el179 kind:List
+el179.appendChild(el180);
+let el182= document.createElement('li');
+Object.assign(el182.style, mdStyle.li);
+// dbg -- pelNam: el182 children: 2
+const el183=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el182.appendChild(el183);
+// dbg -- el: el182 parent:el179 kind:List
+el179.appendChild(el182);
+// dbg -- el: el179 parent:mdDiv kind:Document
+mdDiv.appendChild(el179);
+let el184=document.createElement('p');
+Object.assign(el184.style, mdStyle.p);
+// dbg -- pelNam: el184 children: 1
+el184.textContent=`But if you want to be lazy, you don't have to:`;
+// dbg -- par el: el184 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el184);
+let el185= document.createElement('ul');
+Object.assign(el185.style, mdStyle.ul);
+let el186= document.createElement('li');
+Object.assign(el186.style, mdStyle.li);
+// dbg -- pelNam: el186 children: 3
+const el187=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el186.appendChild(el187);
+// dbg -- el: el186 parent:el185 kind:List
+el185.appendChild(el186);
+let el188= document.createElement('li');
+Object.assign(el188.style, mdStyle.li);
+// dbg -- pelNam: el188 children: 2
+const el189=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el188.appendChild(el189);
+// dbg -- el: el188 parent:el185 kind:List
+el185.appendChild(el188);
+// dbg -- el: el185 parent:mdDiv kind:Document
+mdDiv.appendChild(el185);
+let el190=document.createElement('p');
+Object.assign(el190.style, mdStyle.p);
+// dbg -- pelNam: el190 children: 3
+const el191=document.createTextNode(`List items may consist of multiple paragraphs. Each subsequentparagraph in a list item must be indented by either 4 spacesor one tab:`);
+el190.appendChild(el191);
+// dbg -- par el: el190 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el190);
+let el192= document.createElement('ol');
+Object.assign(el192.style, mdStyle.ol);
+let el193= document.createElement('li');
+Object.assign(el193.style, mdStyle.li);
+let el194=document.createElement('p');
+Object.assign(el194.style, mdStyle.p);
+// dbg -- pelNam: el194 children: 3
+const el195=document.createTextNode(`This is a list item with two paragraphs. Lorem ipsum dolorsit amet, consectetuer adipiscing elit. Aliquam hendreritmi posuere lectus.`);
+el194.appendChild(el195);
+// dbg -- par el: el194 kind: Paragraph parent:el193 kind:ListItem
+el193.appendChild(el194);
+let el196=document.createElement('p');
+Object.assign(el196.style, mdStyle.p);
+// dbg -- pelNam: el196 children: 3
+const el197=document.createTextNode(`Vestibulum enim wisi, viverra nec, fringilla in, laoreetvitae, risus. Donec sit amet nisl. Aliquam semper ipsumsit amet velit.`);
+el196.appendChild(el197);
+// dbg -- par el: el196 kind: Paragraph parent:el193 kind:ListItem
+el193.appendChild(el196);
+// dbg -- el: el193 parent:el192 kind:List
+el192.appendChild(el193);
+let el198= document.createElement('li');
+Object.assign(el198.style, mdStyle.li);
+let el199=document.createElement('p');
+Object.assign(el199.style, mdStyle.p);
+// dbg -- pelNam: el199 children: 1
+el199.textContent=`Suspendisse id sem consectetuer libero luctus adipiscing.`;
+// dbg -- par el: el199 kind: Paragraph parent:el198 kind:ListItem
+el198.appendChild(el199);
+// dbg -- el: el198 parent:el192 kind:List
+el192.appendChild(el198);
+// dbg -- el: el192 parent:mdDiv kind:Document
+mdDiv.appendChild(el192);
+let el200=document.createElement('p');
+Object.assign(el200.style, mdStyle.p);
+// dbg -- pelNam: el200 children: 3
+const el201=document.createTextNode(`It looks nice if you indent every line of the subsequentparagraphs, but here again, Markdown will allow you to belazy:`);
+el200.appendChild(el201);
+// dbg -- par el: el200 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el200);
+let el202= document.createElement('ul');
+Object.assign(el202.style, mdStyle.ul);
+let el203= document.createElement('li');
+Object.assign(el203.style, mdStyle.li);
+let el204=document.createElement('p');
+Object.assign(el204.style, mdStyle.p);
+// dbg -- pelNam: el204 children: 1
+el204.textContent=`This is a list item with two paragraphs.`;
+// dbg -- par el: el204 kind: Paragraph parent:el203 kind:ListItem
+el203.appendChild(el204);
+let el205=document.createElement('p');
+Object.assign(el205.style, mdStyle.p);
+// dbg -- pelNam: el205 children: 3
+const el206=document.createTextNode(`This is the second paragraph in the list item. You'reonly required to indent the first line. Lorem ipsum dolorsit amet, consectetuer adipiscing elit.`);
+el205.appendChild(el206);
+// dbg -- par el: el205 kind: Paragraph parent:el203 kind:ListItem
+el203.appendChild(el205);
+// dbg -- el: el203 parent:el202 kind:List
+el202.appendChild(el203);
+let el207= document.createElement('li');
+Object.assign(el207.style, mdStyle.li);
+let el208=document.createElement('p');
+Object.assign(el208.style, mdStyle.p);
+// dbg -- pelNam: el208 children: 1
+el208.textContent=`Another item in the same list.`;
+// dbg -- par el: el208 kind: Paragraph parent:el207 kind:ListItem
+el207.appendChild(el208);
+// dbg -- el: el207 parent:el202 kind:List
+el202.appendChild(el207);
+// dbg -- el: el202 parent:mdDiv kind:Document
+mdDiv.appendChild(el202);
+let el209=document.createElement('p');
+Object.assign(el209.style, mdStyle.p);
+// dbg -- pelNam: el209 children: 4
+const el210=document.createTextNode(`To put a blockquote within a list item, the blockquote's `);
+el209.appendChild(el210);
+let el211=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el211Span1=document.createTextNode('>');
+el211.appendChild(el211Span1);
+// dbg -- codespan el: el211 kind: CodeSpan parent:el209 kind:Paragraph
+el209.appendChild(el211);
+const el212=document.createTextNode(`delimiters need to be indented:`);
+el209.appendChild(el212);
+// dbg -- par el: el209 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el209);
+let el213= document.createElement('ul');
+Object.assign(el213.style, mdStyle.ul);
+let el214= document.createElement('li');
+Object.assign(el214.style, mdStyle.li);
+let el215=document.createElement('p');
+Object.assign(el215.style, mdStyle.p);
+// dbg -- pelNam: el215 children: 1
+el215.textContent=`A list item with a blockquote:`;
+// dbg -- par el: el215 kind: Paragraph parent:el214 kind:ListItem
+el214.appendChild(el215);
+let el216= document.createElement('blockquote');
+Object.assign(el216.style, mdStyle.block);
+let el217=document.createElement('p');
+Object.assign(el217.style, mdStyle.p);
+// dbg -- pelNam: el217 children: 2
+const el218=document.createTextNode(`This is a blockquoteinside a list item.`);
+el217.appendChild(el218);
+// dbg -- par el: el217 kind: Paragraph parent:el216 kind:Blockquote
+el216.appendChild(el217);
+// dbg -- el: el216 parent:el214 kind:ListItem
+el214.appendChild(el216);
+// dbg -- el: el214 parent:el213 kind:List
+el213.appendChild(el214);
+// dbg -- el: el213 parent:mdDiv kind:Document
+mdDiv.appendChild(el213);
+let el219=document.createElement('p');
+Object.assign(el219.style, mdStyle.p);
+// dbg -- pelNam: el219 children: 4
+const el220=document.createTextNode(`To put a code block within a list item, the code block needsto be indented `);
+el219.appendChild(el220);
+let el221=document.createElement('em');
+// dbg -- pelNam: el221 children: 1
+el221.textContent=`twice`;
+el219.appendChild(el221);
+const el222=document.createTextNode(` -- 8 spaces or two tabs:`);
+el219.appendChild(el222);
+// dbg -- par el: el219 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el219);
+let el223= document.createElement('ul');
+Object.assign(el223.style, mdStyle.ul);
+let el224= document.createElement('li');
+Object.assign(el224.style, mdStyle.li);
+let el225=document.createElement('p');
+Object.assign(el225.style, mdStyle.p);
+// dbg -- pelNam: el225 children: 1
+el225.textContent=`A list item with a code block:`;
+// dbg -- par el: el225 kind: Paragraph parent:el224 kind:ListItem
+el224.appendChild(el225);
+let el226= document.createElement('pre');
+let el227= document.createElement('code');
+const el228= document.createTextNode(`<code goes here>
+`);
+el227.appendChild(el228);
+el226.appendChild(el227);
+// dbg -- el: el226 parent:el224 kind:ListItem
+el224.appendChild(el226);
+// dbg -- el: el224 parent:el223 kind:List
+el223.appendChild(el224);
+// dbg -- el: el223 parent:mdDiv kind:Document
+mdDiv.appendChild(el223);
+let el229= document.createElement('h3');
+Object.assign(el229.style, mdStyle.h3);
+el229.id='code-blocks';
+const el230Txt= `Code Blocks`;
+const el230=document.createTextNode(el230Txt);
+// dbg -- el: el230 parent:el229 kind:Heading
+el229.appendChild(el230);
+// dbg -- el: el229 parent:mdDiv kind:Document
+mdDiv.appendChild(el229);
+let el231=document.createElement('p');
+Object.assign(el231.style, mdStyle.p);
+// dbg -- pelNam: el231 children: 8
+const el232=document.createTextNode(`Pre-formatted code blocks are used for writing about programming ormarkup source code. Rather than forming normal paragraphs, the linesof a code block are interpreted literally. Markdown wraps a code blockin both `);
+el231.appendChild(el232);
+let el233=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[5]: "<pre>"
+const el233Span1=document.createTextNode('<pre>');
+el233.appendChild(el233Span1);
+// dbg -- codespan el: el233 kind: CodeSpan parent:el231 kind:Paragraph
+el231.appendChild(el233);
+const el234=document.createTextNode(` and `);
+el231.appendChild(el234);
+let el235=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[6]: "<code>"
+const el235Span1=document.createTextNode('<code>');
+el235.appendChild(el235Span1);
+// dbg -- codespan el: el235 kind: CodeSpan parent:el231 kind:Paragraph
+el231.appendChild(el235);
+const el236=document.createTextNode(` tags.`);
+el231.appendChild(el236);
+// dbg -- par el: el231 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el231);
+let el237=document.createElement('p');
+Object.assign(el237.style, mdStyle.p);
+// dbg -- pelNam: el237 children: 2
+const el238=document.createTextNode(`To produce a code block in Markdown, simply indent every line of theblock by at least 4 spaces or 1 tab.`);
+el237.appendChild(el238);
+// dbg -- par el: el237 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el237);
+let el239=document.createElement('p');
+Object.assign(el239.style, mdStyle.p);
+// dbg -- pelNam: el239 children: 1
+el239.textContent=`This is a normal paragraph:`;
+// dbg -- par el: el239 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el239);
+let el240= document.createElement('pre');
+let el241= document.createElement('code');
+const el242= document.createTextNode(`This is a code block.
+`);
+el241.appendChild(el242);
+el240.appendChild(el241);
+// dbg -- el: el240 parent:mdDiv kind:Document
+mdDiv.appendChild(el240);
+let el243=document.createElement('p');
+Object.assign(el243.style, mdStyle.p);
+// dbg -- pelNam: el243 children: 1
+el243.textContent=`Here is an example of AppleScript:`;
+// dbg -- par el: el243 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el243);
+let el244= document.createElement('pre');
+let el245= document.createElement('code');
+const el246= document.createTextNode(`tell application "Foo"
+    beep
+end tell
+`);
+el245.appendChild(el246);
+el244.appendChild(el245);
+// dbg -- el: el244 parent:mdDiv kind:Document
+mdDiv.appendChild(el244);
+let el247=document.createElement('p');
+Object.assign(el247.style, mdStyle.p);
+// dbg -- pelNam: el247 children: 2
+const el248=document.createTextNode(`A code block continues until it reaches a line that is not indented(or the end of the article).`);
+el247.appendChild(el248);
+// dbg -- par el: el247 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el247);
+let el249=document.createElement('p');
+Object.assign(el249.style, mdStyle.p);
+// dbg -- pelNam: el249 children: 11
+const el250=document.createTextNode(`Within a code block, ampersands (`);
+el249.appendChild(el250);
+let el251=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "&"
+const el251Span1=document.createTextNode('&');
+el251.appendChild(el251Span1);
+// dbg -- codespan el: el251 kind: CodeSpan parent:el249 kind:Paragraph
+el249.appendChild(el251);
+const el252=document.createTextNode(`) and angle brackets (`);
+el249.appendChild(el252);
+let el253=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "<"
+const el253Span1=document.createTextNode('<');
+el253.appendChild(el253Span1);
+// dbg -- codespan el: el253 kind: CodeSpan parent:el249 kind:Paragraph
+el249.appendChild(el253);
+const el254=document.createTextNode(` and `);
+el249.appendChild(el254);
+let el255=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el255Span1=document.createTextNode('>');
+el255.appendChild(el255Span1);
+// dbg -- codespan el: el255 kind: CodeSpan parent:el249 kind:Paragraph
+el249.appendChild(el255);
+const el256=document.createTextNode(`)are automatically converted into HTML entities. This makes it veryeasy to include example HTML source code using Markdown -- just pasteit and indent it, and Markdown will handle the hassle of encoding theampersands and angle brackets. For example, this:`);
+el249.appendChild(el256);
+// dbg -- par el: el249 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el249);
+let el257= document.createElement('pre');
+let el258= document.createElement('code');
+const el259= document.createTextNode(`<div class="footer">
+    &copy; 2004 Foo Corporation
+</div>
+`);
+el258.appendChild(el259);
+el257.appendChild(el258);
+// dbg -- el: el257 parent:mdDiv kind:Document
+mdDiv.appendChild(el257);
+let el260=document.createElement('p');
+Object.assign(el260.style, mdStyle.p);
+// dbg -- pelNam: el260 children: 3
+const el261=document.createTextNode(`Regular Markdown syntax is not processed within code blocks. E.g.,asterisks are just literal asterisks within a code block. This meansit's also easy to use Markdown to write about Markdown's own syntax.`);
+el260.appendChild(el261);
+// dbg -- par el: el260 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el260);
+let el262= document.createElement('pre');
+let el263= document.createElement('code');
+const el264= document.createTextNode(`>tell application "Foo"
+>    beep
+>end tell
+`);
+el263.appendChild(el264);
+el262.appendChild(el263);
+// dbg -- el: el262 parent:mdDiv kind:Document
+mdDiv.appendChild(el262);
+let el265= document.createElement('h2');
+Object.assign(el265.style, mdStyle.h2);
+el265.id='span-elements';
+const el266Txt= `Span Elements`;
+const el266=document.createTextNode(el266Txt);
+// dbg -- el: el266 parent:el265 kind:Heading
+el265.appendChild(el266);
+// dbg -- el: el265 parent:mdDiv kind:Document
+mdDiv.appendChild(el265);
+let el267= document.createElement('h3');
+Object.assign(el267.style, mdStyle.h3);
+el267.id='links';
+const el268Txt= `Links`;
+const el268=document.createTextNode(el268Txt);
+// dbg -- el: el268 parent:el267 kind:Heading
+el267.appendChild(el268);
+// dbg -- el: el267 parent:mdDiv kind:Document
+mdDiv.appendChild(el267);
+let el269=document.createElement('p');
+Object.assign(el269.style, mdStyle.p);
+// dbg -- pelNam: el269 children: 5
+const el270=document.createTextNode(`Markdown supports two style of links: `);
+el269.appendChild(el270);
+let el271=document.createElement('em');
+// dbg -- pelNam: el271 children: 1
+el271.textContent=`inline`;
+el269.appendChild(el271);
+const el272=document.createTextNode(` and `);
+el269.appendChild(el272);
+let el273=document.createElement('em');
+// dbg -- pelNam: el273 children: 1
+el273.textContent=`reference`;
+el269.appendChild(el273);
+const el274=document.createTextNode(`.`);
+el269.appendChild(el274);
+// dbg -- par el: el269 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el269);
+let el275=document.createElement('p');
+Object.assign(el275.style, mdStyle.p);
+// dbg -- pelNam: el275 children: 3
+const el276=document.createTextNode(`In both styles, the link text is delimited by [square brackets].`);
+el275.appendChild(el276);
+// dbg -- par el: el275 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el275);
+let el277=document.createElement('p');
+Object.assign(el277.style, mdStyle.p);
+// dbg -- pelNam: el277 children: 6
+const el278=document.createTextNode(`To create an inline link, use a set of regular parentheses immediatelyafter the link text's closing square bracket. Inside the parentheses,put the URL where you want the link to point, along with an `);
+el277.appendChild(el278);
+let el279=document.createElement('em');
+// dbg -- pelNam: el279 children: 1
+el279.textContent=`optional`;
+el277.appendChild(el279);
+const el280=document.createTextNode(`title for the link, surrounded in quotes. For example:`);
+el277.appendChild(el280);
+// dbg -- par el: el277 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el277);
+let el281=document.createElement('p');
+Object.assign(el281.style, mdStyle.p);
+// dbg -- pelNam: el281 children: 3
+const el282=document.createTextNode(`This is `);
+el281.appendChild(el282);
+let el283=document.createElement("a");
+el283.href='http://example.com/';
+Object.assign(el283.style, mdStyle.a);
+el283.textContent=`an example`;
+// dbg -- el: el283 parent:el281 kind:Paragraph
+el281.appendChild(el283);
+const el284=document.createTextNode(` inline link.`);
+el281.appendChild(el284);
+// dbg -- par el: el281 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el281);
+let el285=document.createElement('p');
+Object.assign(el285.style, mdStyle.p);
+// dbg -- pelNam: el285 children: 2
+let el286=document.createElement("a");
+el286.href='http://example.net/';
+Object.assign(el286.style, mdStyle.a);
+el286.textContent=`This link`;
+// dbg -- el: el286 parent:el285 kind:Paragraph
+el285.appendChild(el286);
+const el287=document.createTextNode(` has no title attribute.`);
+el285.appendChild(el287);
+// dbg -- par el: el285 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el285);
+let el288= document.createElement('h3');
+Object.assign(el288.style, mdStyle.h3);
+el288.id='emphasis';
+const el289Txt= `Emphasis`;
+const el289=document.createTextNode(el289Txt);
+// dbg -- el: el289 parent:el288 kind:Heading
+el288.appendChild(el289);
+// dbg -- el: el288 parent:mdDiv kind:Document
+mdDiv.appendChild(el288);
+let el290=document.createElement('p');
+Object.assign(el290.style, mdStyle.p);
+// dbg -- pelNam: el290 children: 19
+const el291=document.createTextNode(`Markdown treats asterisks (`);
+el290.appendChild(el291);
+let el292=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "*"
+const el292Span1=document.createTextNode('*');
+el292.appendChild(el292Span1);
+// dbg -- codespan el: el292 kind: CodeSpan parent:el290 kind:Paragraph
+el290.appendChild(el292);
+const el293=document.createTextNode(`) and underscores (`);
+el290.appendChild(el293);
+let el294=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "_"
+const el294Span1=document.createTextNode('_');
+el294.appendChild(el294Span1);
+// dbg -- codespan el: el294 kind: CodeSpan parent:el290 kind:Paragraph
+el290.appendChild(el294);
+const el295=document.createTextNode(`) as indicators ofemphasis. Text wrapped with one `);
+el290.appendChild(el295);
+let el296=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "*"
+const el296Span1=document.createTextNode('*');
+el296.appendChild(el296Span1);
+// dbg -- codespan el: el296 kind: CodeSpan parent:el290 kind:Paragraph
+el290.appendChild(el296);
+const el297=document.createTextNode(` or `);
+el290.appendChild(el297);
+let el298=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "_"
+const el298Span1=document.createTextNode('_');
+el298.appendChild(el298Span1);
+// dbg -- codespan el: el298 kind: CodeSpan parent:el290 kind:Paragraph
+el290.appendChild(el298);
+const el299=document.createTextNode(` will be wrapped with anHTML `);
+el290.appendChild(el299);
+let el300=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[4]: "<em>"
+const el300Span1=document.createTextNode('<em>');
+el300.appendChild(el300Span1);
+// dbg -- codespan el: el300 kind: CodeSpan parent:el290 kind:Paragraph
+el290.appendChild(el300);
+const el301=document.createTextNode(` tag; double `);
+el290.appendChild(el301);
+let el302=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "*"
+const el302Span1=document.createTextNode('*');
+el302.appendChild(el302Span1);
+// dbg -- codespan el: el302 kind: CodeSpan parent:el290 kind:Paragraph
+el290.appendChild(el302);
+const el303=document.createTextNode(`'s or `);
+el290.appendChild(el303);
+let el304=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "_"
+const el304Span1=document.createTextNode('_');
+el304.appendChild(el304Span1);
+// dbg -- codespan el: el304 kind: CodeSpan parent:el290 kind:Paragraph
+el290.appendChild(el304);
+const el305=document.createTextNode(`'s will be wrapped with an HTML`);
+el290.appendChild(el305);
+let el306=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[8]: "<strong>"
+const el306Span1=document.createTextNode('<strong>');
+el306.appendChild(el306Span1);
+// dbg -- codespan el: el306 kind: CodeSpan parent:el290 kind:Paragraph
+el290.appendChild(el306);
+const el307=document.createTextNode(` tag. E.g., this input:`);
+el290.appendChild(el307);
+// dbg -- par el: el290 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el290);
+let el308=document.createElement('p');
+Object.assign(el308.style, mdStyle.p);
+// dbg -- pelNam: el308 children: 1
+let el309=document.createElement('em');
+// dbg -- pelNam: el309 children: 1
+el309.textContent=`single asterisks`;
+el308.appendChild(el309);
+// dbg -- par el: el308 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el308);
+let el310=document.createElement('p');
+Object.assign(el310.style, mdStyle.p);
+// dbg -- pelNam: el310 children: 1
+let el311=document.createElement('em');
+// dbg -- pelNam: el311 children: 1
+el311.textContent=`single underscores`;
+el310.appendChild(el311);
+// dbg -- par el: el310 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el310);
+let el312=document.createElement('p');
+Object.assign(el312.style, mdStyle.p);
+// dbg -- pelNam: el312 children: 1
+let el313=document.createElement('strong');
+// dbg -- pelNam: el313 children: 1
+el313.textContent=`double asterisks`;
+el312.appendChild(el313);
+// dbg -- par el: el312 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el312);
+let el314=document.createElement('p');
+Object.assign(el314.style, mdStyle.p);
+// dbg -- pelNam: el314 children: 1
+let el315=document.createElement('strong');
+// dbg -- pelNam: el315 children: 1
+el315.textContent=`double underscores`;
+el314.appendChild(el315);
+// dbg -- par el: el314 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el314);
+let el316= document.createElement('h3');
+Object.assign(el316.style, mdStyle.h3);
+el316.id='code';
+const el317Txt= `Code`;
+const el317=document.createTextNode(el317Txt);
+// dbg -- el: el317 parent:el316 kind:Heading
+el316.appendChild(el317);
+// dbg -- el: el316 parent:mdDiv kind:Document
+mdDiv.appendChild(el316);
+let el318=document.createElement('p');
+Object.assign(el318.style, mdStyle.p);
+// dbg -- pelNam: el318 children: 5
+const el319=document.createTextNode(`To indicate a span of code, wrap it with backtick quotes (`);
+el318.appendChild(el319);
+let el320=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "`"
+const el320Span1=document.createTextNode('`');
+el320.appendChild(el320Span1);
+// dbg -- codespan el: el320 kind: CodeSpan parent:el318 kind:Paragraph
+el318.appendChild(el320);
+const el321=document.createTextNode(`).Unlike a pre-formatted code block, a code span indicates code within anormal paragraph. For example:`);
+el318.appendChild(el321);
+// dbg -- par el: el318 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el318);
+let el322=document.createElement('p');
+Object.assign(el322.style, mdStyle.p);
+// dbg -- pelNam: el322 children: 3
+const el323=document.createTextNode(`Use the `);
+el322.appendChild(el323);
+let el324=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[8]: "printf()"
+const el324Span1=document.createTextNode('printf()');
+el324.appendChild(el324Span1);
+// dbg -- codespan el: el324 kind: CodeSpan parent:el322 kind:Paragraph
+el322.appendChild(el324);
+const el325=document.createTextNode(` function.`);
+el322.appendChild(el325);
+// dbg -- par el: el322 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el322);
+return mdDiv;
+};
@@ -0,0 +1,115 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h3');
+Object.assign(el2.style, mdStyle.h3);
+el2.id='lists';
+const el3Txt= `Lists`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+// dbg -- pelNam: el4 children: 1
+el4.textContent=`Markdown supports ordered (numbered) and unordered (bulleted) lists.`;
+// dbg -- par el: el4 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+// dbg -- pelNam: el6 children: 1
+el6.textContent=`Red`;
+// dbg -- el: el6 parent:el5 kind:List
+el5.appendChild(el6);
+let el7= document.createElement('li');
+Object.assign(el7.style, mdStyle.li);
+// dbg -- pelNam: el7 children: 1
+el7.textContent=`Green`;
+// dbg -- el: el7 parent:el5 kind:List
+el5.appendChild(el7);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+// dbg -- pelNam: el8 children: 1
+el8.textContent=`Blue`;
+// dbg -- el: el8 parent:el5 kind:List
+el5.appendChild(el8);
+// dbg -- el: el5 parent:mdDiv kind:Document
+mdDiv.appendChild(el5);
+let el9=document.createElement('p');
+Object.assign(el9.style, mdStyle.p);
+// dbg -- pelNam: el9 children: 1
+el9.textContent=`is equivalent to:`;
+// dbg -- par el: el9 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el9);
+let el10= document.createElement('ul');
+Object.assign(el10.style, mdStyle.ul);
+let el11= document.createElement('li');
+Object.assign(el11.style, mdStyle.li);
+// dbg -- pelNam: el11 children: 1
+el11.textContent=`Red`;
+// dbg -- el: el11 parent:el10 kind:List
+el10.appendChild(el11);
+let el12= document.createElement('li');
+Object.assign(el12.style, mdStyle.li);
+// dbg -- pelNam: el12 children: 1
+el12.textContent=`Green`;
+// dbg -- el: el12 parent:el10 kind:List
+el10.appendChild(el12);
+let el13= document.createElement('li');
+Object.assign(el13.style, mdStyle.li);
+// dbg -- pelNam: el13 children: 1
+el13.textContent=`Blue`;
+// dbg -- el: el13 parent:el10 kind:List
+el10.appendChild(el13);
+// dbg -- el: el10 parent:mdDiv kind:Document
+mdDiv.appendChild(el10);
+let el14=document.createElement('p');
+Object.assign(el14.style, mdStyle.p);
+// dbg -- pelNam: el14 children: 1
+el14.textContent=`and:`;
+// dbg -- par el: el14 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el14);
+let el15= document.createElement('ul');
+Object.assign(el15.style, mdStyle.ul);
+let el16= document.createElement('li');
+Object.assign(el16.style, mdStyle.li);
+// dbg -- pelNam: el16 children: 1
+el16.textContent=`Red`;
+// dbg -- el: el16 parent:el15 kind:List
+el15.appendChild(el16);
+let el17= document.createElement('li');
+Object.assign(el17.style, mdStyle.li);
+// dbg -- pelNam: el17 children: 1
+el17.textContent=`Green`;
+// dbg -- el: el17 parent:el15 kind:List
+el15.appendChild(el17);
+let el18= document.createElement('li');
+Object.assign(el18.style, mdStyle.li);
+// dbg -- pelNam: el18 children: 1
+el18.textContent=`Blue`;
+// dbg -- el: el18 parent:el15 kind:List
+el15.appendChild(el18);
+// dbg -- el: el15 parent:mdDiv kind:Document
+mdDiv.appendChild(el15);
+let el19= document.createElement('h2');
+Object.assign(el19.style, mdStyle.h2);
+el19.id='end-of-unordered-lists';
+const el20Txt= `End of Unordered Lists`;
+const el20=document.createTextNode(el20Txt);
+// dbg -- el: el20 parent:el19 kind:Heading
+el19.appendChild(el20);
+// dbg -- el: el19 parent:mdDiv kind:Document
+mdDiv.appendChild(el19);
+return mdDiv;
+};
@@ -0,0 +1,155 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h3');
+Object.assign(el2.style, mdStyle.h3);
+el2.id='lists';
+const el3Txt= `Lists`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+// dbg -- pelNam: el4 children: 1
+el4.textContent=`Markdown supports ordered (numbered) and unordered (bulleted) lists.`;
+// dbg -- par el: el4 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+// dbg -- pelNam: el6 children: 1
+el6.textContent=`Red`;
+// dbg -- el: el6 parent:el5 kind:List
+el5.appendChild(el6);
+let el7= document.createElement('li');
+Object.assign(el7.style, mdStyle.li);
+// dbg -- pelNam: el7 children: 1
+el7.textContent=`Green`;
+// dbg -- el: el7 parent:el5 kind:List
+el5.appendChild(el7);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+// dbg -- pelNam: el8 children: 1
+el8.textContent=`Blue`;
+// dbg -- el: el8 parent:el5 kind:List
+el5.appendChild(el8);
+// dbg -- el: el5 parent:mdDiv kind:Document
+mdDiv.appendChild(el5);
+let el9=document.createElement('p');
+Object.assign(el9.style, mdStyle.p);
+// dbg -- pelNam: el9 children: 1
+el9.textContent=`Nested lists two levels`;
+// dbg -- par el: el9 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el9);
+let el10= document.createElement('ul');
+Object.assign(el10.style, mdStyle.ul);
+let el11= document.createElement('li');
+Object.assign(el11.style, mdStyle.li);
+// dbg -- pelNam: el11 children: 1
+el11.textContent=`Red`;
+let el12= document.createElement('ul');
+Object.assign(el12.style, mdStyle.ul);
+let el13= document.createElement('li');
+Object.assign(el13.style, mdStyle.li);
+// dbg -- pelNam: el13 children: 1
+el13.textContent=`Orange`;
+// dbg -- el: el13 parent:el12 kind:List
+el12.appendChild(el13);
+let el14= document.createElement('li');
+Object.assign(el14.style, mdStyle.li);
+// dbg -- pelNam: el14 children: 1
+el14.textContent=`Purple`;
+// dbg -- el: el14 parent:el12 kind:List
+el12.appendChild(el14);
+// dbg -- el: el12 parent:el11 kind:ListItem
+el11.appendChild(el12);
+// dbg -- el: el11 parent:el10 kind:List
+el10.appendChild(el11);
+let el15= document.createElement('li');
+Object.assign(el15.style, mdStyle.li);
+// dbg -- pelNam: el15 children: 1
+el15.textContent=`Green`;
+// dbg -- el: el15 parent:el10 kind:List
+el10.appendChild(el15);
+let el16= document.createElement('li');
+Object.assign(el16.style, mdStyle.li);
+// dbg -- pelNam: el16 children: 1
+el16.textContent=`Blue`;
+let el17= document.createElement('ul');
+Object.assign(el17.style, mdStyle.ul);
+let el18= document.createElement('li');
+Object.assign(el18.style, mdStyle.li);
+// dbg -- pelNam: el18 children: 1
+el18.textContent=`Dark Blue`;
+// dbg -- el: el18 parent:el17 kind:List
+el17.appendChild(el18);
+let el19= document.createElement('li');
+Object.assign(el19.style, mdStyle.li);
+// dbg -- pelNam: el19 children: 1
+el19.textContent=`Light Blue`;
+// dbg -- el: el19 parent:el17 kind:List
+el17.appendChild(el19);
+// dbg -- el: el17 parent:el16 kind:ListItem
+el16.appendChild(el17);
+// dbg -- el: el16 parent:el10 kind:List
+el10.appendChild(el16);
+// dbg -- el: el10 parent:mdDiv kind:Document
+mdDiv.appendChild(el10);
+let el20=document.createElement('p');
+Object.assign(el20.style, mdStyle.p);
+// dbg -- pelNam: el20 children: 1
+el20.textContent=`Nested Lists with three levels`;
+// dbg -- par el: el20 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el20);
+let el21= document.createElement('ul');
+Object.assign(el21.style, mdStyle.ul);
+let el22= document.createElement('li');
+Object.assign(el22.style, mdStyle.li);
+// dbg -- pelNam: el22 children: 1
+el22.textContent=`Red Level 1`;
+let el23= document.createElement('ul');
+Object.assign(el23.style, mdStyle.ul);
+let el24= document.createElement('li');
+Object.assign(el24.style, mdStyle.li);
+// dbg -- pelNam: el24 children: 1
+el24.textContent=`Red Level 2`;
+// dbg -- el: el24 parent:el23 kind:List
+el23.appendChild(el24);
+let el25= document.createElement('li');
+Object.assign(el25.style, mdStyle.li);
+// dbg -- pelNam: el25 children: 5
+const el26=document.createTextNode(`Orange Level 2*    Red Level 3*    Blue Level 3`);
+el25.appendChild(el26);
+// dbg -- el: el25 parent:el23 kind:List
+el23.appendChild(el25);
+// dbg -- el: el23 parent:el22 kind:ListItem
+el22.appendChild(el23);
+// dbg -- el: el22 parent:el21 kind:List
+el21.appendChild(el22);
+let el27= document.createElement('li');
+Object.assign(el27.style, mdStyle.li);
+// dbg -- pelNam: el27 children: 1
+el27.textContent=`Green`;
+// dbg -- el: el27 parent:el21 kind:List
+el21.appendChild(el27);
+let el28= document.createElement('li');
+Object.assign(el28.style, mdStyle.li);
+// dbg -- pelNam: el28 children: 1
+el28.textContent=`Blue`;
+// dbg -- el: el28 parent:el21 kind:List
+el21.appendChild(el28);
+// dbg -- el: el21 parent:mdDiv kind:Document
+mdDiv.appendChild(el21);
+return mdDiv;
+};
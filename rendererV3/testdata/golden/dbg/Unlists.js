@@ -0,0 +1,57 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h3');
+Object.assign(el2.style, mdStyle.h3);
+el2.id='lists';
+const el3Txt= `Lists`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+// dbg -- pelNam: el4 children: 1
+el4.textContent=`Markdown supports ordered (numbered) and unordered (bulleted) lists.`;
+// dbg -- par el: el4 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+// dbg -- pelNam: el6 children: 1
+el6.textContent=`Red`;
+// dbg -- el: el6 parent:el5 kind:List
+el5.appendChild(el6);
+let el7= document.createElement('li');
+Object.assign(el7.style, mdStyle.li);
+// dbg -- pelNam: el7 children: 1
+el7.textContent=`Green`;
+// dbg -- el: el7 parent:el5 kind:List
+el5.appendChild(el7);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+// dbg -- pelNam: el8 children: 1
+el8.textContent=`Blue`;
+// dbg -- el: el8 parent:el5 kind:List
+el5.appendChild(el8);
+// dbg -- el: el5 parent:mdDiv kind:Document
+mdDiv.appendChild(el5);
+let el9=document.createElement('p');
+Object.assign(el9.style, mdStyle.p);
+// dbg -- pelNam: el9 children: 2
+const el10=document.createTextNode(`Unordered lists use asterisks, pluses, and hyphens -- interchangably-- as list markers`);
+el9.appendChild(el10);
+// dbg -- par el: el9 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el9);
+return mdDiv;
+};
@@ -0,0 +1,71 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h3');
+Object.assign(el2.style, mdStyle.h3);
+el2.id='blockquotes';
+const el3Txt= `Blockquotes`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+// dbg -- pelNam: el4 children: 8
+const el5=document.createTextNode(`Markdown uses email-style `);
+el4.appendChild(el5);
+let el6=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el6Span1=document.createTextNode('>');
+el6.appendChild(el6Span1);
+// dbg -- codespan el: el6 kind: CodeSpan parent:el4 kind:Paragraph
+el4.appendChild(el6);
+const el7=document.createTextNode(` characters for blockquoting. If you'refamiliar with quoting passages of text in an email message, then youknow how to create a blockquote in Markdown. It looks best if you hardwrap the text and put a `);
+el4.appendChild(el7);
+let el8=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el8Span1=document.createTextNode('>');
+el8.appendChild(el8Span1);
+// dbg -- codespan el: el8 kind: CodeSpan parent:el4 kind:Paragraph
+el4.appendChild(el8);
+const el9=document.createTextNode(` before every line:`);
+el4.appendChild(el9);
+// dbg -- par el: el4 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el10= document.createElement('blockquote');
+Object.assign(el10.style, mdStyle.block);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+// dbg -- pelNam: el11 children: 3
+const el12=document.createTextNode(`This is a blockquote with two paragraphs. Lorem ipsum dolor sit amet,consectetuer adipiscing elit. Aliquam hendrerit mi posuere lectus.Vestibulum enim wisi, viverra nec, fringilla in, laoreet vitae, risus.`);
+el11.appendChild(el12);
+// dbg -- par el: el11 kind: Paragraph parent:el10 kind:Blockquote
+el10.appendChild(el11);
+let el13=document.createElement('p');
+Object.assign(el13.style, mdStyle.p);
+// dbg -- pelNam: el13 children: 2
+const el14=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit. Suspendisseid sem consectetuer libero luctus adipiscing.`);
+el13.appendChild(el14);
+// dbg -- par el: el13 kind: Paragraph parent:el10 kind:Blockquote
+el10.appendChild(el13);
+// dbg -- el: el10 parent:mdDiv kind:Document
+mdDiv.appendChild(el10);
+let el15=document.createElement('p');
+Object.assign(el15.style, mdStyle.p);
+// dbg -- pelNam: el15 children: 1
+el15.textContent=`A final line for testing the block section.`;
+// dbg -- par el: el15 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el15);
+return mdDiv;
+};
@@ -0,0 +1,54 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2=document.createElement('p');
+Object.assign(el2.style, mdStyle.p);
+// dbg -- pelNam: el2 children: 2
+const el3=document.createTextNode(`Blockquotes can contain other Markdown elements, including headers, lists,and code blocks:`);
+el2.appendChild(el3);
+// dbg -- par el: el2 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4= document.createElement('blockquote');
+Object.assign(el4.style, mdStyle.block);
+let el5= document.createElement('h2');
+Object.assign(el5.style, mdStyle.h2);
+el5.id='this-is-a-header';
+const el6Txt= `This is a header.`;
+const el6=document.createTextNode(el6Txt);
+// dbg -- el: el6 parent:el5 kind:Heading
+el5.appendChild(el6);
+// dbg -- el: el5 parent:el4 kind:Blockquote
+el4.appendChild(el5);
+let el7=document.createElement('p');
+Object.assign(el7.style, mdStyle.p);
+// dbg -- pelNam: el7 children: 1
+el7.textContent=`Here's some example code:`;
+// dbg -- par el: el7 kind: Paragraph parent:el4 kind:Blockquote
+el4.appendChild(el7);
+let el8= document.createElement('pre');
+let el9= document.createElement('code');
+const el10= document.createTextNode(`return shell_exec("echo $input | $markdown_script");
+`);
+el9.appendChild(el10);
+el8.appendChild(el9);
+// dbg -- el: el8 parent:el4 kind:Blockquote
+el4.appendChild(el8);
+// dbg -- el: el4 parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+// dbg -- pelNam: el11 children: 3
+const el12=document.createTextNode(`Any decent text editor should make email-style quoting easy. Forexample, with BBEdit, you can make a selection and choose IncreaseQuote Level from the Text menu.`);
+el11.appendChild(el12);
+// dbg -- par el: el11 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el11);
+return mdDiv;
+};
@@ -0,0 +1,99 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h2');
+Object.assign(el2.style, mdStyle.h2);
+el2.id='block-elements';
+const el3Txt= `Block Elements`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4= document.createElement('h3');
+Object.assign(el4.style, mdStyle.h3);
+el4.id='paragraphs-and-line-breaks';
+const el5Txt= `Paragraphs and Line Breaks`;
+const el5=document.createTextNode(el5Txt);
+// dbg -- el: el5 parent:el4 kind:Heading
+el4.appendChild(el5);
+// dbg -- el: el4 parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el6=document.createElement('p');
+Object.assign(el6.style, mdStyle.p);
+// dbg -- pelNam: el6 children: 4
+const el7=document.createTextNode(`A paragraph is simply one or more consecutive lines of text, separatedby one or more blank lines. (A blank line is any line that looks like ablank line -- a line containing nothing but spaces or tabs is consideredblank.) Normal paragraphs should not be indented with spaces or tabs.`);
+el6.appendChild(el7);
+// dbg -- par el: el6 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el6);
+let el8=document.createElement('p');
+Object.assign(el8.style, mdStyle.p);
+// dbg -- pelNam: el8 children: 7
+const el9=document.createTextNode(`The implication of the "one or more consecutive lines of text" rule isthat Markdown supports "hard-wrapped" text paragraphs. This differssignificantly from most other text-to-HTML formatters (including MovableType's "Convert Line Breaks" option) which translate every line breakcharacter in a paragraph into a `);
+el8.appendChild(el9);
+let el10=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[6]: "<br />"
+const el10Span1=document.createTextNode('<br />');
+el10.appendChild(el10Span1);
+// dbg -- codespan el: el10 kind: CodeSpan parent:el8 kind:Paragraph
+el8.appendChild(el10);
+const el11=document.createTextNode(` tag.`);
+el8.appendChild(el11);
+// dbg -- par el: el8 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el8);
+let el12=document.createElement('p');
+Object.assign(el12.style, mdStyle.p);
+// dbg -- pelNam: el12 children: 6
+const el13=document.createTextNode(`When you `);
+el12.appendChild(el13);
+let el14=document.createElement('em');
+// dbg -- pelNam: el14 children: 1
+el14.textContent=`do`;
+el12.appendChild(el14);
+const el15=document.createTextNode(` want to insert a `);
+el12.appendChild(el15);
+let el16=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[6]: "<br />"
+const el16Span1=document.createTextNode('<br />');
+el16.appendChild(el16Span1);
+// dbg -- codespan el: el16 kind: CodeSpan parent:el12 kind:Paragraph
+el12.appendChild(el16);
+const el17=document.createTextNode(` break tag using Markdown, youend a line with two or more spaces, then type return.`);
+el12.appendChild(el17);
+// dbg -- par el: el12 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el12);
+let el18= document.createElement('h3');
+Object.assign(el18.style, mdStyle.h3);
+el18.id='headers';
+const el19Txt= `Headers`;
+const el19=document.createTextNode(el19Txt);
+// dbg -- el: el19 parent:el18 kind:Heading
+el18.appendChild(el19);
+// dbg -- el: el18 parent:mdDiv kind:Document
+mdDiv.appendChild(el18);
+let el20=document.createElement('p');
+Object.assign(el20.style, mdStyle.p);
+// dbg -- pelNam: el20 children: 6
+const el21=document.createTextNode(`Markdown supports two styles of headers, [Setext] [1] and [atx] [2].`);
+el20.appendChild(el21);
+// dbg -- par el: el20 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el20);
+let el22=document.createElement('p');
+Object.assign(el22.style, mdStyle.p);
+// dbg -- pelNam: el22 children: 5
+const el23=document.createTextNode(`Optionally, you may "close" atx-style headers. This is purelycosmetic -- you can use this if you think it looks better. Theclosing hashes don't even need to match the number of hashesused to open the header. (The number of opening hashesdetermines the header level.)`);
+el22.appendChild(el23);
+// dbg -- par el: el22 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el22);
+return mdDiv;
+};
@@ -0,0 +1,202 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h3');
+Object.assign(el2.style, mdStyle.h3);
+el2.id='blockquotes';
+const el3Txt= `Blockquotes`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+// dbg -- pelNam: el4 children: 8
+const el5=document.createTextNode(`Markdown uses email-style `);
+el4.appendChild(el5);
+let el6=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el6Span1=document.createTextNode('>');
+el6.appendChild(el6Span1);
+// dbg -- codespan el: el6 kind: CodeSpan parent:el4 kind:Paragraph
+el4.appendChild(el6);
+const el7=document.createTextNode(` characters for blockquoting. If you'refamiliar with quoting passages of text in an email message, then youknow how to create a blockquote in Markdown. It looks best if you hardwrap the text and put a `);
+el4.appendChild(el7);
+let el8=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el8Span1=document.createTextNode('>');
+el8.appendChild(el8Span1);
+// dbg -- codespan el: el8 kind: CodeSpan parent:el4 kind:Paragraph
+el4.appendChild(el8);
+const el9=document.createTextNode(` before every line:`);
+el4.appendChild(el9);
+// dbg -- par el: el4 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el10= document.createElement('blockquote');
+Object.assign(el10.style, mdStyle.block);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+// dbg -- pelNam: el11 children: 3
+const el12=document.createTextNode(`This is a blockquote with two paragraphs. Lorem ipsum dolor sit amet,consectetuer adipiscing elit. Aliquam hendrerit mi posuere lectus.Vestibulum enim wisi, viverra nec, fringilla in, laoreet vitae, risus.`);
+el11.appendChild(el12);
+// dbg -- par el: el11 kind: Paragraph parent:el10 kind:Blockquote
+el10.appendChild(el11);
+let el13=document.createElement('p');
+Object.assign(el13.style, mdStyle.p);
+// dbg -- pelNam: el13 children: 2
+const el14=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit. Suspendisseid sem consectetuer libero luctus adipiscing.`);
+el13.appendChild(el14);
+// dbg -- par el: el13 kind: Paragraph parent:el10 kind:Blockquote
+el10.appendChild(el13);
+// dbg -- el: el10 parent:mdDiv kind:Document
+mdDiv.appendChild(el10);
+let el15=document.createElement('p');
+Object.assign(el15.style, mdStyle.p);
+// dbg -- pelNam: el15 children: 4
+const el16=document.createTextNode(`Markdown allows you to be lazy and only put the `);
+el15.appendChild(el16);
+let el17=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el17Span1=document.createTextNode('>');
+el17.appendChild(el17Span1);
+// dbg -- codespan el: el17 kind: CodeSpan parent:el15 kind:Paragraph
+el15.appendChild(el17);
+const el18=document.createTextNode(` before the firstline of a hard-wrapped paragraph:`);
+el15.appendChild(el18);
+// dbg -- par el: el15 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el15);
+let el19= document.createElement('blockquote');
+Object.assign(el19.style, mdStyle.block);
+let el20=document.createElement('p');
+Object.assign(el20.style, mdStyle.p);
+// dbg -- pelNam: el20 children: 3
+const el21=document.createTextNode(`This is a blockquote with two paragraphs. Lorem ipsum dolor sit amet,consectetuer adipiscing elit. Aliquam hendrerit mi posuere lectus.Vestibulum enim wisi, viverra nec, fringilla in, laoreet vitae, risus.`);
+el20.appendChild(el21);
+// dbg -- par el: el20 kind: Paragraph parent:el19 kind:Blockquote
+el19.appendChild(el20);
+// dbg -- el: el19 parent:mdDiv kind:Document
+mdDiv.appendChild(el19);
+let el22= document.createElement('blockquote');
+Object.assign(el22.style, mdStyle.block);
+let el23=document.createElement('p');
+Object.assign(el23.style, mdStyle.p);
+// dbg -- pelNam: el23 children: 2
+const el24=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit. Suspendisseid sem consectetuer libero luctus adipiscing.`);
+el23.appendChild(el24);
+// dbg -- par el: el23 kind: Paragraph parent:el22 kind:Blockquote
+el22.appendChild(el23);
+// dbg -- el: el22 parent:mdDiv kind:Document
+mdDiv.appendChild(el22);
+let el25=document.createElement('p');
+Object.assign(el25.style, mdStyle.p);
+// dbg -- pelNam: el25 children: 4
+const el26=document.createTextNode(`Blockquotes can be nested (i.e. a blockquote-in-a-blockquote) byadding additional levels of `);
+el25.appendChild(el26);
+let el27=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: ">"
+const el27Span1=document.createTextNode('>');
+el27.appendChild(el27Span1);
+// dbg -- codespan el: el27 kind: CodeSpan parent:el25 kind:Paragraph
+el25.appendChild(el27);
+const el28=document.createTextNode(`:`);
+el25.appendChild(el28);
+// dbg -- par el: el25 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el25);
+let el29= document.createElement('blockquote');
+Object.assign(el29.style, mdStyle.block);
+let el30=document.createElement('p');
+Object.assign(el30.style, mdStyle.p);
+// dbg -- pelNam: el30 children: 1
+el30.textContent=`This is the first level of quoting.`;
+// dbg -- par el: el30 kind: Paragraph parent:el29 kind:Blockquote
+el29.appendChild(el30);
+let el31= document.createElement('blockquote');
+Object.assign(el31.style, mdStyle.block);
+let el32=document.createElement('p');
+Object.assign(el32.style, mdStyle.p);
+// dbg -- pelNam: el32 children: 1
+el32.textContent=`This is nested blockquote.`;
+// dbg -- par el: el32 kind: Paragraph parent:el31 kind:Blockquote
+el31.appendChild(el32);
+// dbg -- el: el31 parent:el29 kind:Blockquote
+el29.appendChild(el31);
+let el33=document.createElement('p');
+Object.assign(el33.style, mdStyle.p);
+// dbg -- pelNam: el33 children: 1
+el33.textContent=`Back to the first level.`;
+// dbg -- par el: el33 kind: Paragraph parent:el29 kind:Blockquote
+el29.appendChild(el33);
+// dbg -- el: el29 parent:mdDiv kind:Document
+mdDiv.appendChild(el29);
+let el34=document.createElement('p');
+Object.assign(el34.style, mdStyle.p);
+// dbg -- pelNam: el34 children: 2
+const el35=document.createTextNode(`Blockquotes can contain other Markdown elements, including headers, lists,and code blocks:`);
+el34.appendChild(el35);
+// dbg -- par el: el34 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el34);
+let el36= document.createElement('blockquote');
+Object.assign(el36.style, mdStyle.block);
+let el37= document.createElement('h2');
+Object.assign(el37.style, mdStyle.h2);
+el37.id='this-is-a-header';
+const el38Txt= `This is a header.`;
+const el38=document.createTextNode(el38Txt);
+// dbg -- el: el38 parent:el37 kind:Heading
+el37.appendChild(el38);
+// dbg -- el: el37 parent:el36 kind:Blockquote
+el36.appendChild(el37);
+let el39= document.createElement('ol');
+Object.assign(el39.style, mdStyle.ol);
+let el40= document.createElement('li');
+Object.assign(el40.style, mdStyle.li);
+// dbg -- pelNam: el40 children: 1
+el40.textContent=`This is the first list item.`;
+// dbg -- el: el40 parent:el39 kind:List
+el39.appendChild(el40);
+let el41= document.createElement('li');
+Object.assign(el41.style, mdStyle.li);
+// dbg -- pelNam: el41 children: 1
+el41.textContent=`This is the second list item.`;
+// dbg -- el: el41 parent:el39 kind:List
+el39.appendChild(el41);
+// dbg -- el: el39 parent:el36 kind:Blockquote
+el36.appendChild(el39);
+let el42=document.createElement('p');
+Object.assign(el42.style, mdStyle.p);
+// dbg -- pelNam: el42 children: 1
+el42.textContent=`Here's some example code:`;
+// dbg -- par el: el42 kind: Paragraph parent:el36 kind:Blockquote
+el36.appendChild(el42);
+let el43= document.createElement('pre');
+let el44= document.createElement('code');
+const el45= document.createTextNode(`return shell_exec("echo $input | $markdown_script");
+`);
+el44.appendChild(el45);
+el43.appendChild(el44);
+// dbg -- el: el43 parent:el36 kind:Blockquote
+el36.appendChild(el43);
+// dbg -- el: el36 parent:mdDiv kind:Document
+mdDiv.appendChild(el36);
+let el46=document.createElement('p');
+Object.assign(el46.style, mdStyle.p);
+// dbg -- pelNam: el46 children: 3
+const el47=document.createTextNode(`Any decent text editor should make email-style quoting easy. Forexample, with BBEdit, you can make a selection and choose IncreaseQuote Level from the Text menu.`);
+el46.appendChild(el47);
+// dbg -- par el: el46 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el46);
+return mdDiv;
+};
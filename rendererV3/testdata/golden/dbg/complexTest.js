@@ -0,0 +1,333 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h1');
+Object.assign(el2.style, mdStyle.h1);
+el2.id='h1';
+const el3Txt= `H1`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+// dbg -- pelNam: el4 children: 6
+const el5=document.createTextNode(`Lorem ipsum dolor sit amet, `);
+el4.appendChild(el5);
+let el6=document.createElement('em');
+// dbg -- pelNam: el6 children: 1
+el6.textContent=`consectetur`;
+el4.appendChild(el6);
+const el7=document.createTextNode(` adipisicing elit, sed do eiusmodtempor incididunt ut `);
+el4.appendChild(el7);
+let el8=document.createElement('strong');
+// dbg -- pelNam: el8 children: 1
+el8.textContent=`labore et dolore magna aliqua`;
+el4.appendChild(el8);
+const el9=document.createTextNode(`. Ut enim ad minim veniam,`);
+el4.appendChild(el9);
+// dbg -- par el: el4 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el10= document.createElement('blockquote');
+Object.assign(el10.style, mdStyle.block);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+// dbg -- pelNam: el11 children: 1
+el11.textContent=`quis nostrud exercitation ullamco laboris nisi ut aliquip ex ea commodo`;
+// dbg -- par el: el11 kind: Paragraph parent:el10 kind:Blockquote
+el10.appendChild(el11);
+// dbg -- el: el10 parent:mdDiv kind:Document
+mdDiv.appendChild(el10);
+let el12=document.createElement('p');
+Object.assign(el12.style, mdStyle.p);
+// dbg -- pelNam: el12 children: 4
+const el13=document.createTextNode(`consequat. `);
+el12.appendChild(el13);
+let el14=document.createElement('em');
+// dbg -- pelNam: el14 children: 1
+let el15=document.createElement('strong');
+// dbg -- pelNam: el15 children: 1
+el15.textContent=`Duis aute irure dolor`;
+el14.appendChild(el15);
+el12.appendChild(el14);
+const el16=document.createTextNode(` in reprehenderit in voluptate velit essecillum dolore eu fugiat nulla pariatur. ~~Excepteur sint occaecat~~ cupidatat nonproident, sunt in culpa qui officia deserunt mollit anim id est laborum.`);
+el12.appendChild(el16);
+// dbg -- par el: el12 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el12);
+let el17= document.createElement('h2');
+Object.assign(el17.style, mdStyle.h2);
+el17.id='h2';
+const el18Txt= `H2`;
+const el18=document.createTextNode(el18Txt);
+// dbg -- el: el18 parent:el17 kind:Heading
+el17.appendChild(el18);
+// dbg -- el: el17 parent:mdDiv kind:Document
+mdDiv.appendChild(el17);
+let el19=document.createElement('p');
+Object.assign(el19.style, mdStyle.p);
+// dbg -- pelNam: el19 children: 8
+const el20=document.createTextNode(`Lorem ipsum dolor sit amet, `);
+el19.appendChild(el20);
+let el21=document.createElement('em');
+// dbg -- pelNam: el21 children: 1
+el21.textContent=`consectetur`;
+el19.appendChild(el21);
+const el22=document.createTextNode(` adipisicing elit, sed do eiusmodtempor incididunt ut `);
+el19.appendChild(el22);
+let el23=document.createElement('strong');
+// dbg -- pelNam: el23 children: 1
+el23.textContent=`labore et dolore magna aliqua`;
+el19.appendChild(el23);
+const el24=document.createTextNode(`. Ut enim ad minim veniam,quis nostrud exercitation ullamco laboris nisi ut aliquip ex ea commodoconsequat.`);
+el19.appendChild(el24);
+// dbg -- par el: el19 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el19);
+let el25=document.createElement('hr');
+// dbg -- el: el25 parent:mdDiv kind:Document
+mdDiv.appendChild(el25);
+let el26=document.createElement('p');
+Object.assign(el26.style, mdStyle.p);
+// dbg -- pelNam: el26 children: 4
+let el27=document.createElement('em');
+// dbg -- pelNam: el27 children: 1
+let el28=document.createElement('strong');
+// dbg -- pelNam: el28 children: 1
+el28.textContent=`Duis aute irure dolor`;
+el27.appendChild(el28);
+el26.appendChild(el27);
+const el29=document.createTextNode(` in reprehenderit in voluptate velit essecillum dolore eu fugiat nulla pariatur. ~~Excepteur sint occaecat~~ cupidatat nonproident, sunt in culpa qui officia deserunt mollit anim id est laborum.`);
+el26.appendChild(el29);
+// dbg -- par el: el26 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el26);
+let el30= document.createElement('h3');
+Object.assign(el30.style, mdStyle.h3);
+el30.id='h3';
+const el31Txt= `H3`;
+const el31=document.createTextNode(el31Txt);
+// dbg -- el: el31 parent:el30 kind:Heading
+el30.appendChild(el31);
+// dbg -- el: el30 parent:mdDiv kind:Document
+mdDiv.appendChild(el30);
+let el32=document.createElement('p');
+Object.assign(el32.style, mdStyle.p);
+// dbg -- pelNam: el32 children: 1
+el32.textContent=`unordered list:`;
+// dbg -- par el: el32 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el32);
+let el33= document.createElement('ul');
+Object.assign(el33.style, mdStyle.ul);
+let el34= document.createElement('li');
+Object.assign(el34.style, mdStyle.li);
+// dbg -- pelNam: el34 children: 1
+el34.textContent=`item-1`;
+let el35= document.createElement('ul');
+Object.assign(el35.style, mdStyle.ul);
+let el36= document.createElement('li');
+Object.assign(el36.style, mdStyle.li);
+// dbg -- pelNam: el36 children: 1
+el36.textContent=`sub-item-1`;
+// dbg -- el: el36 parent:el35 kind:List
+el35.appendChild(el36);
+let el37= document.createElement('li');
+Object.assign(el37.style, mdStyle.li);
+// dbg -- pelNam: el37 children: 1
+el37.textContent=`sub-item-2`;
+// dbg -- el: el37 parent:el35 kind:List
+el35.appendChild(el37);
+// dbg -- el: el35 parent:el34 kind:ListItem
+el34.appendChild(el35);
+// dbg -- el: el34 parent:el33 kind:List
+el33.appendChild(el34);
+// dbg -- el: el33 parent:mdDiv kind:Document
+mdDiv.appendChild(el33);
+let el38= document.createElement('ul');
+Object.assign(el38.style, mdStyle.ul);
+let el39= document.createElement('li');
+Object.assign(el39.style, mdStyle.li);
+// dbg -- pelNam: el39 children: 1
+el39.textContent=`item-2`;
+let el40= document.createElement('ul');
+Object.assign(el40.style, mdStyle.ul);
+let el41= document.createElement('li');
+Object.assign(el41.style, mdStyle.li);
+// dbg -- pelNam: el41 children: 1
+el41.textContent=`sub-item-3`;
+// dbg -- el: el41 parent:el40 kind:List
+el40.appendChild(el41);
+let el42= document.createElement('li');
+Object.assign(el42.style, mdStyle.li);
+// dbg -- pelNam: el42 children: 1
+el42.textContent=`sub-item-4`;
+// dbg -- el: el42 parent:el40 kind:List
+el40.appendChild(el42);
+// dbg -- el: el40 parent:el39 kind:ListItem
+el39.appendChild(el40);
+// dbg -- el: el39 parent:el38 kind:List
+el38.appendChild(el39);
+// dbg -- el: el38 parent:mdDiv kind:Document
+mdDiv.appendChild(el38);
+let el43= document.createElement('ul');
+Object.assign(el43.style, mdStyle.ul);
+let el44= document.createElement('li');
+Object.assign(el44.style, mdStyle.li);
+// dbg -- pelNam: el44 children: 1
+el44.textContent=`item-3`;
+let el45= document.createElement('ul');
+Object.assign(el45.style, mdStyle.ul);
+let el46= document.createElement('li');
+Object.assign(el46.style, mdStyle.li);
+// dbg -- pelNam: el46 children: 1
+el46.textContent=`sub-item-5`;
+// dbg -- el: el46 parent:el45 kind:List
+el45.appendChild(el46);
+let el47= document.createElement('li');
+Object.assign(el47.style, mdStyle.li);
+// dbg -- pelNam: el47 children: 1
+el47.textContent=`sub-item-6`;
+// dbg -- el: el47 parent:el45 kind:List
+el45.appendChild(el47);
+// dbg -- el: el45 parent:el44 kind:ListItem
+el44.appendChild(el45);
+// dbg -- el: el44 parent:el43 kind:List
+el43.appendChild(el44);
+// dbg -- el: el43 parent:mdDiv kind:Document
+mdDiv.appendChild(el43);
+let el48=document.createElement('p');
+Object.assign(el48.style, mdStyle.p);
+// dbg -- pelNam: el48 children: 1
+el48.textContent=`ordered list:`;
+// dbg -- par el: el48 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el48);
+let el49= document.createElement('ol');
+Object.assign(el49.style, mdStyle.ol);
+let el50= document.createElement('li');
+Object.assign(el50.style, mdStyle.li);
+// dbg -- pelNam: el50 children: 1
+el50.textContent=`item-1`;
+let el51= document.createElement('ol');
+Object.assign(el51.style, mdStyle.ol);
+let el52= document.createElement('li');
+Object.assign(el52.style, mdStyle.li);
+// dbg -- pelNam: el52 children: 1
+el52.textContent=`sub-item-1`;
+// dbg -- el: el52 parent:el51 kind:List
+el51.appendChild(el52);
+let el53= document.createElement('li');
+Object.assign(el53.style, mdStyle.li);
+// dbg -- pelNam: el53 children: 1
+el53.textContent=`sub-item-2`;
+// dbg -- el: el53 parent:el51 kind:List
+el51.appendChild(el53);
+// dbg -- el: el51 parent:el50 kind:ListItem
+el50.appendChild(el51);
+// dbg -- el: el50 parent:el49 kind:List
+el49.appendChild(el50);
+let el54= document.createElement('li');
+Object.assign(el54.style, mdStyle.li);
+// dbg -- pelNam: el54 children: 1
+el54.textContent=`item-2`;
+let el55= document.createElement('ol');
+Object.assign(el55.style, mdStyle.ol);
+let el56= document.createElement('li');
+Object.assign(el56.style, mdStyle.li);
+// dbg -- pelNam: el56 children: 1
+el56.textContent=`sub-item-3`;
+// dbg -- el: el56 parent:el55 kind:List
+el55.appendChild(el56);
+let el57= document.createElement('li');
+Object.assign(el57.style, mdStyle.li);
+// dbg -- pelNam: el57 children: 1
+el57.textContent=`sub-item-4`;
+// dbg -- el: el57 parent:el55 kind:List
+el55.appendChild(el57);
+// dbg -- el: el55 parent:el54 kind:ListItem
+el54.appendChild(el55);
+// dbg -- el: el54 parent:el49 kind:List
+el49.appendChild(el54);
+let el58= document.createElement('li');
+Object.assign(el58.style, mdStyle.li);
+// dbg -- pelNam: el58 children: 1
+el58.textContent=`item-3`;
+// dbg -- el: el58 parent:el49 kind:List
+el49.appendChild(el58);
+// dbg -- el: el49 parent:mdDiv kind:Document
+mdDiv.appendChild(el49);
+let el59= document.createElement('h4');
+Object.assign(el59.style, mdStyle.h4);
+el59.id='header4';
+const el60Txt= `Header4`;
+const el60=document.createTextNode(el60Txt);
+// dbg -- el: el60 parent:el59 kind:Heading
+el59.appendChild(el60);
+// dbg -- el: el59 parent:mdDiv kind:Document
+mdDiv.appendChild(el59);
+let el61=document.createElement('p');
+Object.assign(el61.style, mdStyle.p);
+// dbg -- pelNam: el61 children: 5
+const el62=document.createTextNode(`Table Header-1 | Table Header-2 | Table Header-3:--- | :---: | ---:Table Data-1 | Table Data-2 | Table Data-3TD-4 | Td-5 | TD-6Table Data-7 | Table Data-8 | Table Data-9`);
+el61.appendChild(el62);
+// dbg -- par el: el61 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el61);
+let el63= document.createElement('h5');
+Object.assign(el63.style, mdStyle.h5);
+el63.id='header5';
+const el64Txt= `Header5`;
+const el64=document.createTextNode(el64Txt);
+// dbg -- el: el64 parent:el63 kind:Heading
+el63.appendChild(el64);
+// dbg -- el: el63 parent:mdDiv kind:Document
+mdDiv.appendChild(el63);
+let el65=document.createElement('p');
+Object.assign(el65.style, mdStyle.p);
+// dbg -- pelNam: el65 children: 3
+const el66=document.createTextNode(`You may also want some images right in here like `);
+el65.appendChild(el66);
+let el67=document.createElement('img');
+el67.src='https://cloud.githubusercontent.com/assets/5456665/13322882/e74f6626-dc00-11e5-921d-f6d024a01eaa.png';
+el67.alt='GitHub Logo';
+el67.title='GitHub';
+// dbg -- el: el67 parent:el65 kind:Paragraph
+el65.appendChild(el67);
+const el68=document.createTextNode(` - you can do that but I would recommend you to use the component "image" and simply split your text.`);
+el65.appendChild(el68);
+// dbg -- par el: el65 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el65);
+let el69= document.createElement('h6');
+Object.assign(el69.style, mdStyle.h6);
+el69.id='header6';
+const el70Txt= `Header6`;
+const el70=document.createTextNode(el70Txt);
+// dbg -- el: el70 parent:el69 kind:Heading
+el69.appendChild(el70);
+// dbg -- el: el69 parent:mdDiv kind:Document
+mdDiv.appendChild(el69);
+let el71=document.createElement('p');
+Object.assign(el71.style, mdStyle.p);
+// dbg -- pelNam: el71 children: 4
+const el72=document.createTextNode(`Let us do some links - this for example: https://github.com/MinhasKamal/github-markdown-syntax is `);
+el71.appendChild(el72);
+let el73=document.createElement('strong');
+// dbg -- pelNam: el73 children: 1
+el73.textContent=`NOT`;
+el71.appendChild(el73);
+const el74=document.createTextNode(` a link but this: is `);
+el71.appendChild(el74);
+let el75=document.createElement("a");
+el75.href='https://github.com/MinhasKamal/github-markdown-syntax';
+Object.assign(el75.style, mdStyle.a);
+el75.textContent=`GitHub`;
+// dbg -- el: el75 parent:el71 kind:Paragraph
+el71.appendChild(el75);
+// dbg -- par el: el71 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el71);
+return mdDiv;
+};
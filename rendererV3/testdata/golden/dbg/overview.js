@@ -0,0 +1,68 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h2');
+Object.assign(el2.style, mdStyle.h2);
+el2.id='overview';
+const el3Txt= `Overview`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4= document.createElement('h3');
+Object.assign(el4.style, mdStyle.h3);
+el4.id='philosophy';
+const el5Txt= `Philosophy`;
+const el5=document.createTextNode(el5Txt);
+// dbg -- el: el5 parent:el4 kind:Heading
+el4.appendChild(el5);
+// dbg -- el: el4 parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el6=document.createElement('p');
+Object.assign(el6.style, mdStyle.p);
+// dbg -- pelNam: el6 children: 1
+el6.textContent=`Markdown is intended to be as easy-to-read and easy-to-write as is feasible.`;
+// dbg -- par el: el6 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el6);
+let el7=document.createElement('p');
+Object.assign(el7.style, mdStyle.p);
+// dbg -- pelNam: el7 children: 12
+const el8=document.createTextNode(`Readability, however, is emphasized above all else. A Markdown-formatteddocument should be publishable as-is, as plain text, without lookinglike it's been marked up with tags or formatting instructions. WhileMarkdown's syntax has been influenced by several existing text-to-HTMLfilters -- including `);
+el7.appendChild(el8);
+let el9=document.createElement("a");
+el9.href='http://docutils.sourceforge.net/mirror/setext.html';
+Object.assign(el9.style, mdStyle.a);
+el9.textContent=`Setext`;
+// dbg -- el: el9 parent:el7 kind:Paragraph
+el7.appendChild(el9);
+const el10=document.createTextNode(`, [atx](http://www.aaronsw.com/2002/at>`);
+el7.appendChild(el10);
+let el11=document.createElement("a");
+el11.href='http://www.triptico.com/software/grutatxt.html';
+Object.assign(el11.style, mdStyle.a);
+el11.textContent=`Grutatext`;
+// dbg -- el: el11 parent:el7 kind:Paragraph
+el7.appendChild(el11);
+const el12=document.createTextNode(`, and `);
+el7.appendChild(el12);
+let el13=document.createElement("a");
+el13.href='http://ettext.taint.org/doc/';
+Object.assign(el13.style, mdStyle.a);
+el13.textContent=`EtText`;
+// dbg -- el: el13 parent:el7 kind:Paragraph
+el7.appendChild(el13);
+const el14=document.createTextNode(` -- the single b>inspiration for Markdown's syntax is the format of plain text email.`);
+el7.appendChild(el14);
+// dbg -- par el: el7 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el7);
+return mdDiv;
+};
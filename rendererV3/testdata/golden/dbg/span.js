@@ -0,0 +1,263 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h2');
+Object.assign(el2.style, mdStyle.h2);
+el2.id='span-elements';
+const el3Txt= `Span Elements`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4= document.createElement('h3');
+Object.assign(el4.style, mdStyle.h3);
+el4.id='links';
+const el5Txt= `Links`;
+const el5=document.createTextNode(el5Txt);
+// dbg -- el: el5 parent:el4 kind:Heading
+el4.appendChild(el5);
+// dbg -- el: el4 parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el6=document.createElement('p');
+Object.assign(el6.style, mdStyle.p);
+// dbg -- pelNam: el6 children: 5
+const el7=document.createTextNode(`Markdown supports two style of links: `);
+el6.appendChild(el7);
+let el8=document.createElement('em');
+// dbg -- pelNam: el8 children: 1
+el8.textContent=`inline`;
+el6.appendChild(el8);
+const el9=document.createTextNode(` and `);
+el6.appendChild(el9);
+let el10=document.createElement('em');
+// dbg -- pelNam: el10 children: 1
+el10.textContent=`reference`;
+el6.appendChild(el10);
+const el11=document.createTextNode(`.`);
+el6.appendChild(el11);
+// dbg -- par el: el6 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el6);
+let el12=document.createElement('p');
+Object.assign(el12.style, mdStyle.p);
+// dbg -- pelNam: el12 children: 3
+const el13=document.createTextNode(`In both styles, the link text is delimited by [square brackets].`);
+el12.appendChild(el13);
+// dbg -- par el: el12 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el12);
+let el14=document.createElement('p');
+Object.assign(el14.style, mdStyle.p);
+// dbg -- pelNam: el14 children: 6
+const el15=document.createTextNode(`To create an inline link, use a set of regular parentheses immediatelyafter the link text's closing square bracket. Inside the parentheses,put the URL where you want the link to point, along with an `);
+el14.appendChild(el15);
+let el16=document.createElement('em');
+// dbg -- pelNam: el16 children: 1
+el16.textContent=`optional`;
+el14.appendChild(el16);
+const el17=document.createTextNode(`title for the link, surrounded in quotes. For example:`);
+el14.appendChild(el17);
+// dbg -- par el: el14 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el14);
+let el18=document.createElement('p');
+Object.assign(el18.style, mdStyle.p);
+// dbg -- pelNam: el18 children: 3
+const el19=document.createTextNode(`This is `);
+el18.appendChild(el19);
+let el20=document.createElement("a");
+el20.href='http://example.com/';
+Object.assign(el20.style, mdStyle.a);
+el20.textContent=`an example`;
+// dbg -- el: el20 parent:el18 kind:Paragraph
+el18.appendChild(el20);
+const el21=document.createTextNode(` inline link.`);
+el18.appendChild(el21);
+// dbg -- par el: el18 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el18);
+let el22=document.createElement('p');
+Object.assign(el22.style, mdStyle.p);
+// dbg -- pelNam: el22 children: 2
+let el23=document.createElement("a");
+el23.href='http://example.net/';
+Object.assign(el23.style, mdStyle.a);
+el23.textContent=`This link`;
+// dbg -- el: el23 parent:el22 kind:Paragraph
+el22.appendChild(el23);
+const el24=document.createTextNode(` has no title attribute.`);
+el22.appendChild(el24);
+// dbg -- par el: el22 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el22);
+let el25= document.createElement('h3');
+Object.assign(el25.style, mdStyle.h3);
+el25.id='emphasis';
+const el26Txt= `Emphasis`;
+const el26=document.createTextNode(el26Txt);
+// dbg -- el: el26 parent:el25 kind:Heading
+el25.appendChild(el26);
+// dbg -- el: el25 parent:mdDiv kind:Document
+mdDiv.appendChild(el25);
+let el27=document.createElement('p');
+Object.assign(el27.style, mdStyle.p);
+// dbg -- pelNam: el27 children: 19
+const el28=document.createTextNode(`Markdown treats asterisks (`);
+el27.appendChild(el28);
+let el29=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "*"
+const el29Span1=document.createTextNode('*');
+el29.appendChild(el29Span1);
+// dbg -- codespan el: el29 kind: CodeSpan parent:el27 kind:Paragraph
+el27.appendChild(el29);
+const el30=document.createTextNode(`) and underscores (`);
+el27.appendChild(el30);
+let el31=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "_"
+const el31Span1=document.createTextNode('_');
+el31.appendChild(el31Span1);
+// dbg -- codespan el: el31 kind: CodeSpan parent:el27 kind:Paragraph
+el27.appendChild(el31);
+const el32=document.createTextNode(`) as indicators ofemphasis. Text wrapped with one `);
+el27.appendChild(el32);
+let el33=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "*"
+const el33Span1=document.createTextNode('*');
+el33.appendChild(el33Span1);
+// dbg -- codespan el: el33 kind: CodeSpan parent:el27 kind:Paragraph
+el27.appendChild(el33);
+const el34=document.createTextNode(` or `);
+el27.appendChild(el34);
+let el35=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "_"
+const el35Span1=document.createTextNode('_');
+el35.appendChild(el35Span1);
+// dbg -- codespan el: el35 kind: CodeSpan parent:el27 kind:Paragraph
+el27.appendChild(el35);
+const el36=document.createTextNode(` will be wrapped with anHTML `);
+el27.appendChild(el36);
+let el37=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[4]: "<em>"
+const el37Span1=document.createTextNode('<em>');
+el37.appendChild(el37Span1);
+// dbg -- codespan el: el37 kind: CodeSpan parent:el27 kind:Paragraph
+el27.appendChild(el37);
+const el38=document.createTextNode(` tag; double `);
+el27.appendChild(el38);
+let el39=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "*"
+const el39Span1=document.createTextNode('*');
+el39.appendChild(el39Span1);
+// dbg -- codespan el: el39 kind: CodeSpan parent:el27 kind:Paragraph
+el27.appendChild(el39);
+const el40=document.createTextNode(`'s or `);
+el27.appendChild(el40);
+let el41=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "_"
+const el41Span1=document.createTextNode('_');
+el41.appendChild(el41Span1);
+// dbg -- codespan el: el41 kind: CodeSpan parent:el27 kind:Paragraph
+el27.appendChild(el41);
+const el42=document.createTextNode(`'s will be wrapped with an HTML`);
+el27.appendChild(el42);
+let el43=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[8]: "<strong>"
+const el43Span1=document.createTextNode('<strong>');
+el43.appendChild(el43Span1);
+// dbg -- codespan el: el43 kind: CodeSpan parent:el27 kind:Paragraph
+el27.appendChild(el43);
+const el44=document.createTextNode(` tag. E.g., this input:`);
+el27.appendChild(el44);
+// dbg -- par el: el27 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el27);
+let el45=document.createElement('p');
+Object.assign(el45.style, mdStyle.p);
+// dbg -- pelNam: el45 children: 1
+let el46=document.createElement('em');
+// dbg -- pelNam: el46 children: 1
+el46.textContent=`single asterisks`;
+el45.appendChild(el46);
+// dbg -- par el: el45 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el45);
+let el47=document.createElement('p');
+Object.assign(el47.style, mdStyle.p);
+// dbg -- pelNam: el47 children: 1
+let el48=document.createElement('em');
+// dbg -- pelNam: el48 children: 1
+el48.textContent=`single underscores`;
+el47.appendChild(el48);
+// dbg -- par el: el47 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el47);
+let el49=document.createElement('p');
+Object.assign(el49.style, mdStyle.p);
+// dbg -- pelNam: el49 children: 1
+let el50=document.createElement('strong');
+// dbg -- pelNam: el50 children: 1
+el50.textContent=`double asterisks`;
+el49.appendChild(el50);
+// dbg -- par el: el49 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el49);
+let el51=document.createElement('p');
+Object.assign(el51.style, mdStyle.p);
+// dbg -- pelNam: el51 children: 1
+let el52=document.createElement('strong');
+// dbg -- pelNam: el52 children: 1
+el52.textContent=`double underscores`;
+el51.appendChild(el52);
+// dbg -- par el: el51 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el51);
+let el53= document.createElement('h3');
+Object.assign(el53.style, mdStyle.h3);
+el53.id='code';
+const el54Txt= `Code`;
+const el54=document.createTextNode(el54Txt);
+// dbg -- el: el54 parent:el53 kind:Heading
+el53.appendChild(el54);
+// dbg -- el: el53 parent:mdDiv kind:Document
+mdDiv.appendChild(el53);
+let el55=document.createElement('p');
+Object.assign(el55.style, mdStyle.p);
+// dbg -- pelNam: el55 children: 5
+const el56=document.createTextNode(`To indicate a span of code, wrap it with backtick quotes (`);
+el55.appendChild(el56);
+let el57=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[1]: "`"
+const el57Span1=document.createTextNode('`');
+el57.appendChild(el57Span1);
+// dbg -- codespan el: el57 kind: CodeSpan parent:el55 kind:Paragraph
+el55.appendChild(el57);
+const el58=document.createTextNode(`).Unlike a pre-formatted code block, a code span indicates code within anormal paragraph. For example:`);
+el55.appendChild(el58);
+// dbg -- par el: el55 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el55);
+let el59=document.createElement('p');
+Object.assign(el59.style, mdStyle.p);
+// dbg -- pelNam: el59 children: 3
+const el60=document.createTextNode(`Use the `);
+el59.appendChild(el60);
+let el61=document.createElement("code");
+//dbg -- codespan: children 1
+//dbg -- child[8]: "printf()"
+const el61Span1=document.createTextNode('printf()');
+el61.appendChild(el61Span1);
+// dbg -- codespan el: el61 kind: CodeSpan parent:el59 kind:Paragraph
+el59.appendChild(el61);
+const el62=document.createTextNode(` function.`);
+el59.appendChild(el62);
+// dbg -- par el: el59 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el59);
+return mdDiv;
+};
@@ -0,0 +1,103 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h1');
+Object.assign(el2.style, mdStyle.h1);
+el2.id='markdown-test-file';
+const el3Txt= `markdown test file`;
+const el3=document.createTextNode(el3Txt);
+// dbg -- el: el3 parent:el2 kind:Heading
+el2.appendChild(el3);
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+// dbg -- pelNam: el4 children: 1
+el4.textContent=`This is a test file to check the markdown conversion process.`;
+// dbg -- par el: el4 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el4);
+let el5= document.createElement('h2');
+Object.assign(el5.style, mdStyle.h2);
+el5.id='second-heading';
+const el6Txt= `Second heading`;
+const el6=document.createTextNode(el6Txt);
+// dbg -- el: el6 parent:el5 kind:Heading
+el5.appendChild(el6);
+// dbg -- el: el5 parent:mdDiv kind:Document
+mdDiv.appendChild(el5);
+let el7=document.createElement('p');
+Object.assign(el7.style, mdStyle.p);
+// dbg -- pelNam: el7 children: 1
+el7.textContent=`This paragrapgh is written under the second heading.`;
+// dbg -- par el: el7 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el7);
+let el8= document.createElement('h3');
+Object.assign(el8.style, mdStyle.h3);
+el8.id='third-heading';
+const el9Txt= `Third Heading`;
+const el9=document.createTextNode(el9Txt);
+// dbg -- el: el9 parent:el8 kind:Heading
+el8.appendChild(el9);
+// dbg -- el: el8 parent:mdDiv kind:Document
+mdDiv.appendChild(el8);
+let el10=document.createElement('p');
+Object.assign(el10.style, mdStyle.p);
+// dbg -- pelNam: el10 children: 1
+el10.textContent=`This paragrapgh is written under the third heading.`;
+// dbg -- par el: el10 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el10);
+let el11= document.createElement('h4');
+Object.assign(el11.style, mdStyle.h4);
+el11.id='fourth-heading';
+const el12Txt= `Fourth Heading`;
+const el12=document.createTextNode(el12Txt);
+// dbg -- el: el12 parent:el11 kind:Heading
+el11.appendChild(el12);
+// dbg -- el: el11 parent:mdDiv kind:Document
+mdDiv.appendChild(el11);
+let el13=document.createElement('p');
+Object.assign(el13.style, mdStyle.p);
+// dbg -- pelNam: el13 children: 1
+el13.textContent=`This paragrapgh is written under the fourth heading.`;
+// dbg -- par el: el13 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el13);
+let el14= document.createElement('h5');
+Object.assign(el14.style, mdStyle.h5);
+el14.id='fifth-heading';
+const el15Txt= `Fifth Heading`;
+const el15=document.createTextNode(el15Txt);
+// dbg -- el: el15 parent:el14 kind:Heading
+el14.appendChild(el15);
+// dbg -- el: el14 parent:mdDiv kind:Document
+mdDiv.appendChild(el14);
+let el16=document.createElement('p');
+Object.assign(el16.style, mdStyle.p);
+// dbg -- pelNam: el16 children: 1
+el16.textContent=`This paragrapgh is written under the fifth heading.`;
+// dbg -- par el: el16 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el16);
+let el17= document.createElement('h6');
+Object.assign(el17.style, mdStyle.h6);
+el17.id='sixth-heading';
+const el18Txt= `Sixth Heading`;
+const el18=document.createTextNode(el18Txt);
+// dbg -- el: el18 parent:el17 kind:Heading
+el17.appendChild(el18);
+// dbg -- el: el17 parent:mdDiv kind:Document
+mdDiv.appendChild(el17);
+let el19=document.createElement('p');
+Object.assign(el19.style, mdStyle.p);
+// dbg -- pelNam: el19 children: 1
+el19.textContent=`This paragrapgh is written under the sixth heading.`;
+// dbg -- par el: el19 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el19);
+return mdDiv;
+};
@@ -0,0 +1,57 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2=document.createElement('hr');
+// dbg -- el: el2 parent:mdDiv kind:Document
+mdDiv.appendChild(el2);
+let el3=document.createElement('p');
+Object.assign(el3.style, mdStyle.p);
+// dbg -- pelNam: el3 children: 5
+const el4=document.createTextNode(`author: prrdate: 20 Nov 2024layout: posttitle: I Love Markdowntags:`);
+el3.appendChild(el4);
+// dbg -- par el: el3 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el3);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+// dbg -- pelNam: el6 children: 1
+el6.textContent=`test`;
+// dbg -- el: el6 parent:el5 kind:List
+el5.appendChild(el6);
+let el7= document.createElement('li');
+Object.assign(el7.style, mdStyle.li);
+// dbg -- pelNam: el7 children: 1
+el7.textContent=`example`;
+// dbg -- el: el7 parent:el5 kind:List
+el5.appendChild(el7);
+// dbg -- el: el5 parent:mdDiv kind:Document
+mdDiv.appendChild(el5);
+let el8=document.createElement('hr');
+// dbg -- el: el8 parent:mdDiv kind:Document
+mdDiv.appendChild(el8);
+let el9= document.createElement('h1');
+Object.assign(el9.style, mdStyle.h1);
+el9.id='yaml-test-document';
+const el10Txt= `Yaml Test Document`;
+const el10=document.createTextNode(el10Txt);
+// dbg -- el: el10 parent:el9 kind:Heading
+el9.appendChild(el10);
+// dbg -- el: el9 parent:mdDiv kind:Document
+mdDiv.appendChild(el9);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+// dbg -- pelNam: el11 children: 1
+el11.textContent=`This is a a test document to check a yaml section for meta data of this document.`;
+// dbg -- par el: el11 kind: Paragraph parent:mdDiv kind:Document
+mdDiv.appendChild(el11);
+return mdDiv;
+};
@@ -0,0 +1,97 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h2');
+Object.assign(el2.style, mdStyle.h2);
+el2.id='block-element-test';
+const el3Txt= `Block Element Test`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4= document.createElement('h3');
+Object.assign(el4.style, mdStyle.h3);
+el4.id='paragraphs-with-links';
+const el5Txt= `Paragraphs with links`;
+const el5=document.createTextNode(el5Txt);
+el4.appendChild(el5);
+mdDiv.appendChild(el4);
+let el6=document.createElement('p');
+Object.assign(el6.style, mdStyle.p);
+const el7=document.createTextNode(`A paragraph is simply one or more consecutive lines of text, separatedby one or more blank lines. (A blank line is any line that looks like ablank line -- a line containing nothing but spaces or tabs is consideredblank.) Normal paragraphs should not be indented with spaces or tabs.`);
+el6.appendChild(el7);
+mdDiv.appendChild(el6);
+let el8= document.createElement('h3');
+Object.assign(el8.style, mdStyle.h3);
+el8.id='links';
+const el9Txt= `Links`;
+const el9=document.createTextNode(el9Txt);
+el8.appendChild(el9);
+mdDiv.appendChild(el8);
+let el10=document.createElement('p');
+Object.assign(el10.style, mdStyle.p);
+const el11=document.createTextNode(`Markdown supports two style of links: `);
+el10.appendChild(el11);
+let el12=document.createElement('em');
+el12.textContent=`inline`;
+el10.appendChild(el12);
+const el13=document.createTextNode(` and `);
+el10.appendChild(el13);
+let el14=document.createElement('em');
+el14.textContent=`reference`;
+el10.appendChild(el14);
+const el15=document.createTextNode(`.`);
+el10.appendChild(el15);
+mdDiv.appendChild(el10);
+let el16=document.createElement('p');
+Object.assign(el16.style, mdStyle.p);
+const el17=document.createTextNode(`In both styles, the link text is delimited by [square brackets].`);
+el16.appendChild(el17);
+mdDiv.appendChild(el16);
+let el18=document.createElement('p');
+Object.assign(el18.style, mdStyle.p);
+const el19=document.createTextNode(`To create an inline link, use a set of regular parentheses immediatelyafter the link text's closing square bracket. Inside the parentheses,put the URL where you want the link to point, along with an `);
+el18.appendChild(el19);
+let el20=document.createElement('em');
+el20.textContent=`optional`;
+el18.appendChild(el20);
+const el21=document.createTextNode(`title for the link, surrounded in quotes. For example:`);
+el18.appendChild(el21);
+mdDiv.appendChild(el18);
+let el22=document.createElement('p');
+Object.assign(el22.style, mdStyle.p);
+const el23=document.createTextNode(`This is `);
+el22.appendChild(el23);
+let el24=document.createElement("a");
+el24.href='http://example.com/';
+Object.assign(el24.style, mdStyle.a);
+el24.textContent=`an example`;
+el22.appendChild(el24);
+const el25=document.createTextNode(` inline link.`);
+el22.appendChild(el25);
+mdDiv.appendChild(el22);
+let el26=document.createElement('p');
+Object.assign(el26.style, mdStyle.p);
+let el27=document.createElement("a");
+el27.href='http://example.net/';
+Object.assign(el27.style, mdStyle.a);
+el27.textContent=`This link`;
+el26.appendChild(el27);
+const el28=document.createTextNode(` has no title attribute.`);
+el26.appendChild(el28);
+mdDiv.appendChild(el26);
+let el29=document.createElement('p');
+Object.assign(el29.style, mdStyle.p);
+let el30=document.createElement('strong');
+el30.textContent=`End of Link Element Test`;
+el29.appendChild(el30);
+mdDiv.appendChild(el29);
+return mdDiv;
+};
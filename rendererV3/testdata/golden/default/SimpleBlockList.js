@@ -0,0 +1,52 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2=document.createElement('p');
+Object.assign(el2.style, mdStyle.p);
+const el3=document.createTextNode(`you'd get the exact same HTML output. The point is, if you want to,you can use ordinal numbers in your ordered Markdown lists, so thatthe numbers in your source match the numbers in your published HTML.But if you want to be lazy, you don't have to.`);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+el4.textContent=`To make lists look nice, you can wrap items with hanging indents:`;
+mdDiv.appendChild(el4);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+const el7=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el6.appendChild(el7);
+el5.appendChild(el6);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+const el9=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el8.appendChild(el9);
+el5.appendChild(el8);
+mdDiv.appendChild(el5);
+let el10=document.createElement('p');
+Object.assign(el10.style, mdStyle.p);
+el10.textContent=`But if you want to be lazy, you don't have to:`;
+mdDiv.appendChild(el10);
+let el11= document.createElement('ul');
+Object.assign(el11.style, mdStyle.ul);
+let el12= document.createElement('li');
+Object.assign(el12.style, mdStyle.li);
+const el13=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el12.appendChild(el13);
+el11.appendChild(el12);
+let el14= document.createElement('li');
+Object.assign(el14.style, mdStyle.li);
+const el15=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el14.appendChild(el15);
+el11.appendChild(el14);
+mdDiv.appendChild(el11);
+return mdDiv;
+};
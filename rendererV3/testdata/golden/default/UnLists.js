@@ -0,0 +1,84 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h3');
+Object.assign(el2.style, mdStyle.h3);
+el2.id='lists';
+const el3Txt= `Lists`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+el4.textContent=`Markdown supports ordered (numbered) and unordered (bulleted) lists.`;
+mdDiv.appendChild(el4);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+el6.textContent=`Red`;
+el5.appendChild(el6);
+let el7= document.createElement('li');
+Object.assign(el7.style, mdStyle.li);
+el7.textContent=`Green`;
+el5.appendChild(el7);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+el8.textContent=`Blue`;
+el5.appendChild(el8);
+mdDiv.appendChild(el5);
+let el9=document.createElement('p');
+Object.assign(el9.style, mdStyle.p);
+el9.textContent=`is equivalent to:`;
+mdDiv.appendChild(el9);
+let el10= document.createElement('ul');
+Object.assign(el10.style, mdStyle.ul);
+let el11= document.createElement('li');
+Object.assign(el11.style, mdStyle.li);
+el11.textContent=`Red`;
+el10.appendChild(el11);
+let el12= document.createElement('li');
+Object.assign(el12.style, mdStyle.li);
+el12.textContent=`Green`;
+el10.appendChild(el12);
+let el13= document.createElement('li');
+Object.assign(el13.style, mdStyle.li);
+el13.textContent=`Blue`;
+el10.appendChild(el13);
+mdDiv.appendChild(el10);
+let el14=document.createElement('p');
+Object.assign(el14.style, mdStyle.p);
+el14.textContent=`and:`;
+mdDiv.appendChild(el14);
+let el15= document.createElement('ul');
+Object.assign(el15.style, mdStyle.ul);
+let el16= document.createElement('li');
+Object.assign(el16.style, mdStyle.li);
+el16.textContent=`Red`;
+el15.appendChild(el16);
+let el17= document.createElement('li');
+Object.assign(el17.style, mdStyle.li);
+el17.textContent=`Green`;
+el15.appendChild(el17);
+let el18= document.createElement('li');
+Object.assign(el18.style, mdStyle.li);
+el18.textContent=`Blue`;
+el15.appendChild(el18);
+mdDiv.appendChild(el15);
+let el19= document.createElement('h2');
+Object.assign(el19.style, mdStyle.h2);
+el19.id='end-of-unordered-lists';
+const el20Txt= `End of Unordered Lists`;
+const el20=document.createTextNode(el20Txt);
+el19.appendChild(el20);
+mdDiv.appendChild(el19);
+return mdDiv;
+};
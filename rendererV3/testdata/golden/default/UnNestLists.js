@@ -0,0 +1,111 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h3');
+Object.assign(el2.style, mdStyle.h3);
+el2.id='lists';
+const el3Txt= `Lists`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+el4.textContent=`Markdown supports ordered (numbered) and unordered (bulleted) lists.`;
+mdDiv.appendChild(el4);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+el6.textContent=`Red`;
+el5.appendChild(el6);
+let el7= document.createElement('li');
+Object.assign(el7.style, mdStyle.li);
+el7.textContent=`Green`;
+el5.appendChild(el7);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+el8.textContent=`Blue`;
+el5.appendChild(el8);
+mdDiv.appendChild(el5);
+let el9=document.createElement('p');
+Object.assign(el9.style, mdStyle.p);
+el9.textContent=`Nested lists two levels`;
+mdDiv.appendChild(el9);
+let el10= document.createElement('ul');
+Object.assign(el10.style, mdStyle.ul);
+let el11= document.createElement('li');
+Object.assign(el11.style, mdStyle.li);
+el11.textContent=`Red`;
+let el12= document.createElement('ul');
+Object.assign(el12.style, mdStyle.ul);
+let el13= document.createElement('li');
+Object.assign(el13.style, mdStyle.li);
+el13.textContent=`Orange`;
+el12.appendChild(el13);
+let el14= document.createElement('li');
+Object.assign(el14.style, mdStyle.li);
+el14.textContent=`Purple`;
+el12.appendChild(el14);
+el11.appendChild(el12);
+el10.appendChild(el11);
+let el15= document.createElement('li');
+Object.assign(el15.style, mdStyle.li);
+el15.textContent=`Green`;
+el10.appendChild(el15);
+let el16= document.createElement('li');
+Object.assign(el16.style, mdStyle.li);
+el16.textContent=`Blue`;
+let el17= document.createElement('ul');
+Object.assign(el17.style, mdStyle.ul);
+let el18= document.createElement('li');
+Object.assign(el18.style, mdStyle.li);
+el18.textContent=`Dark Blue`;
+el17.appendChild(el18);
+let el19= document.createElement('li');
+Object.assign(el19.style, mdStyle.li);
+el19.textContent=`Light Blue`;
+el17.appendChild(el19);
+el16.appendChild(el17);
+el10.appendChild(el16);
+mdDiv.appendChild(el10);
+let el20=document.createElement('p');
+Object.assign(el20.style, mdStyle.p);
+el20.textContent=`Nested Lists with three levels`;
+mdDiv.appendChild(el20);
+let el21= document.createElement('ul');
+Object.assign(el21.style, mdStyle.ul);
+let el22= document.createElement('li');
+Object.assign(el22.style, mdStyle.li);
+el22.textContent=`Red Level 1`;
+let el23= document.createElement('ul');
+Object.assign(el23.style, mdStyle.ul);
+let el24= document.createElement('li');
+Object.assign(el24.style, mdStyle.li);
+el24.textContent=`Red Level 2`;
+el23.appendChild(el24);
+let el25= document.createElement('li');
+Object.assign(el25.style, mdStyle.li);
+const el26=document.createTextNode(`Orange Level 2*    Red Level 3*    Blue Level 3`);
+el25.appendChild(el26);
+el23.appendChild(el25);
+el22.appendChild(el23);
+el21.appendChild(el22);
+let el27= document.createElement('li');
+Object.assign(el27.style, mdStyle.li);
+el27.textContent=`Green`;
+el21.appendChild(el27);
+let el28= document.createElement('li');
+Object.assign(el28.style, mdStyle.li);
+el28.textContent=`Blue`;
+el21.appendChild(el28);
+mdDiv.appendChild(el21);
+return mdDiv;
+};
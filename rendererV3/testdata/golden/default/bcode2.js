@@ -0,0 +1,55 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2=document.createElement('p');
+Object.assign(el2.style, mdStyle.p);
+const el3=document.createTextNode(`Blockquotes can contain other Markdown elements, including headers, lists,and code blocks:`);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4= document.createElement('blockquote');
+Object.assign(el4.style, mdStyle.block);
+let el5= document.createElement('h2');
+Object.assign(el5.style, mdStyle.h2);
+el5.id='this-is-a-header';
+const el6Txt= `This is a header.`;
+const el6=document.createTextNode(el6Txt);
+el5.appendChild(el6);
+el4.appendChild(el5);
+let el7= document.createElement('ol');
+Object.assign(el7.style, mdStyle.ol);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+el8.textContent=`This is the first list item.`;
+el7.appendChild(el8);
+let el9= document.createElement('li');
+Object.assign(el9.style, mdStyle.li);
+el9.textContent=`This is the second list item.`;
+el7.appendChild(el9);
+el4.appendChild(el7);
+let el10=document.createElement('p');
+Object.assign(el10.style, mdStyle.p);
+el10.textContent=`Here's some example code:`;
+el4.appendChild(el10);
+let el11= document.createElement('pre');
+let el12= document.createElement('code');
+const el13= document.createTextNode(`return shell_exec("echo $input | $markdown_script");
+`);
+el12.appendChild(el13);
+el11.appendChild(el12);
+el4.appendChild(el11);
+mdDiv.appendChild(el4);
+let el14=document.createElement('p');
+Object.assign(el14.style, mdStyle.p);
+const el15=document.createTextNode(`Any decent text editor should make email-style quoting easy. Forexample, with BBEdit, you can make a selection and choose IncreaseQuote Level from the Text menu.`);
+el14.appendChild(el15);
+mdDiv.appendChild(el14);
+return mdDiv;
+};
@@ -0,0 +1,118 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h3');
+Object.assign(el2.style, mdStyle.h3);
+el2.id='code-blocks';
+const el3Txt= `Code Blocks`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+const el5=document.createTextNode(`Pre-formatted code blocks are used for writing about programming ormarkup source code. Rather than forming normal paragraphs, the linesof a code block are interpreted literally. Markdown wraps a code blockin both `);
+el4.appendChild(el5);
+let el6=document.createElement("code");
+const el6Span1=document.createTextNode('<pre>');
+el6.appendChild(el6Span1);
+el4.appendChild(el6);
+const el7=document.createTextNode(` and `);
+el4.appendChild(el7);
+let el8=document.createElement("code");
+const el8Span1=document.createTextNode('<code>');
+el8.appendChild(el8Span1);
+el4.appendChild(el8);
+const el9=document.createTextNode(` tags.`);
+el4.appendChild(el9);
+mdDiv.appendChild(el4);
+let el10=document.createElement('p');
+Object.assign(el10.style, mdStyle.p);
+const el11=document.createTextNode(`To produce a code block in Markdown, simply indent every line of theblock by at least 4 spaces or 1 tab.`);
+el10.appendChild(el11);
+mdDiv.appendChild(el10);
+let el12=document.createElement('p');
+Object.assign(el12.style, mdStyle.p);
+el12.textContent=`This is a normal paragraph:`;
+mdDiv.appendChild(el12);
+let el13= document.createElement('pre');
+let el14= document.createElement('code');
+const el15= document.createTextNode(`This is a code block.
+`);
+el14.appendChild(el15);
+el13.appendChild(el14);
+mdDiv.appendChild(el13);
+let el16=document.createElement('p');
+Object.assign(el16.style, mdStyle.p);
+el16.textContent=`Here is an example of AppleScript:`;
+mdDiv.appendChild(el16);
+let el17= document.createElement('pre');
+let el18= document.createElement('code');
+const el19= document.createTextNode(`tell application "Foo"
+    beep
+end tell
+`);
+el18.appendChild(el19);
+el17.appendChild(el18);
+mdDiv.appendChild(el17);
+let el20=document.createElement('p');
+Object.assign(el20.style, mdStyle.p);
+const el21=document.createTextNode(`A code block continues until it reaches a line that is not indented(or the end of the article).`);
+el20.appendChild(el21);
+mdDiv.appendChild(el20);
+let el22=document.createElement('p');
+Object.assign(el22.style, mdStyle.p);
+const el23=document.createTextNode(`Within a code block, ampersands (`);
+el22.appendChild(el23);
+let el24=document.createElement("code");
+const el24Span1=document.createTextNode('&');
+el24.appendChild(el24Span1);
+el22.appendChild(el24);
+const el25=document.createTextNode(`) and angle brackets (`);
+el22.appendChild(el25);
+let el26=document.createElement("code");
+const el26Span1=document.createTextNode('<');
+el26.appendChild(el26Span1);
+el22.appendChild(el26);
+const el27=document.createTextNode(` and `);
+el22.appendChild(el27);
+let el28=document.createElement("code");
+const el28Span1=document.createTextNode('>');
+el28.appendChild(el28Span1);
+el22.appendChild(el28);
+const el29=document.createTextNode(`)are automatically converted into HTML entities. This makes it veryeasy to include example HTML source code using Markdown -- just pasteit and indent it, and Markdown will handle the hassle of encoding theampersands and angle brackets. For example, this:`);
+el22.appendChild(el29);
+mdDiv.appendChild(el22);
+let el30= document.createElement('pre');
+let el31= document.createElement('code');
+const el32= document.createTextNode(`<div class="footer">
+    &copy; 2004 Foo Corporation
+</div>
+`);
+el31.appendChild(el32);
+el30.appendChild(el31);
+mdDiv.appendChild(el30);
+let el33=document.createElement('p');
+Object.assign(el33.style, mdStyle.p);
+const el34=document.createTextNode(`Regular Markdown syntax is not processed within code blocks. E.g.,asterisks are just literal asterisks within a code block. This meansit's also easy to use Markdown to write about Markdown's own syntax.`);
+el33.appendChild(el34);
+mdDiv.appendChild(el33);
+let el35= document.createElement('pre');
+let el36= document.createElement('code');
+const el37= document.createTextNode(`>tell application "Foo"
+>    beep
+>end tell
+`);
+el36.appendChild(el37);
+el35.appendChild(el36);
+// dbg -- el: el35 parent:mdDiv kind:Document
+mdDiv.appendChild(el35);
+return mdDiv;
+};
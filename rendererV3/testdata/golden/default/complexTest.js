@@ -0,0 +1,247 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h1');
+Object.assign(el2.style, mdStyle.h1);
+el2.id='h1';
+const el3Txt= `H1`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+const el5=document.createTextNode(`Lorem ipsum dolor sit amet, `);
+el4.appendChild(el5);
+let el6=document.createElement('em');
+el6.textContent=`consectetur`;
+el4.appendChild(el6);
+const el7=document.createTextNode(` adipisicing elit, sed do eiusmodtempor incididunt ut `);
+el4.appendChild(el7);
+let el8=document.createElement('strong');
+el8.textContent=`labore et dolore magna aliqua`;
+el4.appendChild(el8);
+const el9=document.createTextNode(`. Ut enim ad minim veniam,`);
+el4.appendChild(el9);
+mdDiv.appendChild(el4);
+let el10= document.createElement('blockquote');
+Object.assign(el10.style, mdStyle.block);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+el11.textContent=`quis nostrud exercitation ullamco laboris nisi ut aliquip ex ea commodo`;
+el10.appendChild(el11);
+mdDiv.appendChild(el10);
+let el12=document.createElement('p');
+Object.assign(el12.style, mdStyle.p);
+const el13=document.createTextNode(`consequat. `);
+el12.appendChild(el13);
+let el14=document.createElement('em');
+let el15=document.createElement('strong');
+el15.textContent=`Duis aute irure dolor`;
+el14.appendChild(el15);
+el12.appendChild(el14);
+const el16=document.createTextNode(` in reprehenderit in voluptate velit essecillum dolore eu fugiat nulla pariatur. ~~Excepteur sint occaecat~~ cupidatat nonproident, sunt in culpa qui officia deserunt mollit anim id est laborum.`);
+el12.appendChild(el16);
+mdDiv.appendChild(el12);
+let el17= document.createElement('h2');
+Object.assign(el17.style, mdStyle.h2);
+el17.id='h2';
+const el18Txt= `H2`;
+const el18=document.createTextNode(el18Txt);
+el17.appendChild(el18);
+mdDiv.appendChild(el17);
+let el19=document.createElement('p');
+Object.assign(el19.style, mdStyle.p);
+const el20=document.createTextNode(`Lorem ipsum dolor sit amet, `);
+el19.appendChild(el20);
+let el21=document.createElement('em');
+el21.textContent=`consectetur`;
+el19.appendChild(el21);
+const el22=document.createTextNode(` adipisicing elit, sed do eiusmodtempor incididunt ut `);
+el19.appendChild(el22);
+let el23=document.createElement('strong');
+el23.textContent=`labore et dolore magna aliqua`;
+el19.appendChild(el23);
+const el24=document.createTextNode(`. Ut enim ad minim veniam,quis nostrud exercitation ullamco laboris nisi ut aliquip ex ea commodoconsequat.`);
+el19.appendChild(el24);
+mdDiv.appendChild(el19);
+let el25=document.createElement('hr');
+mdDiv.appendChild(el25);
+let el26=document.createElement('p');
+Object.assign(el26.style, mdStyle.p);
+let el27=document.createElement('em');
+let el28=document.createElement('strong');
+el28.textContent=`Duis aute irure dolor`;
+el27.appendChild(el28);
+el26.appendChild(el27);
+const el29=document.createTextNode(` in reprehenderit in voluptate velit essecillum dolore eu fugiat nulla pariatur. ~~Excepteur sint occaecat~~ cupidatat nonproident, sunt in culpa qui officia deserunt mollit anim id est laborum.`);
+el26.appendChild(el29);
+mdDiv.appendChild(el26);
+let el30= document.createElement('h3');
+Object.assign(el30.style, mdStyle.h3);
+el30.id='h3';
+const el31Txt= `H3`;
+const el31=document.createTextNode(el31Txt);
+el30.appendChild(el31);
+mdDiv.appendChild(el30);
+let el32=document.createElement('p');
+Object.assign(el32.style, mdStyle.p);
+el32.textContent=`unordered list:`;
+mdDiv.appendChild(el32);
+let el33= document.createElement('ul');
+Object.assign(el33.style, mdStyle.ul);
+let el34= document.createElement('li');
+Object.assign(el34.style, mdStyle.li);
+el34.textContent=`item-1`;
+let el35= document.createElement('ul');
+Object.assign(el35.style, mdStyle.ul);
+let el36= document.createElement('li');
+Object.assign(el36.style, mdStyle.li);
+el36.textContent=`sub-item-1`;
+el35.appendChild(el36);
+let el37= document.createElement('li');
+Object.assign(el37.style, mdStyle.li);
+el37.textContent=`sub-item-2`;
+el35.appendChild(el37);
+el34.appendChild(el35);
+el33.appendChild(el34);
+mdDiv.appendChild(el33);
+let el38= document.createElement('ul');
+Object.assign(el38.style, mdStyle.ul);
+let el39= document.createElement('li');
+Object.assign(el39.style, mdStyle.li);
+el39.textContent=`item-2`;
+let el40= document.createElement('ul');
+Object.assign(el40.style, mdStyle.ul);
+let el41= document.createElement('li');
+Object.assign(el41.style, mdStyle.li);
+el41.textContent=`sub-item-3`;
+el40.appendChild(el41);
+let el42= document.createElement('li');
+Object.assign(el42.style, mdStyle.li);
+el42.textContent=`sub-item-4`;
+el40.appendChild(el42);
+el39.appendChild(el40);
+el38.appendChild(el39);
+mdDiv.appendChild(el38);
+let el43= document.createElement('ul');
+Object.assign(el43.style, mdStyle.ul);
+let el44= document.createElement('li');
+Object.assign(el44.style, mdStyle.li);
+el44.textContent=`item-3`;
+let el45= document.createElement('ul');
+Object.assign(el45.style, mdStyle.ul);
+let el46= document.createElement('li');
+Object.assign(el46.style, mdStyle.li);
+el46.textContent=`sub-item-5`;
+el45.appendChild(el46);
+let el47= document.createElement('li');
+Object.assign(el47.style, mdStyle.li);
+el47.textContent=`sub-item-6`;
+el45.appendChild(el47);
+el44.appendChild(el45);
+el43.appendChild(el44);
+mdDiv.appendChild(el43);
+let el48=document.createElement('p');
+Object.assign(el48.style, mdStyle.p);
+el48.textContent=`ordered list:`;
+mdDiv.appendChild(el48);
+let el49= document.createElement('ol');
+Object.assign(el49.style, mdStyle.ol);
+let el50= document.createElement('li');
+Object.assign(el50.style, mdStyle.li);
+el50.textContent=`item-1`;
+let el51= document.createElement('ol');
+Object.assign(el51.style, mdStyle.ol);
+let el52= document.createElement('li');
+Object.assign(el52.style, mdStyle.li);
+el52.textContent=`sub-item-1`;
+el51.appendChild(el52);
+let el53= document.createElement('li');
+Object.assign(el53.style, mdStyle.li);
+el53.textContent=`sub-item-2`;
+el51.appendChild(el53);
+el50.appendChild(el51);
+el49.appendChild(el50);
+let el54= document.createElement('li');
+Object.assign(el54.style, mdStyle.li);
+el54.textContent=`item-2`;
+let el55= document.createElement('ol');
+Object.assign(el55.style, mdStyle.ol);
+let el56= document.createElement('li');
+Object.assign(el56.style, mdStyle.li);
+el56.textContent=`sub-item-3`;
+el55.appendChild(el56);
+let el57= document.createElement('li');
+Object.assign(el57.style, mdStyle.li);
+el57.textContent=`sub-item-4`;
+el55.appendChild(el57);
+el54.appendChild(el55);
+el49.appendChild(el54);
+let el58= document.createElement('li');
+Object.assign(el58.style, mdStyle.li);
+el58.textContent=`item-3`;
+el49.appendChild(el58);
+mdDiv.appendChild(el49);
+let el59= document.createElement('h4');
+Object.assign(el59.style, mdStyle.h4);
+el59.id='header4';
+const el60Txt= `Header4`;
+const el60=document.createTextNode(el60Txt);
+el59.appendChild(el60);
+mdDiv.appendChild(el59);
+let el61=document.createElement('p');
+Object.assign(el61.style, mdStyle.p);
+const el62=document.createTextNode(`Table Header-1 | Table Header-2 | Table Header-3:--- | :---: | ---:Table Data-1 | Table Data-2 | Table Data-3TD-4 | Td-5 | TD-6Table Data-7 | Table Data-8 | Table Data-9`);
+el61.appendChild(el62);
+mdDiv.appendChild(el61);
+let el63= document.createElement('h5');
+Object.assign(el63.style, mdStyle.h5);
+el63.id='header5';
+const el64Txt= `Header5`;
+const el64=document.createTextNode(el64Txt);
+el63.appendChild(el64);
+mdDiv.appendChild(el63);
+let el65=document.createElement('p');
+Object.assign(el65.style, mdStyle.p);
+const el66=document.createTextNode(`You may also want some images right in here like `);
+el65.appendChild(el66);
+let el67=document.createElement('img');
+el67.src='https://cloud.githubusercontent.com/assets/5456665/13322882/e74f6626-dc00-11e5-921d-f6d024a01eaa.png';
+el67.alt='GitHub Logo';
+el67.title='GitHub';
+el65.appendChild(el67);
+const el68=document.createTextNode(` - you can do that but I would recommend you to use the component "image" and simply split your text.`);
+el65.appendChild(el68);
+mdDiv.appendChild(el65);
+let el69= document.createElement('h6');
+Object.assign(el69.style, mdStyle.h6);
+el69.id='header6';
+const el70Txt= `Header6`;
+const el70=document.createTextNode(el70Txt);
+el69.appendChild(el70);
+mdDiv.appendChild(el69);
+let el71=document.createElement('p');
+Object.assign(el71.style, mdStyle.p);
+const el72=document.createTextNode(`Let us do some links - this for example: https://github.com/MinhasKamal/github-markdown-syntax is `);
+el71.appendChild(el72);
+let el73=document.createElement('strong');
+el73.textContent=`NOT`;
+el71.appendChild(el73);
+const el74=document.createTextNode(` a link but this: is `);
+el71.appendChild(el74);
+let el75=document.createElement("a");
+el75.href='https://github.com/MinhasKamal/github-markdown-syntax';
+Object.assign(el75.style, mdStyle.a);
+el75.textContent=`GitHub`;
+el71.appendChild(el75);
+mdDiv.appendChild(el71);
+return mdDiv;
+};
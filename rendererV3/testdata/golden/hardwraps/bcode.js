@@ -0,0 +1,54 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h3');
+Object.assign(el2.style, mdStyle.h3);
+el2.id='blockquotes';
+const el3Txt= `Blockquotes`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+const el5=document.createTextNode(`Markdown uses email-style `);
+el4.appendChild(el5);
+let el6=document.createElement("code");
+const el6Span1=document.createTextNode('>');
+el6.appendChild(el6Span1);
+el4.appendChild(el6);
+const el7=document.createTextNode(` characters for blockquoting. If you'refamiliar with quoting passages of text in an email message, then youknow how to create a blockquote in Markdown. It looks best if you hardwrap the text and put a `);
+el4.appendChild(el7);
+let el8=document.createElement("code");
+const el8Span1=document.createTextNode('>');
+el8.appendChild(el8Span1);
+el4.appendChild(el8);
+const el9=document.createTextNode(` before every line:`);
+el4.appendChild(el9);
+mdDiv.appendChild(el4);
+let el10= document.createElement('blockquote');
+Object.assign(el10.style, mdStyle.block);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+const el12=document.createTextNode(`This is a blockquote with two paragraphs. Lorem ipsum dolor sit amet,consectetuer adipiscing elit. Aliquam hendrerit mi posuere lectus.Vestibulum enim wisi, viverra nec, fringilla in, laoreet vitae, risus.`);
+el11.appendChild(el12);
+el10.appendChild(el11);
+let el13=document.createElement('p');
+Object.assign(el13.style, mdStyle.p);
+const el14=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit. Suspendisseid sem consectetuer libero luctus adipiscing.`);
+el13.appendChild(el14);
+el10.appendChild(el13);
+mdDiv.appendChild(el10);
+let el15=document.createElement('p');
+Object.assign(el15.style, mdStyle.p);
+el15.textContent=`A final line for testing the block section.`;
+mdDiv.appendChild(el15);
+return mdDiv;
+};
@@ -0,0 +1,79 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h1');
+Object.assign(el2.style, mdStyle.h1);
+el2.id='markdown-test-file';
+const el3Txt= `markdown test file`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+el4.textContent=`This is a test file to check the markdown conversion process.`;
+mdDiv.appendChild(el4);
+let el5= document.createElement('h2');
+Object.assign(el5.style, mdStyle.h2);
+el5.id='second-heading';
+const el6Txt= `Second heading`;
+const el6=document.createTextNode(el6Txt);
+el5.appendChild(el6);
+mdDiv.appendChild(el5);
+let el7=document.createElement('p');
+Object.assign(el7.style, mdStyle.p);
+el7.textContent=`This paragrapgh is written under the second heading.`;
+mdDiv.appendChild(el7);
+let el8= document.createElement('h3');
+Object.assign(el8.style, mdStyle.h3);
+el8.id='third-heading';
+const el9Txt= `Third Heading`;
+const el9=document.createTextNode(el9Txt);
+el8.appendChild(el9);
+mdDiv.appendChild(el8);
+let el10=document.createElement('p');
+Object.assign(el10.style, mdStyle.p);
+el10.textContent=`This paragrapgh is written under the third heading.`;
+mdDiv.appendChild(el10);
+let el11= document.createElement('h4');
+Object.assign(el11.style, mdStyle.h4);
+el11.id='fourth-heading';
+const el12Txt= `Fourth Heading`;
+const el12=document.createTextNode(el12Txt);
+el11.appendChild(el12);
+mdDiv.appendChild(el11);
+let el13=document.createElement('p');
+Object.assign(el13.style, mdStyle.p);
+el13.textContent=`This paragrapgh is written under the fourth heading.`;
+mdDiv.appendChild(el13);
+let el14= document.createElement('h5');
+Object.assign(el14.style, mdStyle.h5);
+el14.id='fifth-heading';
+const el15Txt= `Fifth Heading`;
+const el15=document.createTextNode(el15Txt);
+el14.appendChild(el15);
+mdDiv.appendChild(el14);
+let el16=document.createElement('p');
+Object.assign(el16.style, mdStyle.p);
+el16.textContent=`This paragrapgh is written under the fifth heading.`;
+mdDiv.appendChild(el16);
+let el17= document.createElement('h6');
+Object.assign(el17.style, mdStyle.h6);
+el17.id='sixth-heading';
+const el18Txt= `Sixth Heading`;
+const el18=document.createTextNode(el18Txt);
+el17.appendChild(el18);
+mdDiv.appendChild(el17);
+let el19=document.createElement('p');
+Object.assign(el19.style, mdStyle.p);
+el19.textContent=`This paragrapgh is written under the sixth heading.`;
+mdDiv.appendChild(el19);
+return mdDiv;
+};
@@ -0,0 +1,169 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h2');
+Object.assign(el2.style, mdStyle.h2);
+el2.id='more-lists';
+const el3Txt= `More Lists`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+el4.textContent=`To make lists look nice, you can wrap items with hanging indents:`;
+mdDiv.appendChild(el4);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+const el7=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el6.appendChild(el7);
+el5.appendChild(el6);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+const el9=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el8.appendChild(el9);
+el5.appendChild(el8);
+mdDiv.appendChild(el5);
+let el10=document.createElement('p');
+Object.assign(el10.style, mdStyle.p);
+el10.textContent=`But if you want to be lazy, you don't have to:`;
+mdDiv.appendChild(el10);
+let el11= document.createElement('ul');
+Object.assign(el11.style, mdStyle.ul);
+let el12= document.createElement('li');
+Object.assign(el12.style, mdStyle.li);
+const el13=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el12.appendChild(el13);
+el11.appendChild(el12);
+let el14= document.createElement('li');
+Object.assign(el14.style, mdStyle.li);
+const el15=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el14.appendChild(el15);
+el11.appendChild(el14);
+mdDiv.appendChild(el11);
+let el16=document.createElement('p');
+Object.assign(el16.style, mdStyle.p);
+const el17=document.createTextNode(`List items may consist of multiple paragraphs. Each subsequentparagraph in a list item must be indented by either 4 spacesor one tab:`);
+el16.appendChild(el17);
+mdDiv.appendChild(el16);
+let el18= document.createElement('ol');
+Object.assign(el18.style, mdStyle.ol);
+let el19= document.createElement('li');
+Object.assign(el19.style, mdStyle.li);
+let el20=document.createElement('p');
+Object.assign(el20.style, mdStyle.p);
+const el21=document.createTextNode(`This is a list item with two paragraphs. Lorem ipsum dolorsit amet, consectetuer adipiscing elit. Aliquam hendreritmi posuere lectus.`);
+el20.appendChild(el21);
+el19.appendChild(el20);
+let el22=document.createElement('p');
+Object.assign(el22.style, mdStyle.p);
+const el23=document.createTextNode(`Vestibulum enim wisi, viverra nec, fringilla in, laoreetvitae, risus. Donec sit amet nisl. Aliquam semper ipsumsit amet velit.`);
+el22.appendChild(el23);
+el19.appendChild(el22);
+el18.appendChild(el19);
+let el24= document.createElement('li');
+Object.assign(el24.style, mdStyle.li);
+let el25=document.createElement('p');
+Object.assign(el25.style, mdStyle.p);
+el25.textContent=`Suspendisse id sem consectetuer libero luctus adipiscing.`;
+el24.appendChild(el25);
+el18.appendChild(el24);
+mdDiv.appendChild(el18);
+let el26=document.createElement('p');
+Object.assign(el26.style, mdStyle.p);
+const el27=document.createTextNode(`It looks nice if you indent every line of the subsequentparagraphs, but here again, Markdown will allow you to belazy:`);
+el26.appendChild(el27);
+mdDiv.appendChild(el26);
+let el28= document.createElement('ul');
+Object.assign(el28.style, mdStyle.ul);
+let el29= document.createElement('li');
+Object.assign(el29.style, mdStyle.li);
+let el30=document.createElement('p');
+Object.assign(el30.style, mdStyle.p);
+el30.textContent=`This is a list item with two paragraphs.`;
+el29.appendChild(el30);
+let el31=document.createElement('p');
+Object.assign(el31.style, mdStyle.p);
+const el32=document.createTextNode(`This is the second paragraph in the list item. You'reonly required to indent the first line. Lorem ipsum dolorsit amet, consectetuer adipiscing elit.`);
+el31.appendChild(el32);
+el29.appendChild(el31);
+el28.appendChild(el29);
+let el33= document.createElement('li');
+Object.assign(el33.style, mdStyle.li);
+let el34=document.createElement('p');
+Object.assign(el34.style, mdStyle.p);
+el34.textContent=`Another item in the same list.`;
+el33.appendChild(el34);
+el28.appendChild(el33);
+mdDiv.appendChild(el28);
+let el35=document.createElement('p');
+Object.assign(el35.style, mdStyle.p);
+const el36=document.createTextNode(`To put a blockquote within a list item, the blockquote's `);
+el35.appendChild(el36);
+let el37=document.createElement("code");
+const el37Span1=document.createTextNode('>');
+el37.appendChild(el37Span1);
+el35.appendChild(el37);
+const el38=document.createTextNode(`delimiters need to be indented:`);
+el35.appendChild(el38);
+mdDiv.appendChild(el35);
+let el39= document.createElement('ul');
+Object.assign(el39.style, mdStyle.ul);
+let el40= document.createElement('li');
+Object.assign(el40.style, mdStyle.li);
+let el41=document.createElement('p');
+Object.assign(el41.style, mdStyle.p);
+el41.textContent=`A list item with a blockquote:`;
+el40.appendChild(el41);
+let el42= document.createElement('blockquote');
+Object.assign(el42.style, mdStyle.block);
+let el43=document.createElement('p');
+Object.assign(el43.style, mdStyle.p);
+const el44=document.createTextNode(`This is a blockquoteinside a list item.`);
+el43.appendChild(el44);
+el42.appendChild(el43);
+el40.appendChild(el42);
+el39.appendChild(el40);
+mdDiv.appendChild(el39);
+let el45=document.createElement('p');
+Object.assign(el45.style, mdStyle.p);
+const el46=document.createTextNode(`To put a code block within a list item, the code block needsto be indented `);
+el45.appendChild(el46);
+let el47=document.createElement('em');
+el47.textContent=`twice`;
+el45.appendChild(el47);
+const el48=document.createTextNode(` -- 8 spaces or two tabs:`);
+el45.appendChild(el48);
+mdDiv.appendChild(el45);
+let el49= document.createElement('ul');
+Object.assign(el49.style, mdStyle.ul);
+let el50= document.createElement('li');
+Object.assign(el50.style, mdStyle.li);
+let el51=document.createElement('p');
+Object.assign(el51.style, mdStyle.p);
+el51.textContent=`A list item with a code block:`;
+el50.appendChild(el51);
+let el52= document.createElement('pre');
+let el53= document.createElement('code');
+const el54= document.createTextNode(`<code goes here>
+`);
+el53.appendChild(el54);
+el52.appendChild(el53);
+el50.appendChild(el52);
+el49.appendChild(el50);
+mdDiv.appendChild(el49);
+let el55=document.createElement('p');
+Object.assign(el55.style, mdStyle.p);
+el55.textContent=`end of more lists`;
+mdDiv.appendChild(el55);
+return mdDiv;
+};
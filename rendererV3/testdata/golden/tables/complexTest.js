@@ -0,0 +1,317 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h1');
+Object.assign(el2.style, mdStyle.h1);
+el2.id='h1';
+const el3Txt= `H1`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+const el5=document.createTextNode(`Lorem ipsum dolor sit amet, `);
+el4.appendChild(el5);
+let el6=document.createElement('em');
+el6.textContent=`consectetur`;
+el4.appendChild(el6);
+const el7=document.createTextNode(` adipisicing elit, sed do eiusmodtempor incididunt ut `);
+el4.appendChild(el7);
+let el8=document.createElement('strong');
+el8.textContent=`labore et dolore magna aliqua`;
+el4.appendChild(el8);
+const el9=document.createTextNode(`. Ut enim ad minim veniam,`);
+el4.appendChild(el9);
+mdDiv.appendChild(el4);
+let el10= document.createElement('blockquote');
+Object.assign(el10.style, mdStyle.block);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+el11.textContent=`quis nostrud exercitation ullamco laboris nisi ut aliquip ex ea commodo`;
+el10.appendChild(el11);
+mdDiv.appendChild(el10);
+let el12=document.createElement('p');
+Object.assign(el12.style, mdStyle.p);
+const el13=document.createTextNode(`consequat. `);
+el12.appendChild(el13);
+let el14=document.createElement('em');
+let el15=document.createElement('strong');
+el15.textContent=`Duis aute irure dolor`;
+el14.appendChild(el15);
+el12.appendChild(el14);
+const el16=document.createTextNode(` in reprehenderit in voluptate velit essecillum dolore eu fugiat nulla pariatur. ~~Excepteur sint occaecat~~ cupidatat nonproident, sunt in culpa qui officia deserunt mollit anim id est laborum.`);
+el12.appendChild(el16);
+mdDiv.appendChild(el12);
+let el17= document.createElement('h2');
+Object.assign(el17.style, mdStyle.h2);
+el17.id='h2';
+const el18Txt= `H2`;
+const el18=document.createTextNode(el18Txt);
+el17.appendChild(el18);
+mdDiv.appendChild(el17);
+let el19=document.createElement('p');
+Object.assign(el19.style, mdStyle.p);
+const el20=document.createTextNode(`Lorem ipsum dolor sit amet, `);
+el19.appendChild(el20);
+let el21=document.createElement('em');
+el21.textContent=`consectetur`;
+el19.appendChild(el21);
+const el22=document.createTextNode(` adipisicing elit, sed do eiusmodtempor incididunt ut `);
+el19.appendChild(el22);
+let el23=document.createElement('strong');
+el23.textContent=`labore et dolore magna aliqua`;
+el19.appendChild(el23);
+const el24=document.createTextNode(`. Ut enim ad minim veniam,quis nostrud exercitation ullamco laboris nisi ut aliquip ex ea commodoconsequat.`);
+el19.appendChild(el24);
+mdDiv.appendChild(el19);
+let el25=document.createElement('hr');
+mdDiv.appendChild(el25);
+let el26=document.createElement('p');
+Object.assign(el26.style, mdStyle.p);
+let el27=document.createElement('em');
+let el28=document.createElement('strong');
+el28.textContent=`Duis aute irure dolor`;
+el27.appendChild(el28);
+el26.appendChild(el27);
+const el29=document.createTextNode(` in reprehenderit in voluptate velit essecillum dolore eu fugiat nulla pariatur. ~~Excepteur sint occaecat~~ cupidatat nonproident, sunt in culpa qui officia deserunt mollit anim id est laborum.`);
+el26.appendChild(el29);
+mdDiv.appendChild(el26);
+let el30= document.createElement('h3');
+Object.assign(el30.style, mdStyle.h3);
+el30.id='h3';
+const el31Txt= `H3`;
+const el31=document.createTextNode(el31Txt);
+el30.appendChild(el31);
+mdDiv.appendChild(el30);
+let el32=document.createElement('p');
+Object.assign(el32.style, mdStyle.p);
+el32.textContent=`unordered list:`;
+mdDiv.appendChild(el32);
+let el33= document.createElement('ul');
+Object.assign(el33.style, mdStyle.ul);
+let el34= document.createElement('li');
+Object.assign(el34.style, mdStyle.li);
+el34.textContent=`item-1`;
+let el35= document.createElement('ul');
+Object.assign(el35.style, mdStyle.ul);
+let el36= document.createElement('li');
+Object.assign(el36.style, mdStyle.li);
+el36.textContent=`sub-item-1`;
+el35.appendChild(el36);
+let el37= document.createElement('li');
+Object.assign(el37.style, mdStyle.li);
+el37.textContent=`sub-item-2`;
+el35.appendChild(el37);
+el34.appendChild(el35);
+el33.appendChild(el34);
+mdDiv.appendChild(el33);
+let el38= document.createElement('ul');
+Object.assign(el38.style, mdStyle.ul);
+let el39= document.createElement('li');
+Object.assign(el39.style, mdStyle.li);
+el39.textContent=`item-2`;
+let el40= document.createElement('ul');
+Object.assign(el40.style, mdStyle.ul);
+let el41= document.createElement('li');
+Object.assign(el41.style, mdStyle.li);
+el41.textContent=`sub-item-3`;
+el40.appendChild(el41);
+let el42= document.createElement('li');
+Object.assign(el42.style, mdStyle.li);
+el42.textContent=`sub-item-4`;
+el40.appendChild(el42);
+el39.appendChild(el40);
+el38.appendChild(el39);
+mdDiv.appendChild(el38);
+let el43= document.createElement('ul');
+Object.assign(el43.style, mdStyle.ul);
+let el44= document.createElement('li');
+Object.assign(el44.style, mdStyle.li);
+el44.textContent=`item-3`;
+let el45= document.createElement('ul');
+Object.assign(el45.style, mdStyle.ul);
+let el46= document.createElement('li');
+Object.assign(el46.style, mdStyle.li);
+el46.textContent=`sub-item-5`;
+el45.appendChild(el46);
+let el47= document.createElement('li');
+Object.assign(el47.style, mdStyle.li);
+el47.textContent=`sub-item-6`;
+el45.appendChild(el47);
+el44.appendChild(el45);
+el43.appendChild(el44);
+mdDiv.appendChild(el43);
+let el48=document.createElement('p');
+Object.assign(el48.style, mdStyle.p);
+el48.textContent=`ordered list:`;
+mdDiv.appendChild(el48);
+let el49= document.createElement('ol');
+Object.assign(el49.style, mdStyle.ol);
+let el50= document.createElement('li');
+Object.assign(el50.style, mdStyle.li);
+el50.textContent=`item-1`;
+let el51= document.createElement('ol');
+Object.assign(el51.style, mdStyle.ol);
+let el52= document.createElement('li');
+Object.assign(el52.style, mdStyle.li);
+el52.textContent=`sub-item-1`;
+el51.appendChild(el52);
+let el53= document.createElement('li');
+Object.assign(el53.style, mdStyle.li);
+el53.textContent=`sub-item-2`;
+el51.appendChild(el53);
+el50.appendChild(el51);
+el49.appendChild(el50);
+let el54= document.createElement('li');
+Object.assign(el54.style, mdStyle.li);
+el54.textContent=`item-2`;
+let el55= document.createElement('ol');
+Object.assign(el55.style, mdStyle.ol);
+let el56= document.createElement('li');
+Object.assign(el56.style, mdStyle.li);
+el56.textContent=`sub-item-3`;
+el55.appendChild(el56);
+let el57= document.createElement('li');
+Object.assign(el57.style, mdStyle.li);
+el57.textContent=`sub-item-4`;
+el55.appendChild(el57);
+el54.appendChild(el55);
+el49.appendChild(el54);
+let el58= document.createElement('li');
+Object.assign(el58.style, mdStyle.li);
+el58.textContent=`item-3`;
+el49.appendChild(el58);
+mdDiv.appendChild(el49);
+let el59= document.createElement('h4');
+Object.assign(el59.style, mdStyle.h4);
+el59.id='header4';
+const el60Txt= `Header4`;
+const el60=document.createTextNode(el60Txt);
+el59.appendChild(el60);
+mdDiv.appendChild(el59);
+let el61=document.createElement('table');
+Object.assign(el61.style, mdStyle.table);
+let el62=document.createElement('thead');
+let el63=document.createElement('tr');
+let el64=document.createElement('th');
+Object.assign(el64.style, mdStyle.th);
+el64.style.textAlign='left';
+el64.textContent=`Table Header-1`;
+el63.appendChild(el64);
+let el65=document.createElement('th');
+Object.assign(el65.style, mdStyle.th);
+el65.style.textAlign='center';
+el65.textContent=`Table Header-2`;
+el63.appendChild(el65);
+let el66=document.createElement('th');
+Object.assign(el66.style, mdStyle.th);
+el66.style.textAlign='right';
+el66.textContent=`Table Header-3`;
+el63.appendChild(el66);
+el62.appendChild(el63);
+el61.appendChild(el62);
+let el67=document.createElement('tbody');
+el61.appendChild(el67);
+let el68=document.createElement('tr');
+let el69=document.createElement('td');
+Object.assign(el69.style, mdStyle.td);
+el69.style.textAlign='left';
+el69.textContent=`Table Data-1`;
+el68.appendChild(el69);
+let el70=document.createElement('td');
+Object.assign(el70.style, mdStyle.td);
+el70.style.textAlign='center';
+el70.textContent=`Table Data-2`;
+el68.appendChild(el70);
+let el71=document.createElement('td');
+Object.assign(el71.style, mdStyle.td);
+el71.style.textAlign='right';
+el71.textContent=`Table Data-3`;
+el68.appendChild(el71);
+el67.appendChild(el68);
+let el72=document.createElement('tr');
+let el73=document.createElement('td');
+Object.assign(el73.style, mdStyle.td);
+el73.style.textAlign='left';
+el73.textContent=`TD-4`;
+el72.appendChild(el73);
+let el74=document.createElement('td');
+Object.assign(el74.style, mdStyle.td);
+el74.style.textAlign='center';
+el74.textContent=`Td-5`;
+el72.appendChild(el74);
+let el75=document.createElement('td');
+Object.assign(el75.style, mdStyle.td);
+el75.style.textAlign='right';
+el75.textContent=`TD-6`;
+el72.appendChild(el75);
+el67.appendChild(el72);
+let el76=document.createElement('tr');
+let el77=document.createElement('td');
+Object.assign(el77.style, mdStyle.td);
+el77.style.textAlign='left';
+el77.textContent=`Table Data-7`;
+el76.appendChild(el77);
+let el78=document.createElement('td');
+Object.assign(el78.style, mdStyle.td);
+el78.style.textAlign='center';
+el78.textContent=`Table Data-8`;
+el76.appendChild(el78);
+let el79=document.createElement('td');
+Object.assign(el79.style, mdStyle.td);
+el79.style.textAlign='right';
+el79.textContent=`Table Data-9`;
+el76.appendChild(el79);
+el67.appendChild(el76);
+mdDiv.appendChild(el61);
+let el80= document.createElement('h5');
+Object.assign(el80.style, mdStyle.h5);
+el80.id='header5';
+const el81Txt= `Header5`;
+const el81=document.createTextNode(el81Txt);
+el80.appendChild(el81);
+mdDiv.appendChild(el80);
+let el82=document.createElement('p');
+Object.assign(el82.style, mdStyle.p);
+const el83=document.createTextNode(`You may also want some images right in here like `);
+el82.appendChild(el83);
+let el84=document.createElement('img');
+el84.src='https://cloud.githubusercontent.com/assets/5456665/13322882/e74f6626-dc00-11e5-921d-f6d024a01eaa.png';
+el84.alt='GitHub Logo';
+el84.title='GitHub';
+el82.appendChild(el84);
+const el85=document.createTextNode(` - you can do that but I would recommend you to use the component "image" and simply split your text.`);
+el82.appendChild(el85);
+mdDiv.appendChild(el82);
+let el86= document.createElement('h6');
+Object.assign(el86.style, mdStyle.h6);
+el86.id='header6';
+const el87Txt= `Header6`;
+const el87=document.createTextNode(el87Txt);
+el86.appendChild(el87);
+mdDiv.appendChild(el86);
+let el88=document.createElement('p');
+Object.assign(el88.style, mdStyle.p);
+const el89=document.createTextNode(`Let us do some links - this for example: https://github.com/MinhasKamal/github-markdown-syntax is `);
+el88.appendChild(el89);
+let el90=document.createElement('strong');
+el90.textContent=`NOT`;
+el88.appendChild(el90);
+const el91=document.createTextNode(` a link but this: is `);
+el88.appendChild(el91);
+let el92=document.createElement("a");
+el92.href='https://github.com/MinhasKamal/github-markdown-syntax';
+Object.assign(el92.style, mdStyle.a);
+el92.textContent=`GitHub`;
+el88.appendChild(el92);
+mdDiv.appendChild(el88);
+return mdDiv;
+};
@@ -0,0 +1,306 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h1');
+Object.assign(el2.style, mdStyle.h1);
+el2.id='markdown-syntax';
+const el3Txt= `Markdown: Syntax`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4= document.createElement('h2');
+Object.assign(el4.style, mdStyle.h2);
+el4.id='lists';
+const el5Txt= `Lists`;
+const el5=document.createTextNode(el5Txt);
+el4.appendChild(el5);
+mdDiv.appendChild(el4);
+let el6=document.createElement('p');
+Object.assign(el6.style, mdStyle.p);
+el6.textContent=`Markdown supports ordered (numbered) and unordered (bulleted) lists.`;
+mdDiv.appendChild(el6);
+let el7=document.createElement('p');
+Object.assign(el7.style, mdStyle.p);
+const el8=document.createTextNode(`Unordered lists use asterisks, pluses, and hyphens -- interchangably-- as list markers:`);
+el7.appendChild(el8);
+mdDiv.appendChild(el7);
+let el9= document.createElement('ul');
+Object.assign(el9.style, mdStyle.ul);
+let el10= document.createElement('li');
+Object.assign(el10.style, mdStyle.li);
+el10.textContent=`Red`;
+el9.appendChild(el10);
+let el11= document.createElement('li');
+Object.assign(el11.style, mdStyle.li);
+el11.textContent=`Green`;
+el9.appendChild(el11);
+let el12= document.createElement('li');
+Object.assign(el12.style, mdStyle.li);
+el12.textContent=`Blue`;
+el9.appendChild(el12);
+mdDiv.appendChild(el9);
+let el13=document.createElement('p');
+Object.assign(el13.style, mdStyle.p);
+el13.textContent=`is equivalent to:`;
+mdDiv.appendChild(el13);
+let el14= document.createElement('ul');
+Object.assign(el14.style, mdStyle.ul);
+let el15= document.createElement('li');
+Object.assign(el15.style, mdStyle.li);
+el15.textContent=`Red`;
+el14.appendChild(el15);
+let el16= document.createElement('li');
+Object.assign(el16.style, mdStyle.li);
+el16.textContent=`Green`;
+el14.appendChild(el16);
+let el17= document.createElement('li');
+Object.assign(el17.style, mdStyle.li);
+el17.textContent=`Blue`;
+el14.appendChild(el17);
+mdDiv.appendChild(el14);
+let el18=document.createElement('p');
+Object.assign(el18.style, mdStyle.p);
+el18.textContent=`and:`;
+mdDiv.appendChild(el18);
+let el19= document.createElement('ul');
+Object.assign(el19.style, mdStyle.ul);
+let el20= document.createElement('li');
+Object.assign(el20.style, mdStyle.li);
+el20.textContent=`Red`;
+el19.appendChild(el20);
+let el21= document.createElement('li');
+Object.assign(el21.style, mdStyle.li);
+el21.textContent=`Green`;
+el19.appendChild(el21);
+let el22= document.createElement('li');
+Object.assign(el22.style, mdStyle.li);
+el22.textContent=`Blue`;
+el19.appendChild(el22);
+mdDiv.appendChild(el19);
+let el23=document.createElement('p');
+Object.assign(el23.style, mdStyle.p);
+el23.textContent=`Ordered lists use numbers followed by periods:`;
+mdDiv.appendChild(el23);
+let el24= document.createElement('ol');
+Object.assign(el24.style, mdStyle.ol);
+let el25= document.createElement('li');
+Object.assign(el25.style, mdStyle.li);
+el25.textContent=`Bird`;
+el24.appendChild(el25);
+let el26= document.createElement('li');
+Object.assign(el26.style, mdStyle.li);
+el26.textContent=`McHale`;
+el24.appendChild(el26);
+let el27= document.createElement('li');
+Object.assign(el27.style, mdStyle.li);
+el27.textContent=`Parish`;
+el24.appendChild(el27);
+mdDiv.appendChild(el24);
+let el28=document.createElement('p');
+Object.assign(el28.style, mdStyle.p);
+const el29=document.createTextNode(`It's important to note that the actual numbers you use to mark thelist have no effect on the HTML output Markdown produces. The HTMLMarkdown produces from the above list is:`);
+el28.appendChild(el29);
+mdDiv.appendChild(el28);
+let el30=document.createElement('p');
+Object.assign(el30.style, mdStyle.p);
+el30.textContent=`If you instead wrote the list in Markdown like this:`;
+mdDiv.appendChild(el30);
+let el31= document.createElement('ol');
+Object.assign(el31.style, mdStyle.ol);
+let el32= document.createElement('li');
+Object.assign(el32.style, mdStyle.li);
+el32.textContent=`Bird`;
+el31.appendChild(el32);
+let el33= document.createElement('li');
+Object.assign(el33.style, mdStyle.li);
+el33.textContent=`McHale`;
+el31.appendChild(el33);
+let el34= document.createElement('li');
+Object.assign(el34.style, mdStyle.li);
+el34.textContent=`Parish`;
+el31.appendChild(el34);
+mdDiv.appendChild(el31);
+let el35=document.createElement('p');
+Object.assign(el35.style, mdStyle.p);
+el35.textContent=`or even:`;
+mdDiv.appendChild(el35);
+let el36= document.createElement('ol');
+el36.start='3';
+Object.assign(el36.style, mdStyle.ol);
+let el37= document.createElement('li');
+Object.assign(el37.style, mdStyle.li);
+el37.textContent=`Bird`;
+el36.appendChild(el37);
+let el38= document.createElement('li');
+Object.assign(el38.style, mdStyle.li);
+el38.textContent=`McHale`;
+el36.appendChild(el38);
+let el39= document.createElement('li');
+Object.assign(el39.style, mdStyle.li);
+el39.textContent=`Parish`;
+el36.appendChild(el39);
+mdDiv.appendChild(el36);
+let el40=document.createElement('p');
+Object.assign(el40.style, mdStyle.p);
+const el41=document.createTextNode(`you'd get the exact same HTML output. The point is, if you want to,you can use ordinal numbers in your ordered Markdown lists, so thatthe numbers in your source match the numbers in your published HTML.But if you want to be lazy, you don't have to.`);
+el40.appendChild(el41);
+mdDiv.appendChild(el40);
+let el42=document.createElement('p');
+Object.assign(el42.style, mdStyle.p);
+el42.textContent=`To make lists look nice, you can wrap items with hanging indents:`;
+mdDiv.appendChild(el42);
+let el43= document.createElement('ul');
+Object.assign(el43.style, mdStyle.ul);
+let el44= document.createElement('li');
+Object.assign(el44.style, mdStyle.li);
+const el45=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el44.appendChild(el45);
+el43.appendChild(el44);
+let el46= document.createElement('li');
+Object.assign(el46.style, mdStyle.li);
+const el47=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el46.appendChild(el47);
+el43.appendChild(el46);
+mdDiv.appendChild(el43);
+let el48=document.createElement('p');
+Object.assign(el48.style, mdStyle.p);
+el48.textContent=`But if you want to be lazy, you don't have to:`;
+mdDiv.appendChild(el48);
+let el49= document.createElement('ul');
+Object.assign(el49.style, mdStyle.ul);
+let el50= document.createElement('li');
+Object.assign(el50.style, mdStyle.li);
+const el51=document.createTextNode(`Lorem ipsum dolor sit amet, consectetuer adipiscing elit.Aliquam hendrerit mi posuere lectus. Vestibulum enim wisi,viverra nec, fringilla in, laoreet vitae, risus.`);
+el50.appendChild(el51);
+el49.appendChild(el50);
+let el52= document.createElement('li');
+Object.assign(el52.style, mdStyle.li);
+const el53=document.createTextNode(`Donec sit amet nisl. Aliquam semper ipsum sit amet velit.Suspendisse id sem consectetuer libero luctus adipiscing.`);
+el52.appendChild(el53);
+el49.appendChild(el52);
+mdDiv.appendChild(el49);
+let el54=document.createElement('p');
+Object.assign(el54.style, mdStyle.p);
+const el55=document.createTextNode(`List items may consist of multiple paragraphs. Each subsequentparagraph in a list item must be indented by either 4 spacesor one tab:`);
+el54.appendChild(el55);
+mdDiv.appendChild(el54);
+let el56= document.createElement('ol');
+Object.assign(el56.style, mdStyle.ol);
+let el57= document.createElement('li');
+Object.assign(el57.style, mdStyle.li);
+let el58=document.createElement('p');
+Object.assign(el58.style, mdStyle.p);
+const el59=document.createTextNode(`This is a list item with two paragraphs. Lorem ipsum dolorsit amet, consectetuer adipiscing elit. Aliquam hendreritmi posuere lectus.`);
+el58.appendChild(el59);
+el57.appendChild(el58);
+let el60=document.createElement('p');
+Object.assign(el60.style, mdStyle.p);
+const el61=document.createTextNode(`Vestibulum enim wisi, viverra nec, fringilla in, laoreetvitae, risus. Donec sit amet nisl. Aliquam semper ipsumsit amet velit.`);
+el60.appendChild(el61);
+el57.appendChild(el60);
+el56.appendChild(el57);
+let el62= document.createElement('li');
+Object.assign(el62.style, mdStyle.li);
+let el63=document.createElement('p');
+Object.assign(el63.style, mdStyle.p);
+el63.textContent=`Suspendisse id sem consectetuer libero luctus adipiscing.`;
+el62.appendChild(el63);
+el56.appendChild(el62);
+mdDiv.appendChild(el56);
+let el64=document.createElement('p');
+Object.assign(el64.style, mdStyle.p);
+const el65=document.createTextNode(`It looks nice if you indent every line of the subsequentparagraphs, but here again, Markdown will allow you to belazy:`);
+el64.appendChild(el65);
+mdDiv.appendChild(el64);
+let el66= document.createElement('ul');
+Object.assign(el66.style, mdStyle.ul);
+let el67= document.createElement('li');
+Object.assign(el67.style, mdStyle.li);
+let el68=document.createElement('p');
+Object.assign(el68.style, mdStyle.p);
+el68.textContent=`This is a list item with two paragraphs.`;
+el67.appendChild(el68);
+let el69=document.createElement('p');
+Object.assign(el69.style, mdStyle.p);
+const el70=document.createTextNode(`This is the second paragraph in the list item. You'reonly required to indent the first line. Lorem ipsum dolorsit amet, consectetuer adipiscing elit.`);
+el69.appendChild(el70);
+el67.appendChild(el69);
+el66.appendChild(el67);
+let el71= document.createElement('li');
+Object.assign(el71.style, mdStyle.li);
+let el72=document.createElement('p');
+Object.assign(el72.style, mdStyle.p);
+el72.textContent=`Another item in the same list.`;
+el71.appendChild(el72);
+el66.appendChild(el71);
+mdDiv.appendChild(el66);
+let el73=document.createElement('p');
+Object.assign(el73.style, mdStyle.p);
+const el74=document.createTextNode(`To put a blockquote within a list item, the blockquote's `);
+el73.appendChild(el74);
+let el75=document.createElement("code");
+const el75Span1=document.createTextNode('>');
+el75.appendChild(el75Span1);
+el73.appendChild(el75);
+const el76=document.createTextNode(`delimiters need to be indented:`);
+el73.appendChild(el76);
+mdDiv.appendChild(el73);
+let el77= document.createElement('ul');
+Object.assign(el77.style, mdStyle.ul);
+let el78= document.createElement('li');
+Object.assign(el78.style, mdStyle.li);
+let el79=document.createElement('p');
+Object.assign(el79.style, mdStyle.p);
+el79.textContent=`A list item with a blockquote:`;
+el78.appendChild(el79);
+let el80= document.createElement('blockquote');
+Object.assign(el80.style, mdStyle.block);
+let el81=document.createElement('p');
+Object.assign(el81.style, mdStyle.p);
+const el82=document.createTextNode(`This is a blockquoteinside a list item.`);
+el81.appendChild(el82);
+el80.appendChild(el81);
+el78.appendChild(el80);
+el77.appendChild(el78);
+mdDiv.appendChild(el77);
+let el83=document.createElement('p');
+Object.assign(el83.style, mdStyle.p);
+const el84=document.createTextNode(`To put a code block within a list item, the code block needsto be indented `);
+el83.appendChild(el84);
+let el85=document.createElement('em');
+el85.textContent=`twice`;
+el83.appendChild(el85);
+const el86=document.createTextNode(` -- 8 spaces or two tabs:`);
+el83.appendChild(el86);
+mdDiv.appendChild(el83);
+let el87= document.createElement('ul');
+Object.assign(el87.style, mdStyle.ul);
+let el88= document.createElement('li');
+Object.assign(el88.style, mdStyle.li);
+let el89=document.createElement('p');
+Object.assign(el89.style, mdStyle.p);
+el89.textContent=`A list item with a code block:`;
+el88.appendChild(el89);
+let el90= document.createElement('pre');
+let el91= document.createElement('code');
+const el92= document.createTextNode(`<code goes here>
+`);
+el91.appendChild(el92);
+el90.appendChild(el91);
+el88.appendChild(el90);
+el87.appendChild(el88);
+mdDiv.appendChild(el87);
+let el93=document.createElement('p');
+Object.assign(el93.style, mdStyle.p);
+el93.textContent=`End of List Test`;
+mdDiv.appendChild(el93);
+return mdDiv;
+};
@@ -0,0 +1,88 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h2');
+Object.assign(el2.style, mdStyle.h2);
+el2.id='ordered-lists';
+const el3Txt= `Ordered Lists`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4=document.createElement('p');
+Object.assign(el4.style, mdStyle.p);
+el4.textContent=`Ordered lists use numbers followed by periods:`;
+mdDiv.appendChild(el4);
+let el5= document.createElement('ol');
+Object.assign(el5.style, mdStyle.ol);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+el6.textContent=`Bird`;
+el5.appendChild(el6);
+let el7= document.createElement('li');
+Object.assign(el7.style, mdStyle.li);
+el7.textContent=`McHale`;
+el5.appendChild(el7);
+let el8= document.createElement('li');
+Object.assign(el8.style, mdStyle.li);
+el8.textContent=`Parish`;
+el5.appendChild(el8);
+mdDiv.appendChild(el5);
+let el9=document.createElement('p');
+Object.assign(el9.style, mdStyle.p);
+const el10=document.createTextNode(`It's important to note that the actual numbers you use to mark thelist have no effect on the HTML output Markdown produces. The HTMLMarkdown produces from the above list is:`);
+el9.appendChild(el10);
+mdDiv.appendChild(el9);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+el11.textContent=`If you instead wrote the list in Markdown like this:`;
+mdDiv.appendChild(el11);
+let el12= document.createElement('ol');
+Object.assign(el12.style, mdStyle.ol);
+let el13= document.createElement('li');
+Object.assign(el13.style, mdStyle.li);
+el13.textContent=`Bird`;
+el12.appendChild(el13);
+let el14= document.createElement('li');
+Object.assign(el14.style, mdStyle.li);
+el14.textContent=`McHale`;
+el12.appendChild(el14);
+let el15= document.createElement('li');
+Object.assign(el15.style, mdStyle.li);
+el15.textContent=`Parish`;
+el12.appendChild(el15);
+mdDiv.appendChild(el12);
+let el16=document.createElement('p');
+Object.assign(el16.style, mdStyle.p);
+el16.textContent=`or even:`;
+mdDiv.appendChild(el16);
+let el17= document.createElement('ol');
+el17.start='3';
+Object.assign(el17.style, mdStyle.ol);
+let el18= document.createElement('li');
+Object.assign(el18.style, mdStyle.li);
+el18.textContent=`Bird`;
+el17.appendChild(el18);
+let el19= document.createElement('li');
+Object.assign(el19.style, mdStyle.li);
+el19.textContent=`McHale`;
+el17.appendChild(el19);
+let el20= document.createElement('li');
+Object.assign(el20.style, mdStyle.li);
+el20.textContent=`Parish`;
+el17.appendChild(el20);
+mdDiv.appendChild(el17);
+let el21=document.createElement('p');
+Object.assign(el21.style, mdStyle.p);
+const el22=document.createTextNode(`you'd get the exact same HTML output. The point is, if you want to,you can use ordinal numbers in your ordered Markdown lists, so thatthe numbers in your source match the numbers in your published HTML.But if you want to be lazy, you don't have to.`);
+el21.appendChild(el22);
+mdDiv.appendChild(el21);
+return mdDiv;
+};
@@ -0,0 +1,76 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2= document.createElement('h2');
+Object.assign(el2.style, mdStyle.h2);
+el2.id='block-elements';
+const el3Txt= `Block Elements`;
+const el3=document.createTextNode(el3Txt);
+el2.appendChild(el3);
+mdDiv.appendChild(el2);
+let el4= document.createElement('h3');
+Object.assign(el4.style, mdStyle.h3);
+el4.id='paragraphs-and-line-breaks';
+const el5Txt= `Paragraphs and Line Breaks`;
+const el5=document.createTextNode(el5Txt);
+el4.appendChild(el5);
+mdDiv.appendChild(el4);
+let el6=document.createElement('p');
+Object.assign(el6.style, mdStyle.p);
+const el7=document.createTextNode(`A paragraph is simply one or more consecutive lines of text, separatedby one or more blank lines. (A blank line is any line that looks like ablank line -- a line containing nothing but spaces or tabs is consideredblank.) Normal paragraphs should not be indented with spaces or tabs.`);
+el6.appendChild(el7);
+mdDiv.appendChild(el6);
+let el8=document.createElement('p');
+Object.assign(el8.style, mdStyle.p);
+const el9=document.createTextNode(`The implication of the "one or more consecutive lines of text" rule isthat Markdown supports "hard-wrapped" text paragraphs. This differssignificantly from most other text-to-HTML formatters (including MovableType's "Convert Line Breaks" option) which translate every line breakcharacter in a paragraph into a `);
+el8.appendChild(el9);
+let el10=document.createElement("code");
+const el10Span1=document.createTextNode('<br />');
+el10.appendChild(el10Span1);
+el8.appendChild(el10);
+const el11=document.createTextNode(` tag.`);
+el8.appendChild(el11);
+mdDiv.appendChild(el8);
+let el12=document.createElement('p');
+Object.assign(el12.style, mdStyle.p);
+const el13=document.createTextNode(`When you `);
+el12.appendChild(el13);
+let el14=document.createElement('em');
+el14.textContent=`do`;
+el12.appendChild(el14);
+const el15=document.createTextNode(` want to insert a `);
+el12.appendChild(el15);
+let el16=document.createElement("code");
+const el16Span1=document.createTextNode('<br />');
+el16.appendChild(el16Span1);
+el12.appendChild(el16);
+const el17=document.createTextNode(` break tag using Markdown, youend a line with two or more spaces, then type return.`);
+el12.appendChild(el17);
+mdDiv.appendChild(el12);
+let el18= document.createElement('h3');
+Object.assign(el18.style, mdStyle.h3);
+el18.id='headers';
+const el19Txt= `Headers`;
+const el19=document.createTextNode(el19Txt);
+el18.appendChild(el19);
+mdDiv.appendChild(el18);
+let el20=document.createElement('p');
+Object.assign(el20.style, mdStyle.p);
+const el21=document.createTextNode(`Markdown supports two styles of headers, [Setext] [1] and [atx] [2].`);
+el20.appendChild(el21);
+mdDiv.appendChild(el20);
+let el22=document.createElement('p');
+Object.assign(el22.style, mdStyle.p);
+const el23=document.createTextNode(`Optionally, you may "close" atx-style headers. This is purelycosmetic -- you can use this if you think it looks better. Theclosing hashes don't even need to match the number of hashesused to open the header. (The number of opening hashesdetermines the header level.)`);
+el22.appendChild(el23);
+mdDiv.appendChild(el22);
+return mdDiv;
+};
@@ -0,0 +1,44 @@
+let mdDivObj = {
+	typ:'div',
+	id: 'mdDiv',
+	style: {
+		margin: '10px',
+		border: '1px dashed blue',
+		position: 'relative',
+		minHeight: '200px',
+	},
+};
+let mdDiv = azul.addElement(mdDivObj);
+let el2=document.createElement('hr');
+mdDiv.appendChild(el2);
+let el3=document.createElement('p');
+Object.assign(el3.style, mdStyle.p);
+const el4=document.createTextNode(`author: prrdate: 20 Nov 2024layout: posttitle: I Love Markdowntags:`);
+el3.appendChild(el4);
+mdDiv.appendChild(el3);
+let el5= document.createElement('ul');
+Object.assign(el5.style, mdStyle.ul);
+let el6= document.createElement('li');
+Object.assign(el6.style, mdStyle.li);
+el6.textContent=`test`;
+el5.appendChild(el6);
+let el7= document.createElement('li');
+Object.assign(el7.style, mdStyle.li);
+el7.textContent=`example`;
+el5.appendChild(el7);
+mdDiv.appendChild(el5);
+let el8=document.createElement('hr');
+mdDiv.appendChild(el8);
+let el9= document.createElement('h1');
+Object.assign(el9.style, mdStyle.h1);
+el9.id='yaml-test-document';
+const el10Txt= `Yaml Test Document`;
+const el10=document.createTextNode(el10Txt);
+el9.appendChild(el10);
+mdDiv.appendChild(el9);
+let el11=document.createElement('p');
+Object.assign(el11.style, mdStyle.p);
+el11.textContent=`This is a a test document to check a yaml section for meta data of this document.`;
+mdDiv.appendChild(el11);
+return mdDiv;
+};
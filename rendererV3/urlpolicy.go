@@ -0,0 +1,157 @@
+package md2jsV2
+
+// url policy: the schemes the urls of links and images and of the url attributes (srcset,
+// ping) may have, as blocklist or as allowlist. The default policy removes javascript, vbscript, file and data urls except
+// the data urls of png, gif, jpeg, webp and svg images.
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+)
+
+// A URLRule decides by its scheme whether an url is kept. An entry is a scheme (mailto)
+// or a data url media type (data:image/png). The longest matching entry decides, a
+// blocked entry before an allowed one of the same length. An url that no entry matches
+// is removed only by an allowlist. Relative urls are always kept.
+type URLRule struct {
+	// AllowList removes the urls that no allowed entry matches.
+	AllowList bool
+	Allow     []string
+	Block     []string
+}
+
+// A URLPolicy has the rules of the urls of links and autolinks and of images.
+type URLPolicy struct {
+	Links  URLRule
+	Images URLRule
+}
+
+// DefaultURLPolicy returns the policy of IsDangerousURL for links and images.
+func DefaultURLPolicy() URLPolicy {
+	rule := URLRule{
+		Allow: []string{"data:image/png", "data:image/gif", "data:image/jpeg", "data:image/webp", "data:image/svg+xml"},
+		Block: []string{"javascript", "vbscript", "file", "data"},
+	}
+	return URLPolicy{Links: rule, Images: rule}
+}
+
+var defaultURLPolicy = DefaultURLPolicy()
+
+// URLPolicy is an option name used in WithURLPolicy.
+const optURLPolicy renderer.OptionName = "URLPolicy"
+
+type withURLPolicy struct {
+	policy URLPolicy
+}
+
+func (o *withURLPolicy) SetConfig(c *renderer.Config) {
+	c.Options[optURLPolicy] = o.policy
+}
+
+func (o *withURLPolicy) SetHTMLOption(c *Config) {
+	c.URLPolicy = &o.policy
+}
+
+// WithURLPolicy is a functional option that replaces the default url policy.
+// WithUnsafe keeps all urls.
+func WithURLPolicy(p URLPolicy) interface {
+	renderer.Option
+	Option
+} {
+	return &withURLPolicy{p}
+}
+
+// Allowed reports whether the rule keeps an url.
+func (rule *URLRule) Allowed(url []byte) bool {
+	scheme, rest := urlScheme(url)
+	if len(scheme) == 0 {return true}
+	allow, n := !rule.AllowList, 0
+	for _, e := range rule.Allow {
+		if m := matchScheme(e, scheme, rest); m > n {allow, n = true, m}
+	}
+	for _, e := range rule.Block {
+		if m := matchScheme(e, scheme, rest); m > 0 && m >= n {allow, n = false, m}
+	}
+	return allow
+}
+
+// ValidSchemeEntry reports whether an entry of a rule is a scheme or a data url media type.
+func ValidSchemeEntry(entry string) bool {
+	scheme, media, typed := strings.Cut(entry, ":")
+	if typed && (!strings.EqualFold(scheme, "data") || len(media) == 0 || strings.ContainsAny(media, " ;,")) {return false}
+	if len(scheme) == 0 || !isAlpha(scheme[0]) {return false}
+	for i := 1; i < len(scheme); i++ {
+		if !isSchemeChar(scheme[i]) {return false}
+	}
+	return true
+}
+
+// urlScheme returns the lower case scheme of an url and the rest after the colon. Like
+// browsers it skips leading spaces and control characters and tabs and line breaks in the
+// scheme. The scheme is empty for a relative url.
+func urlScheme(url []byte) (scheme string, rest []byte) {
+	var buf []byte
+	i := 0
+	for i < len(url) && url[i] <= ' ' {i++}
+	for ; i < len(url); i++ {
+		c := url[i]
+		switch {
+		case c == '\t' || c == '\n' || c == '\r':
+			continue
+		case c == ':':
+			if len(buf) == 0 {return "", nil}
+			return strings.ToLower(string(buf)), url[i+1:]
+		case isAlpha(c) || len(buf) > 0 && isSchemeChar(c):
+			buf = append(buf, c)
+		default:
+			return "", nil
+		}
+	}
+	return "", nil
+}
+
+// matchScheme returns the length of an entry that matches the scheme, 0 if it does not match.
+func matchScheme(entry, scheme string, rest []byte) int {
+	es, media, typed := strings.Cut(entry, ":")
+	if !strings.EqualFold(es, scheme) {return 0}
+	if !typed {return len(entry)}
+	if len(rest) < len(media) || !bytes.EqualFold(rest[:len(media)], []byte(media)) {return 0}
+	if len(rest) > len(media) && rest[len(media)] != ';' && rest[len(media)] != ',' {return 0}
+	return len(entry)
+}
+
+func isAlpha(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isSchemeChar(c byte) bool {
+	return isAlpha(c) || c >= '0' && c <= '9' || c == '+' || c == '-' || c == '.'
+}
+
+// keepURL reports whether the url of a link, autolink or image passes the url policy.
+// A removed url is recorded as diagnostic.
+func (r *Renderer) keepURL(node ast.Node, url []byte) bool {
+	return allowURL(node, url, r.Unsafe, r.URLPolicy)
+}
+
+// keepAttrURL reports whether an url of an attribute (srcset, ping) passes the url policy
+// of the document that contains node.
+func keepAttrURL(node ast.Node, url []byte) bool {
+	rc := docContext(node)
+	return allowURL(node, url, rc.unsafe, rc.policy)
+}
+
+// allowURL applies the image rule of policy to the urls of images and the link rule to
+// the others; nil is the default policy.
+func allowURL(node ast.Node, url []byte, unsafe bool, policy *URLPolicy) bool {
+	if unsafe {return true}
+	if policy == nil {policy = &defaultURLPolicy}
+	rule := &policy.Links
+	if node.Kind() == ast.KindImage {rule = &policy.Images}
+	if rule.Allowed(url) {return true}
+	addDiag(node, SevWarning, "url removed by the url policy: %.40q", url)
+	return false
+}
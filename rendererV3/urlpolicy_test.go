@@ -0,0 +1,116 @@
+// urlpolicy_test.go
+// tests of the url policy: the default rules, blocklists and allowlists with data url
+// media types, the urls of srcset and ping attributes and the diagnostics of removed urls
+//
+// author: prr, azul software
+// date: 18 Oct 2026
+// copyright prr, azul software
+//
+
+package md2jsV2_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	attributes "goDemo/goldmark/samples/extBlockAttr"
+	md2js "goDemo/goldmark/samples/rendererV3"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestURLRules(t *testing.T) {
+
+	def := md2js.DefaultURLPolicy().Links
+	intranet := md2js.URLRule{Block: []string{"javascript", "vbscript", "file", "data"}}
+	public := md2js.URLRule{AllowList: true, Allow: []string{"https", "mailto", "data:image/png"}}
+
+	tests := []struct {
+		rule *md2js.URLRule
+		url  string
+		want bool
+	}{
+		{&def, "https://example.com/", true},
+		{&def, "Lists.md#ordered", true},
+		{&def, "javascript:alert(1)", false},
+		{&def, " JavaScript:alert(1)", false},
+		{&def, "java\tscript:alert(1)", false},
+		{&def, "data:image/png;base64,AAAA", true},
+		{&def, "DATA:IMAGE/SVG+XML;base64,AAAA", true},
+		{&def, "data:image/pngx;base64,AAAA", false},
+		{&def, "data:text/html,<b>", false},
+		{&def, "ssh:host", true},
+		{&intranet, "ssh:host", true},
+		{&intranet, "data:image/png;base64,AAAA", false},
+		{&public, "https://example.com/", true},
+		{&public, "http://example.com/", false},
+		{&public, "tel:+41", false},
+		{&public, "data:image/png,AAAA", true},
+		{&public, "data:image/gif,AAAA", false},
+		{&public, "/about#team", true},
+		{&public, "a/b:c", true},
+	}
+	for _, test := range tests {
+		if got := test.rule.Allowed([]byte(test.url)); got != test.want {t.Errorf("%q: %t, want %t", test.url, got, test.want)}
+	}
+
+	for _, e := range []string{"mailto", "x-app+v1.2", "data:image/png"} {
+		if !md2js.ValidSchemeEntry(e) {t.Errorf("%q not valid", e)}
+	}
+	for _, e := range []string{"", "1x", "mail to", "http:x", "data:", "data:a;b"} {
+		if md2js.ValidSchemeEntry(e) {t.Errorf("%q valid", e)}
+	}
+}
+
+func TestURLPolicy(t *testing.T) {
+
+	policy := md2js.URLPolicy{
+		Links:  md2js.URLRule{AllowList: true, Allow: []string{"https", "mailto", "ssh"}},
+		Images: md2js.URLRule{AllowList: true, Allow: []string{"https"}},
+	}
+	source := []byte("[a](ssh:host) [b](http://x.y) <a@b.c> <tel:+41>\n\n![c](https://x.y/c.png) ![d](data:image/png;base64,AA)\n")
+
+	md := goldmark.New()
+	md.SetRenderer(md2js.GetRenderer("urls", false, md2js.WithURLPolicy(policy)))
+	doc := md.Parser().Parse(text.NewReader(source))
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {t.Fatal(err)}
+
+	for _, want := range []string{".href='ssh:host'", ".href='mailto:a@b.c'", ".src='https://x.y/c.png'"} {
+		if !strings.Contains(buf.String(), want) {t.Errorf("%s missing:\n%s", want, buf.String())}
+	}
+	want := []string{
+		`warning: line 1:16: Link: url removed by the url policy: "http://x.y"`,
+		`warning: line 1:39: AutoLink: url removed by the url policy: "tel:+41"`,
+		`warning: line 3:27: Image: url removed by the url policy: "data:image/png;base64,AA"`,
+	}
+	var got []string
+	for _, d := range md2js.Diagnostics(doc) {got = append(got, d.String())}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diagnostics:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// the urls of srcset and ping attributes pass the url policy like the destinations
+func TestURLAttributes(t *testing.T) {
+
+	source := []byte("![e](e.png){srcset=\"https://x.y/e.png 1x, javascript:alert(1) 2x, e@3x.png 3x\"}\n\n" +
+		"[p](https://x.y){ping=\"https://t.y/p javascript:x\"} [q](q){ping='vbscript:y'}\n")
+
+	md := goldmark.New(goldmark.WithExtensions(attributes.Extension))
+	md.SetRenderer(md2js.GetRenderer("urls", false))
+	doc := md.Parser().Parse(text.NewReader(source))
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {t.Fatal(err)}
+
+	js := buf.String()
+	for _, want := range []string{"'https://x.y/e.png 1x, e@3x.png 3x'", "'https://t.y/p'"} {
+		if !strings.Contains(js, want) {t.Errorf("%s missing:\n%s", want, js)}
+	}
+	if strings.Contains(js, "script:") || strings.Count(js, "'ping'") != 1 {t.Errorf("urls not removed:\n%s", js)}
+	var got []string
+	for _, d := range md2js.Diagnostics(doc) {got = append(got, d.String())}
+	if len(got) != 3 {t.Errorf("diagnostics:\n%s", strings.Join(got, "\n"))}
+}
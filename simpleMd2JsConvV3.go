@@ -9,6 +9,8 @@
 //
 // v3: aggregate child nodes of paragraphs
 //	   create funcs for element and style creation
+// v4: thin wrapper around md2jsLib.ConvertFile
+//     directories, theme and options from the md2js.yaml project config
 
 package main
 
@@ -16,18 +18,16 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"bytes"
+	"path/filepath"
+	"context"
 
-	md2js "goDemo/goldmark/samples/rendererV3"
+	"goDemo/goldmark/samples/md2jsLib"
 
-	"github.com/yuin/goldmark"
 	util "github.com/prr123/utility/utilLib"
 )
 
 func main() {
 
-	var buf bytes.Buffer
-
 	numarg := len(os.Args)
     flags:=[]string{"dbg", "in", "out", "style", "site"}
 
@@ -36,7 +36,7 @@ func main() {
 
     if numarg > len(flags) +1 {
         fmt.Println("too many arguments in cl!")
-        fmt.Println("usage: %s %s\n", os.Args[0], useStr)
+        fmt.Printf("usage: %s %s\n", os.Args[0], useStr)
         os.Exit(-1)
     }
 
@@ -71,89 +71,55 @@ func main() {
         outFil = outval.(string)
     }
 
-    stylFil := "mdStyle"
+    stylFil := ""
     stylval, ok := flagMap["style"]
     if ok {
         if stylval.(string) == "none" {log.Fatalf("error -- no style file name provided!\n")}
         stylFil = stylval.(string)
     }
 
-    siteFil := "mdSite"
+    siteFil := ""
     siteval, ok := flagMap["site"]
     if ok {
         if siteval.(string) == "none" {log.Fatalf("error -- no site file name provided!\n")}
         siteFil = siteval.(string)
     }
 
-	inFilnam := "md/" + inFil + ".md"
-	metaFilnam := "md/" + inFil + ".meta"
-	outFilnam := "script/" + outFil + ".js"
-	stylFilnam := "style/" + stylFil + ".js"
-	siteFilnam := "site/" + siteFil + ".js"
-
-	if dbg {
-		fmt.Printf("input:  %s\n", inFilnam)
-		fmt.Printf("output: %s\n", outFilnam)
-		fmt.Printf("style:  %s\n", stylFilnam)
-		fmt.Printf("site:   %s\n", siteFilnam)
-		fmt.Printf("meta:   %s\n", metaFilnam)
-	}
+	cfg, err := md2jsLib.LoadProjectConfig("")
+	if err != nil {log.Fatalf("error -- config: %v\n", err)}
 
-	mdData, err := os.ReadFile(inFilnam)
-	if err != nil {log.Fatalf("error -- open file: %v\n", err)}
+	inFilnam := filepath.Join(cfg.Path(cfg.InDir), inFil + ".md")
+	outFilnam := filepath.Join(cfg.Path(cfg.OutDir), outFil + ".js")
 
-	metaData, err := os.ReadFile(metaFilnam)
-	if err != nil {log.Printf("info -- no meta file: %v\n", err)}
+	opts, err := cfg.Options(inFilnam)
+	if err != nil {log.Fatalf("error -- config options: %v\n", err)}
+	opts.Name = "test"
+	opts.Dbg = dbg
 
-	stylData, err := os.ReadFile(stylFilnam)
-	if err != nil {log.Printf("info -- no style file: %v\n", err)}
-
-	siteData, err := os.ReadFile(siteFilnam)
-	if err != nil {log.Printf("info -- no style file: %v\n", err)}
-
-	oFil, err := os.Create(outFilnam)
-	if err != nil {log.Fatalf("error -- create out File: %v\n", err)}
-	defer oFil.Close()
-
-	if len(metaData) > 0 {
-		mData, err := md2js.GetMeta(metaData)
-		if err !=nil {log.Fatal("error -- converting meta: %v\n", err)}
-		md2js.PrintMeta(mData)
+	// style and site flags override the config theme and site
+	if len(stylFil) > 0 {
+		opts.Style, err = os.ReadFile("style/" + stylFil + ".js")
+		if err != nil {log.Printf("info -- no style file: %v\n", err)}
+	}
+	if len(siteFil) > 0 {
+		opts.Site, err = os.ReadFile("site/" + siteFil + ".js")
+		if err != nil {log.Printf("info -- no site file: %v\n", err)}
 	}
 
-	startMdStr := md2js.StartRenderFun()
-	_, err = oFil.Write(startMdStr)
-	if err != nil {log.Fatalf("error -- writing md start Render: %v\n")}
-
-	_, err = oFil.Write(stylData)
-	if err != nil {log.Fatalf("error -- writing style: %v\n", err)}
-
-	name:= "test"
-	md2jsRen := md2js.GetRenderer(name,dbg)
-	md := goldmark.New()
-	md.SetRenderer(md2jsRen)
-
-	// retrieve yaml data from mdData if present
-
-	// retrieve summary md data from mdData if present
+	if dbg {
+		fmt.Printf("config: %s\n", cfg.File())
+		fmt.Printf("input:  %s\n", inFilnam)
+		fmt.Printf("output: %s\n", outFilnam)
+	}
 
-	// retrieve body md data from mdData if present
+	res, errcon := md2jsLib.ConvertFile(context.Background(), inFilnam, opts)
+	if res == nil {log.Fatalf("error -- converting: %v\n", errcon)}
 
-// func Convert(source []byte, w io.Writer, opts ...parser.ParseOption) error
-//	err = goldmark.Convert(source, &buf, parser.WithContext(ctx))
-	errcon := md.Convert(mdData, &buf)
-	if errcon != nil {
-		log.Printf("error -- converting: %v\n",errcon)
-	} else {
-		log.Printf("*** success converting ***\n")
-	}
-	// save
-//fmt.Printf("dbg -- buf length: %d\n", len(buf.Bytes()))
-	_, err = oFil.Write(buf.Bytes())
-	if err != nil {log.Fatalf("error -- writing md js body: %v\n")}
+	if res.Meta != nil {md2jsLib.PrintMeta(res.Meta)}
+	for _, d := range res.Diagnostics {log.Printf("%s\n", d)}
 
-	_, err = oFil.Write(siteData)
-	if err != nil {log.Fatalf("error -- writing site: %v\n", err)}
+	err = res.WriteFile(outFilnam)
+	if err != nil {log.Fatalf("error -- %v\n", err)}
 
 	if errcon != nil {
 		log.Println("*** error conversion ***")
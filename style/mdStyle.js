@@ -9,7 +9,11 @@ let mdStyle = {
 	code: {fontFamily: 'monospace'},
 	block: {margin: '0px 40px', color: 'purple', backgroundColor: 'lightgrey',},
 	a: {color: 'blue', textDecoration: 'underline',},
+	externalLink: {fontSize: '0.8em', marginLeft: '0.2em'},
 	ul: {margin: '0 0 0 10px'},
 	ol: {margin: '0 0 0 10px'},
 	li: {listStylePosition: 'outside', margin: '0 0 0 30px'},
+	table: {borderCollapse: 'collapse', margin: '1rem 0'},
+	th: {border: '1px solid grey', padding: '0.2rem 0.5rem', fontWeight: 'bold'},
+	td: {border: '1px solid grey', padding: '0.2rem 0.5rem'},
 };